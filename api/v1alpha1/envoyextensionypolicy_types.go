@@ -13,6 +13,10 @@ import (
 const (
 	// KindEnvoyExtensionPolicy is the name of the EnvoyExtensionPolicy kind.
 	KindEnvoyExtensionPolicy = "EnvoyExtensionPolicy"
+
+	// PolicyReasonPending is used with the "Accepted" condition when the policy
+	// waits for the wasm modules it uses to be pulled.
+	PolicyReasonPending gwapiv1a2.PolicyConditionReason = "Pending"
 )
 
 // +kubebuilder:object:root=true
@@ -44,7 +48,7 @@ type EnvoyExtensionPolicySpec struct {
 	// This Policy and the TargetRef MUST be in the same namespace
 	// for this Policy to have effect and be applied to the Gateway.
 	// TargetRef
-	TargetRef gwapiv1a2.PolicyTargetReferenceWithSectionName `json:"targetRef"`
+	TargetRef PolicyTargetReferenceWithSectionName `json:"targetRef"`
 
 	// WASM is a list of Wasm extensions to be loaded by the Gateway.
 	// Order matters, as the extensions will be loaded in the order they are
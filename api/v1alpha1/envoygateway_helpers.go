@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -95,6 +96,17 @@ func (e *EnvoyGateway) NamespaceMode() bool {
 		len(e.Provider.Kubernetes.Watch.Namespaces) > 0
 }
 
+// GatewayNamespaceMode returns if the managed resources of the Gateways are
+// deployed to the namespaces of the Gateways.
+func (e *EnvoyGateway) GatewayNamespaceMode() bool {
+	return e.Provider != nil &&
+		e.Provider.Type == ProviderTypeKubernetes &&
+		e.Provider.Kubernetes != nil &&
+		e.Provider.Kubernetes.Deploy != nil &&
+		e.Provider.Kubernetes.Deploy.Type != nil &&
+		*e.Provider.Kubernetes.Deploy.Type == KubernetesDeployModeTypeGatewayNamespace
+}
+
 // DefaultLeaderElection returns a new LeaderElection with default configuration parameters.
 func DefaultLeaderElection() *LeaderElection {
 	return &LeaderElection{
@@ -105,6 +117,66 @@ func DefaultLeaderElection() *LeaderElection {
 	}
 }
 
+const (
+	// DefaultShardingLeaseDuration is the default duration of the Lease of a
+	// replica taking part in the sharding of the Gateways.
+	DefaultShardingLeaseDuration = 15 * time.Second
+	// DefaultShardingRenewPeriod is the default interval at which a replica
+	// renews its Lease.
+	DefaultShardingRenewPeriod = 5 * time.Second
+)
+
+// GetSharding returns the sharding settings of the Kubernetes provider, or nil if
+// the Gateways aren't sharded across the Envoy Gateway replicas.
+func (e *EnvoyGateway) GetSharding() *KubernetesSharding {
+	if e.Provider == nil || e.Provider.Type != ProviderTypeKubernetes || e.Provider.Kubernetes == nil {
+		return nil
+	}
+	return e.Provider.Kubernetes.Sharding
+}
+
+// GetLeaseDuration returns the duration of the Lease of a replica, or the
+// default duration if unspecified or invalid.
+func (s *KubernetesSharding) GetLeaseDuration() time.Duration {
+	return parseDurationOrDefault(s.LeaseDuration, DefaultShardingLeaseDuration)
+}
+
+// GetRenewPeriod returns the interval at which a replica renews its Lease, or
+// the default interval if unspecified or invalid.
+func (s *KubernetesSharding) GetRenewPeriod() time.Duration {
+	return parseDurationOrDefault(s.RenewPeriod, DefaultShardingRenewPeriod)
+}
+
+func parseDurationOrDefault(d *gwapiv1.Duration, defaultDuration time.Duration) time.Duration {
+	if d == nil {
+		return defaultDuration
+	}
+	if parsed, err := time.ParseDuration(string(*d)); err == nil {
+		return parsed
+	}
+	return defaultDuration
+}
+
+// GetShardBy returns the resources the Gateways are sharded by, or the default
+// Gateway if unspecified.
+func (s *KubernetesSharding) GetShardBy() ShardingKeyType {
+	if s.ShardBy == nil {
+		return ShardingKeyTypeGateway
+	}
+	return *s.ShardBy
+}
+
+// GetDebounce returns the debounce window of the updates, or zero if invalid.
+func (c *EnvoyGatewayUpdateCoalescing) GetDebounce() time.Duration {
+	return parseDurationOrDefault(&c.Debounce, 0)
+}
+
+// GetMaxDelay returns the maximum time an update waits to be translated, which
+// defaults to ten times the debounce window if unspecified or invalid.
+func (c *EnvoyGatewayUpdateCoalescing) GetMaxDelay() time.Duration {
+	return parseDurationOrDefault(c.MaxDelay, 10*c.GetDebounce())
+}
+
 // DefaultGateway returns a new Gateway with default configuration parameters.
 func DefaultGateway() *Gateway {
 	return &Gateway{
@@ -137,6 +209,23 @@ func (e *EnvoyGateway) GetEnvoyGatewayTelemetry() *EnvoyGatewayTelemetry {
 	return e.Telemetry
 }
 
+// GetEnvoyGatewayTracing returns the EnvoyGatewayTracing of EnvoyGateway, or nil
+// if tracing isn't enabled.
+func (e *EnvoyGateway) GetEnvoyGatewayTracing() *EnvoyGatewayTracing {
+	if e.Telemetry == nil {
+		return nil
+	}
+	return e.Telemetry.Tracing
+}
+
+// GetSamplingRate returns the sampling rate of the traces, in percent.
+func (t *EnvoyGatewayTracing) GetSamplingRate() uint32 {
+	if t.SamplingRate == nil {
+		return 100
+	}
+	return *t.SamplingRate
+}
+
 // DisablePrometheus returns if disable prometheus.
 func (e *EnvoyGateway) DisablePrometheus() bool {
 	return e.GetEnvoyGatewayTelemetry().Metrics.Prometheus.Disable
@@ -237,6 +326,15 @@ func (r *EnvoyGatewayProvider) GetEnvoyGatewayKubeProvider() *EnvoyGatewayKubern
 	return r.Kubernetes
 }
 
+// IsRunningOnHost returns true if the data plane is run as a child process on
+// the host environment, i.e. the Custom provider uses the Host infrastructure provider.
+func (r *EnvoyGatewayProvider) IsRunningOnHost() bool {
+	return r != nil &&
+		r.Type == ProviderTypeCustom &&
+		r.Custom != nil &&
+		r.Custom.Infrastructure.Type == InfrastructureProviderTypeHost
+}
+
 // DefaultEnvoyGatewayLoggingLevel returns a new EnvoyGatewayLogging with default configuration parameters.
 // When v1alpha1.LogComponentGatewayDefault specified, all other logging components are ignored.
 func (logging *EnvoyGatewayLogging) DefaultEnvoyGatewayLoggingLevel(level LogLevel) LogLevel {
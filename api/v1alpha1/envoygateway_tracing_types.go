@@ -0,0 +1,40 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+// EnvoyGatewayTracing defines control plane tracing configurations.
+// A trace follows each update of the resources by the provider through
+// the translations, up to the push of the xDS snapshots to the Envoy proxies.
+type EnvoyGatewayTracing struct {
+	// SamplingRate controls the rate at which the updates are traced.
+	// Defaults to 100, valid values [0-100]. 100 indicates 100% sampling.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=100
+	// +optional
+	SamplingRate *uint32 `json:"samplingRate,omitempty"`
+	// Sinks defines the trace sinks where traces are sent to.
+	Sinks []EnvoyGatewayTraceSink `json:"sinks,omitempty"`
+}
+
+type TraceSinkType string
+
+const (
+	TraceSinkTypeOpenTelemetry TraceSinkType = "OpenTelemetry"
+)
+
+// EnvoyGatewayTraceSink defines control plane
+// trace sinks where traces are sent to.
+type EnvoyGatewayTraceSink struct {
+	// Type defines the trace sink type.
+	// EG control plane currently supports OpenTelemetry.
+	// +kubebuilder:validation:Enum=OpenTelemetry
+	// +kubebuilder:default=OpenTelemetry
+	Type TraceSinkType `json:"type"`
+	// OpenTelemetry defines the configuration for OpenTelemetry sink.
+	// It's required if the sink type is OpenTelemetry.
+	OpenTelemetry *EnvoyGatewayOpenTelemetrySink `json:"openTelemetry,omitempty"`
+}
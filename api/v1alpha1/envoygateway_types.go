@@ -88,6 +88,19 @@ type EnvoyGatewaySpec struct {
 	//
 	// +optional
 	ExtensionAPIs *ExtensionAPISettings `json:"extensionApis,omitempty"`
+
+	// XdsServer defines the settings of the xDS server serving the configuration
+	// of the Envoy proxies.
+	//
+	// +optional
+	XdsServer *EnvoyGatewayXdsServer `json:"xdsServer,omitempty"`
+
+	// UpdateCoalescing defines how the bursts of updates of the resources
+	// watched by the provider are coalesced into a single translation.
+	// If unspecified, every update is translated as soon as it's received.
+	//
+	// +optional
+	UpdateCoalescing *EnvoyGatewayUpdateCoalescing `json:"updateCoalescing,omitempty"`
 }
 
 // LeaderElection defines the desired leader election settings.
@@ -105,11 +118,52 @@ type LeaderElection struct {
 	Disable *bool `json:"disable,omitempty"`
 }
 
+// ShardingKeyType defines the resources the Gateways are sharded by.
+// +kubebuilder:validation:Enum=GatewayClass;Gateway
+type ShardingKeyType string
+
+const (
+	// ShardingKeyTypeGatewayClass shards the Gateways by GatewayClass: all the
+	// Gateways of a GatewayClass are owned by the same replica.
+	ShardingKeyTypeGatewayClass ShardingKeyType = "GatewayClass"
+
+	// ShardingKeyTypeGateway shards the Gateways individually. The Gateways
+	// sharing a route or a policy are owned by the same replica.
+	ShardingKeyTypeGateway ShardingKeyType = "Gateway"
+)
+
+// KubernetesSharding defines how the Gateways are sharded across the Envoy
+// Gateway replicas. Each replica holds a Lease, and the Gateways are assigned
+// to the replicas whose Lease hasn't expired with consistent hashing, so that
+// only the Gateways of a replica joining or leaving move to another replica.
+// The Envoy proxies of a Gateway connect to the replica owning it with the DNS
+// name of the replica under the "envoy-gateway-replicas" headless service, so
+// the replicas run as a StatefulSet governed by this service. A replica which
+// shuts down keeps its Gateways for two minutes, so that they don't move to
+// another replica and back while it restarts.
+type KubernetesSharding struct {
+	// ShardBy defines the resources the Gateways are sharded by.
+	// Defaults to Gateway.
+	// +optional
+	ShardBy *ShardingKeyType `json:"shardBy,omitempty"`
+	// LeaseDuration defines the time after which a replica which didn't renew
+	// its Lease leaves the sharding. The default setting is 15 seconds.
+	// +optional
+	LeaseDuration *gwapiv1.Duration `json:"leaseDuration,omitempty"`
+	// RenewPeriod defines the interval at which a replica renews its Lease and
+	// lists the Leases of the other replicas. The default setting is 5 seconds.
+	// +optional
+	RenewPeriod *gwapiv1.Duration `json:"renewPeriod,omitempty"`
+}
+
 // EnvoyGatewayTelemetry defines telemetry configurations for envoy gateway control plane.
-// Control plane will focus on metrics observability telemetry and tracing telemetry later.
 type EnvoyGatewayTelemetry struct {
 	// Metrics defines metrics configuration for envoy gateway.
 	Metrics *EnvoyGatewayMetrics `json:"metrics,omitempty"`
+	// Tracing defines tracing configuration for envoy gateway.
+	//
+	// +optional
+	Tracing *EnvoyGatewayTracing `json:"tracing,omitempty"`
 }
 
 // EnvoyGatewayLogging defines logging for Envoy Gateway.
@@ -168,10 +222,72 @@ type ExtensionAPISettings struct {
 	EnableEnvoyPatchPolicy bool `json:"enableEnvoyPatchPolicy"`
 }
 
+// EnvoyGatewayXdsServer defines the settings of the xDS server.
+type EnvoyGatewayXdsServer struct {
+	// SnapshotPersistence defines where the last xDS resources generated for the
+	// Envoy proxies are persisted. The persisted resources are served as soon as
+	// Envoy Gateway restarts, until the resources are translated again. The
+	// resources persisted for the Gateways which no longer exist are dropped
+	// once the resources are translated.
+	// If unspecified, the xDS resources aren't persisted.
+	//
+	// +optional
+	SnapshotPersistence *XdsSnapshotPersistence `json:"snapshotPersistence,omitempty"`
+}
+
+// EnvoyGatewayUpdateCoalescing defines how the updates of the resources watched
+// by the provider are coalesced before they're translated.
+type EnvoyGatewayUpdateCoalescing struct {
+	// Debounce defines the time to wait for further updates after an update of
+	// the resources. The updates received within this window of each other are
+	// translated once.
+	Debounce gwapiv1.Duration `json:"debounce"`
+	// MaxDelay defines the maximum time an update waits to be translated while
+	// updates keep arriving within the debounce window.
+	// Defaults to ten times the debounce window.
+	//
+	// +optional
+	MaxDelay *gwapiv1.Duration `json:"maxDelay,omitempty"`
+}
+
+// XdsSnapshotPersistenceType defines the types of storage the xDS resources
+// are persisted to.
+// +kubebuilder:validation:Enum=File
+type XdsSnapshotPersistenceType string
+
+const (
+	// XdsSnapshotPersistenceTypeFile persists the xDS resources to files in a
+	// local directory.
+	XdsSnapshotPersistenceTypeFile XdsSnapshotPersistenceType = "File"
+)
+
+// XdsSnapshotPersistence defines the storage the xDS resources are persisted to.
+// +union
+type XdsSnapshotPersistence struct {
+	// Type is the type of storage. Supported types are "File".
+	//
+	// +unionDiscriminator
+	Type XdsSnapshotPersistenceType `json:"type"`
+
+	// File defines the directory the xDS resources are persisted to.
+	//
+	// +optional
+	File *XdsSnapshotFilePersistence `json:"file,omitempty"`
+}
+
+// XdsSnapshotFilePersistence defines the directory the xDS resources are
+// persisted to.
+type XdsSnapshotFilePersistence struct {
+	// Path is the path of the directory. The directory must outlive the Envoy
+	// Gateway process, e.g. be mounted from a persistent volume, for the
+	// resources to be served after a restart.
+	Path string `json:"path"`
+}
+
 // EnvoyGatewayProvider defines the desired configuration of a provider.
 // +union
 type EnvoyGatewayProvider struct {
-	// Type is the type of provider to use. Supported types are "Kubernetes".
+	// Type is the type of provider to use. Supported types are "Kubernetes" and "Custom".
 	//
 	// +unionDiscriminator
 	Type ProviderType `json:"type"`
@@ -199,6 +315,12 @@ type EnvoyGatewayKubernetesProvider struct {
 	// +optional
 	RateLimitDeployment *KubernetesDeploymentSpec `json:"rateLimitDeployment,omitempty"`
 
+	// RateLimitPDB defines the Pod Disruption Budget settings for the Envoy ratelimit deployment.
+	// If unspecified, no Pod Disruption Budget is created for the ratelimit deployment.
+	//
+	// +optional
+	RateLimitPDB *KubernetesPodDisruptionBudgetSpec `json:"rateLimitPDB,omitempty"`
+
 	// Watch holds configuration of which input resources should be watched and reconciled.
 	// +optional
 	Watch *KubernetesWatchMode `json:"watch,omitempty"`
@@ -213,6 +335,13 @@ type EnvoyGatewayKubernetesProvider struct {
 	// If it's not set up, leader election will be active by default, using Kubernetes' standard settings.
 	// +optional
 	LeaderElection *LeaderElection `json:"leaderElection,omitempty"`
+	// Sharding enables sharding the Gateways across the Envoy Gateway replicas.
+	// Each replica translates the resources, serves the xDS configuration and
+	// manages the infrastructure of the Gateways of its shard only, and the
+	// Envoy proxies of these Gateways connect to it.
+	// If unspecified, every replica translates the resources of all the Gateways.
+	// +optional
+	Sharding *KubernetesSharding `json:"sharding,omitempty"`
 }
 
 const (
@@ -250,9 +379,30 @@ type KubernetesWatchMode struct {
 // KubernetesDeployMode holds configuration for how to deploy managed resources such as the Envoy Proxy
 // data plane fleet.
 type KubernetesDeployMode struct {
-	// TODO
+	// Type indicates the namespace the managed resources of a Gateway are deployed to.
+	// Defaults to ControllerNamespace.
+	// +optional
+	Type *KubernetesDeployModeType `json:"type,omitempty"`
 }
 
+// KubernetesDeployModeType defines the namespace the managed resources are deployed to.
+// +kubebuilder:validation:Enum=ControllerNamespace;GatewayNamespace
+type KubernetesDeployModeType string
+
+const (
+	// KubernetesDeployModeTypeControllerNamespace deploys the managed resources of all the
+	// Gateways to the namespace of Envoy Gateway.
+	KubernetesDeployModeTypeControllerNamespace KubernetesDeployModeType = "ControllerNamespace"
+
+	// KubernetesDeployModeTypeGatewayNamespace deploys the managed resources of each Gateway
+	// to the namespace of the Gateway, along with an xDS client certificate of the Envoy
+	// Proxies issued for the namespace by the CA of the control plane certificates, which is
+	// reissued when the CA is rotated. The resources previously deployed to the namespace of
+	// Envoy Gateway are deleted. The resources of the merged Gateways of a GatewayClass are
+	// deployed to the namespace of Envoy Gateway.
+	KubernetesDeployModeTypeGatewayNamespace KubernetesDeployModeType = "GatewayNamespace"
+)
+
 // EnvoyGatewayCustomProvider defines configuration for the Custom provider.
 type EnvoyGatewayCustomProvider struct {
 	// Resource defines the desired resource provider.
@@ -322,7 +472,18 @@ type EnvoyGatewayInfrastructureProvider struct {
 
 // EnvoyGatewayHostInfrastructureProvider defines configuration for the Host Infrastructure provider.
 type EnvoyGatewayHostInfrastructureProvider struct {
-	// TODO: Add config as use cases are better understood.
+	// ConfigHome is the directory used to store the xDS certificates and the
+	// bootstrap configuration of the managed Envoy processes.
+	// If unspecified, defaults to "$HOME/.config/envoy-gateway".
+	//
+	// +optional
+	ConfigHome *string `json:"configHome,omitempty"`
+
+	// EnvoyBinary is the path of the Envoy executable used to run the data plane.
+	// If unspecified, "envoy" is looked up in the PATH.
+	//
+	// +optional
+	EnvoyBinary *string `json:"envoyBinary,omitempty"`
 }
 
 // RateLimit defines the configuration associated with the Rate Limit Service
@@ -418,6 +579,14 @@ type ExtensionManager struct {
 	// +optional
 	Resources []GroupVersionKind `json:"resources,omitempty"`
 
+	// PolicyResources defines the set of K8s policy resources the extension will handle.
+	// These resources attach to Gateways, Listeners or Routes with a spec.targetRef
+	// in the same way as BackendTrafficPolicy, and are delivered to the extension
+	// along with the xDS resources generated for their targets.
+	//
+	// +optional
+	PolicyResources []GroupVersionKind `json:"policyResources,omitempty"`
+
 	// Hooks defines the set of hooks the extension supports
 	//
 	// +kubebuilder:validation:Required
@@ -428,6 +597,45 @@ type ExtensionManager struct {
 	//
 	// +kubebuilder:validation:Required
 	Service *ExtensionService `json:"service,omitempty"`
+
+	// Timeout defines the timeout of a call to an extension hook, retries
+	// included. If unspecified, defaults to 10 seconds.
+	//
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Retry defines the retry policy of the calls to the extension hooks.
+	// If unspecified, the calls failing with an UNAVAILABLE status are
+	// attempted up to 4 times, with a backoff starting at 100ms and capped at 1s.
+	// The calls wait for the connection to the extension to be ready, except
+	// the calls of the fail open hooks, which fail fast when the extension is
+	// down.
+	//
+	// +optional
+	Retry *ExtensionRetry `json:"retry,omitempty"`
+}
+
+// ExtensionRetry defines the retry policy of the calls to the extension hooks.
+// Only the calls failing with an UNAVAILABLE status are retried.
+type ExtensionRetry struct {
+	// MaxAttempts defines the maximum number of attempts of a call, the
+	// original call included. If unspecified, defaults to 4.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxAttempts *int `json:"maxAttempts,omitempty"`
+
+	// InitialBackoff defines the backoff before the first retry. The backoff
+	// is doubled on every retry, up to MaxBackoff. If unspecified, defaults to 100ms.
+	//
+	// +optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff defines the maximum backoff between retries.
+	// If unspecified, defaults to 1s.
+	//
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
 }
 
 // ExtensionHooks defines extension hooks across all supported runners
@@ -440,12 +648,22 @@ type ExtensionHooks struct {
 type XDSTranslatorHooks struct {
 	Pre  []XDSTranslatorHook `json:"pre,omitempty"`
 	Post []XDSTranslatorHook `json:"post,omitempty"`
+
+	// FailOpen defines the hooks whose failures are ignored, for both the pre
+	// and post hooks of these types. The resources are then translated as if
+	// the hook was not registered, instead of failing the translation.
+	//
+	// +optional
+	FailOpen []XDSTranslatorHook `json:"failOpen,omitempty"`
 }
 
 // ExtensionService defines the configuration for connecting to a registered extension service.
 type ExtensionService struct {
 	// Host define the extension service hostname.
-	Host string `json:"host"`
+	// Either Host or Unix must be specified.
+	//
+	// +optional
+	Host string `json:"host,omitempty"`
 
 	// Port defines the port the extension service is exposed on.
 	//
@@ -454,6 +672,13 @@ type ExtensionService struct {
 	// +kubebuilder:default=80
 	Port int32 `json:"port,omitempty"`
 
+	// Unix defines the Unix domain socket the extension service listens on,
+	// e.g. when the extension runs as a sidecar of Envoy Gateway.
+	// Either Host or Unix must be specified.
+	//
+	// +optional
+	Unix *ExtensionUnixSocket `json:"unix,omitempty"`
+
 	// TLS defines TLS configuration for communication between Envoy Gateway and
 	// the extension service.
 	//
@@ -471,6 +696,28 @@ type ExtensionTLS struct {
 	//
 	// +kubebuilder:validation:Required
 	CertificateRef gwapiv1.SecretObjectReference `json:"certificateRef"`
+
+	// ClientCertificateRef references a Kubernetes Secret of type kubernetes.io/tls,
+	// holding the client certificate and private key Envoy Gateway presents to
+	// the extension server, for mutual TLS.
+	//
+	// +optional
+	ClientCertificateRef *gwapiv1.SecretObjectReference `json:"clientCertificateRef,omitempty"`
+
+	// SubjectAltNames defines the Subject Alternative Names the certificate of the
+	// extension server is verified against. The certificate must match at least one
+	// of them, as a DNS name, IP address or URI.
+	// If unspecified, the certificate is verified against the Host of the extension
+	// service, or against "localhost" for a Unix domain socket.
+	//
+	// +optional
+	SubjectAltNames []string `json:"subjectAltNames,omitempty"`
+}
+
+// ExtensionUnixSocket defines a Unix domain socket the extension service listens on.
+type ExtensionUnixSocket struct {
+	// Path defines the absolute path of the Unix domain socket.
+	Path string `json:"path"`
 }
 
 // EnvoyGatewayAdmin defines the Envoy Gateway Admin configuration.
@@ -480,10 +727,18 @@ type EnvoyGatewayAdmin struct {
 	//
 	// +optional
 	Address *EnvoyGatewayAdminAddress `json:"address,omitempty"`
-	// EnableDumpConfig defines if enable dump config in Envoy Gateway logs.
+	// EnableDumpConfig defines if enable dump config in Envoy Gateway logs,
+	// and serve the config dump of every stage of the translation pipeline
+	// on the "/api/config_dump/" endpoints of the admin server.
 	//
 	// +optional
 	EnableDumpConfig bool `json:"enableDumpConfig,omitempty"`
+	// DumpConfigSecrets defines if the config dumps served by the admin server
+	// include the secrets, e.g. the data of the Secrets and the private keys
+	// of the certificates. They are redacted by default.
+	//
+	// +optional
+	DumpConfigSecrets bool `json:"dumpConfigSecrets,omitempty"`
 	// EnablePprof defines if enable pprof in Envoy Gateway Admin Server.
 	//
 	// +optional
@@ -54,7 +54,7 @@ type EnvoyPatchPolicySpec struct {
 	// This Policy and the TargetRef MUST be in the same namespace
 	// for this Policy to have effect and be applied to the Gateway
 	// TargetRef
-	TargetRef gwapiv1a2.PolicyTargetReference `json:"targetRef"`
+	TargetRef PolicyTargetReference `json:"targetRef"`
 	// Priority of the EnvoyPatchPolicy.
 	// If multiple EnvoyPatchPolicies are applied to the same
 	// TargetRef, they will be applied in the ascending order of
@@ -135,6 +135,7 @@ const (
 	//
 	// * "Invalid"
 	// * "ResourceNotFound"
+	// * "Rejected"
 	//
 	PolicyConditionProgrammed gwapiv1a2.PolicyConditionType = "Programmed"
 
@@ -150,6 +151,10 @@ const (
 	// policy cannot find the resource type to patch to.
 	PolicyReasonResourceNotFound gwapiv1a2.PolicyConditionReason = "ResourceNotFound"
 
+	// PolicyReasonRejected is used with the "Programmed" condition when the patched
+	// resources are rejected by the Envoy proxies.
+	PolicyReasonRejected gwapiv1a2.PolicyConditionReason = "Rejected"
+
 	// PolicyReasonDisabled is used with the "Accepted" condition when the policy
 	// feature is disabled by the configuration.
 	PolicyReasonDisabled gwapiv1a2.PolicyConditionReason = "Disabled"
@@ -70,11 +70,16 @@ func (r *EnvoyProxyProvider) GetEnvoyProxyKubeProvider() *EnvoyProxyKubernetesPr
 		return r.Kubernetes
 	}
 
-	if r.Kubernetes.EnvoyDeployment == nil {
-		r.Kubernetes.EnvoyDeployment = DefaultKubernetesDeployment(DefaultEnvoyProxyImage)
-	}
+	// The Envoy Proxy fleet is run by a deployment, unless a daemonset is specified.
+	if r.Kubernetes.EnvoyDaemonSet != nil {
+		r.Kubernetes.EnvoyDaemonSet.defaultKubernetesDaemonSetSpec(DefaultEnvoyProxyImage)
+	} else {
+		if r.Kubernetes.EnvoyDeployment == nil {
+			r.Kubernetes.EnvoyDeployment = DefaultKubernetesDeployment(DefaultEnvoyProxyImage)
+		}
 
-	r.Kubernetes.EnvoyDeployment.defaultKubernetesDeploymentSpec(DefaultEnvoyProxyImage)
+		r.Kubernetes.EnvoyDeployment.defaultKubernetesDeploymentSpec(DefaultEnvoyProxyImage)
+	}
 
 	if r.Kubernetes.EnvoyService == nil {
 		r.Kubernetes.EnvoyService = DefaultKubernetesService()
@@ -84,6 +89,12 @@ func (r *EnvoyProxyProvider) GetEnvoyProxyKubeProvider() *EnvoyProxyKubernetesPr
 		r.Kubernetes.EnvoyService.Type = GetKubernetesServiceType(ServiceTypeLoadBalancer)
 	}
 
+	for i := range r.Kubernetes.EnvoyAdditionalServices {
+		if r.Kubernetes.EnvoyAdditionalServices[i].Type == nil {
+			r.Kubernetes.EnvoyAdditionalServices[i].Type = GetKubernetesServiceType(ServiceTypeLoadBalancer)
+		}
+	}
+
 	if r.Kubernetes.EnvoyHpa != nil {
 		r.Kubernetes.EnvoyHpa.setDefault()
 	}
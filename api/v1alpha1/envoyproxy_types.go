@@ -136,6 +136,10 @@ type ShutdownConfig struct {
 
 // EnvoyProxyKubernetesProvider defines configuration for the Kubernetes resource
 // provider.
+//
+// +kubebuilder:validation:XValidation:message="only one of envoyDeployment or envoyDaemonSet can be specified",rule="!(has(self.envoyDeployment) && has(self.envoyDaemonSet))"
+// +kubebuilder:validation:XValidation:message="envoyHpa can only be specified with envoyDeployment",rule="!(has(self.envoyHpa) && has(self.envoyDaemonSet))"
+// +kubebuilder:validation:XValidation:message="envoyPDB can only be specified with envoyDeployment",rule="!(has(self.envoyPDB) && has(self.envoyDaemonSet))"
 type EnvoyProxyKubernetesProvider struct {
 	// EnvoyDeployment defines the desired state of the Envoy deployment resource.
 	// If unspecified, default settings for the managed Envoy deployment resource
@@ -144,6 +148,14 @@ type EnvoyProxyKubernetesProvider struct {
 	// +optional
 	EnvoyDeployment *KubernetesDeploymentSpec `json:"envoyDeployment,omitempty"`
 
+	// EnvoyDaemonSet defines the desired state of the Envoy daemonset resource.
+	// If specified, the Envoy Proxy fleet is run by a daemonset, instead of
+	// a deployment, to run one Envoy Proxy per node, e.g. with hostNetwork
+	// set by the patch of the daemonset.
+	//
+	// +optional
+	EnvoyDaemonSet *KubernetesDaemonSetSpec `json:"envoyDaemonSet,omitempty"`
+
 	// EnvoyService defines the desired state of the Envoy service resource.
 	// If unspecified, default settings for the managed Envoy service resource
 	// are applied.
@@ -151,11 +163,30 @@ type EnvoyProxyKubernetesProvider struct {
 	// +optional
 	EnvoyService *KubernetesServiceSpec `json:"envoyService,omitempty"`
 
+	// EnvoyAdditionalServices defines additional Envoy service resources exposing
+	// the listeners of the Gateway along with the service defined by EnvoyService,
+	// e.g. an internal service besides the internet-facing one.
+	// The addresses of all the services are reported in the Gateway status.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	EnvoyAdditionalServices []KubernetesAdditionalServiceSpec `json:"envoyAdditionalServices,omitempty"`
+
 	// EnvoyHpa defines the Horizontal Pod Autoscaler settings for Envoy Proxy Deployment.
 	// Once the HPA is being set, Replicas field from EnvoyDeployment will be ignored.
+	// It can't be set along with EnvoyDaemonSet.
 	//
 	// +optional
 	EnvoyHpa *KubernetesHorizontalPodAutoscalerSpec `json:"envoyHpa,omitempty"`
+
+	// EnvoyPDB defines the Pod Disruption Budget settings for Envoy Proxy Deployment.
+	// If unspecified, no Pod Disruption Budget is created for the Deployment.
+	// It can't be set along with EnvoyDaemonSet.
+	//
+	// +optional
+	EnvoyPDB *KubernetesPodDisruptionBudgetSpec `json:"envoyPDB,omitempty"`
 }
 
 // ProxyLogging defines logging parameters for managed proxies.
@@ -10,6 +10,8 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const (
@@ -52,9 +54,14 @@ const (
 	// ProviderTypeKubernetes defines the "Kubernetes" provider.
 	ProviderTypeKubernetes ProviderType = "Kubernetes"
 
-	// ProviderTypeFile defines the "File" provider. This type is not implemented
-	// until https://github.com/envoyproxy/gateway/issues/1001 is fixed.
+	// ProviderTypeFile defines the "File" provider. It can't be used as the type
+	// of the Envoy Gateway provider, the resources are read from files by the
+	// "Custom" provider with the "File" resource provider instead.
 	ProviderTypeFile ProviderType = "File"
+
+	// ProviderTypeCustom defines the "Custom" provider, which combines a custom
+	// resource provider with a custom infrastructure provider.
+	ProviderTypeCustom ProviderType = "Custom"
 )
 
 // KubernetesDeploymentSpec defines the desired state of the Kubernetes deployment resource.
@@ -92,6 +99,34 @@ type KubernetesDeploymentSpec struct {
 	// TODO: Expose config as use cases are better understood, e.g. labels.
 }
 
+// KubernetesDaemonSetSpec defines the desired state of the Kubernetes daemonset resource.
+type KubernetesDaemonSetSpec struct {
+	// Patch defines how to perform the patch operation to daemonset
+	//
+	// +optional
+	Patch *KubernetesPatchSpec `json:"patch,omitempty"`
+
+	// The daemonset strategy to use to replace existing pods with new ones.
+	// +optional
+	Strategy *appv1.DaemonSetUpdateStrategy `json:"strategy,omitempty"`
+
+	// Pod defines the desired specification of pod.
+	//
+	// +optional
+	Pod *KubernetesPodSpec `json:"pod,omitempty"`
+
+	// Container defines the desired specification of main container.
+	//
+	// +optional
+	Container *KubernetesContainerSpec `json:"container,omitempty"`
+
+	// List of initialization containers belonging to the pod.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/
+	//
+	// +optional
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+}
+
 // KubernetesPodSpec defines the desired state of the Kubernetes pod resource.
 type KubernetesPodSpec struct {
 	// Annotations are the annotations that should be appended to the pods.
@@ -272,6 +307,29 @@ type KubernetesServiceSpec struct {
 	// TODO: Expose config as use cases are better understood, e.g. labels.
 }
 
+// KubernetesAdditionalServiceSpec defines the desired state of an additional
+// Kubernetes service resource exposing the listeners of the Gateway.
+// The addresses of the Gateway spec are only assigned to the Envoy service,
+// not to the additional services.
+type KubernetesAdditionalServiceSpec struct {
+	// Name identifies the service among the services of the Gateway, and is
+	// part of the name of the service resource.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Ports selects the ports of the Gateway listeners exposed by the service.
+	// If unspecified, the ports of all the listeners are exposed.
+	//
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	Ports []gwapiv1.PortNumber `json:"ports,omitempty"`
+
+	KubernetesServiceSpec `json:",inline"`
+}
+
 // LogLevel defines a log level for Envoy Gateway and EnvoyProxy system logs.
 // +kubebuilder:validation:Enum=debug;info;error;warn
 type LogLevel string
@@ -293,7 +351,7 @@ const (
 // XDSTranslatorHook defines the types of hooks that an Envoy Gateway extension may support
 // for the xds-translator
 //
-// +kubebuilder:validation:Enum=VirtualHost;Route;HTTPListener;Translation
+// +kubebuilder:validation:Enum=VirtualHost;Route;HTTPListener;Translation;Cluster
 type XDSTranslatorHook string
 
 const (
@@ -301,6 +359,7 @@ const (
 	XDSRoute        XDSTranslatorHook = "Route"
 	XDSHTTPListener XDSTranslatorHook = "HTTPListener"
 	XDSTranslation  XDSTranslatorHook = "Translation"
+	XDSCluster      XDSTranslatorHook = "Cluster"
 )
 
 // StringMatch defines how to match any strings.
@@ -380,6 +439,26 @@ type KubernetesHorizontalPodAutoscalerSpec struct {
 	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
 }
 
+// KubernetesPodDisruptionBudgetSpec defines Kubernetes PodDisruptionBudget settings of the Deployment.
+// It limits the number of pods of the Deployment that are down simultaneously from voluntary
+// disruptions, such as node drains.
+// See k8s.io.policy.v1.PodDisruptionBudgetSpec.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of minAvailable or maxUnavailable must be specified",rule="has(self.minAvailable) != has(self.maxUnavailable)"
+type KubernetesPodDisruptionBudgetSpec struct {
+	// MinAvailable is the number, or percentage, of the pods that must still be
+	// available after an eviction.
+	//
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number, or percentage, of the pods that can be
+	// unavailable after an eviction.
+	//
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
 // HTTPStatus defines the http status code.
 // +kubebuilder:validation:Minimum=100
 // +kubebuilder:validation:Maximum=600
@@ -407,3 +486,47 @@ type KubernetesPatchSpec struct {
 	// Object contains the raw configuration for merged object
 	Value apiextensionsv1.JSON `json:"value"`
 }
+
+// PolicyTargetReference identifies an API object to apply a direct or
+// inherited policy to. It's the PolicyTargetReference of the v1alpha2 Gateway
+// API, which is no longer part of the Gateway API.
+type PolicyTargetReference struct {
+	// Group is the group of the target resource.
+	Group gwapiv1.Group `json:"group"`
+
+	// Kind is kind of the target resource.
+	Kind gwapiv1.Kind `json:"kind"`
+
+	// Name is the name of the target resource.
+	Name gwapiv1.ObjectName `json:"name"`
+
+	// Namespace is the namespace of the referent. When unspecified, the local
+	// namespace is inferred. Even when policy targets a resource in a different
+	// namespace, it MUST only apply to traffic originating from the same
+	// namespace as the policy.
+	//
+	// +optional
+	Namespace *gwapiv1.Namespace `json:"namespace,omitempty"`
+}
+
+// PolicyTargetReferenceWithSectionName identifies an API object to apply a direct
+// policy to, and optionally a section within it. It's the
+// PolicyTargetReferenceWithSectionName of the v1alpha2 Gateway API, which is no
+// longer part of the Gateway API.
+type PolicyTargetReferenceWithSectionName struct {
+	PolicyTargetReference `json:",inline"`
+
+	// SectionName is the name of a section within the target resource. When
+	// unspecified, this targetRef targets the entire resource. In the following
+	// resources, SectionName is interpreted as the following:
+	//
+	// * Gateway: Listener Name
+	// * Service: Port Name
+	//
+	// If a SectionName is specified, but does not exist on the targeted object,
+	// the Policy must fail to attach, and the policy implementation should record
+	// a `ResolvedRefs` or similar Condition in the Policy's status.
+	//
+	// +optional
+	SectionName *gwapiv1.SectionName `json:"sectionName,omitempty"`
+}
@@ -9,6 +9,9 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"path"
+	"slices"
+	"time"
 
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -26,8 +29,17 @@ func ValidateEnvoyGateway(eg *v1alpha1.EnvoyGateway) error {
 		return errors.New("gateway controllerName is unspecified")
 	case eg.Provider == nil:
 		return errors.New("provider is unspecified")
-	case eg.Provider.Type != v1alpha1.ProviderTypeKubernetes:
+	case eg.Provider.Type != v1alpha1.ProviderTypeKubernetes && eg.Provider.Type != v1alpha1.ProviderTypeCustom:
 		return fmt.Errorf("unsupported provider %v", eg.Provider.Type)
+	case eg.Provider.Type == v1alpha1.ProviderTypeCustom && eg.Provider.Custom == nil:
+		return errors.New("custom provider is unspecified")
+	case eg.Provider.Type == v1alpha1.ProviderTypeCustom && eg.Provider.Custom.Resource.Type != v1alpha1.ResourceProviderTypeFile:
+		return fmt.Errorf("unsupported resource provider %v", eg.Provider.Custom.Resource.Type)
+	case eg.Provider.Type == v1alpha1.ProviderTypeCustom &&
+		(eg.Provider.Custom.Resource.File == nil || len(eg.Provider.Custom.Resource.File.Paths) == 0):
+		return errors.New("paths should be specified when resource provider is 'File'")
+	case eg.Provider.Type == v1alpha1.ProviderTypeCustom && eg.Provider.Custom.Infrastructure.Type != v1alpha1.InfrastructureProviderTypeHost:
+		return fmt.Errorf("unsupported infrastructure provider %v", eg.Provider.Custom.Infrastructure.Type)
 	case eg.Provider.Kubernetes != nil && eg.Provider.Kubernetes.Watch != nil:
 		watch := eg.Provider.Kubernetes.Watch
 		switch watch.Type {
@@ -81,10 +93,20 @@ func ValidateEnvoyGateway(eg *v1alpha1.EnvoyGateway) error {
 			return fmt.Errorf("registered extension has no hooks specified")
 		}
 
+		for _, hook := range eg.ExtensionManager.Hooks.XDSTranslator.Pre {
+			if hook == v1alpha1.XDSVirtualHost || hook == v1alpha1.XDSCluster {
+				return fmt.Errorf("unsupported extension pre xds translator hook %v", hook)
+			}
+		}
+
 		if eg.ExtensionManager.Service == nil {
 			return fmt.Errorf("extension service config is empty")
 		}
 
+		if err := validateExtensionServiceAddress(eg.ExtensionManager.Service); err != nil {
+			return err
+		}
+
 		if eg.ExtensionManager.Service.TLS != nil {
 			certificateRefKind := eg.ExtensionManager.Service.TLS.CertificateRef.Kind
 
@@ -95,6 +117,15 @@ func ValidateEnvoyGateway(eg *v1alpha1.EnvoyGateway) error {
 			if *certificateRefKind != gwapiv1.Kind("Secret") {
 				return fmt.Errorf("unsupported extension server TLS certificateRef %v", certificateRefKind)
 			}
+
+			clientCertificateRef := eg.ExtensionManager.Service.TLS.ClientCertificateRef
+			if clientCertificateRef != nil && clientCertificateRef.Kind != nil && *clientCertificateRef.Kind != gwapiv1.Kind("Secret") {
+				return fmt.Errorf("unsupported extension server TLS clientCertificateRef %v", *clientCertificateRef.Kind)
+			}
+		}
+
+		if err := validateExtensionManagerCalls(eg.ExtensionManager); err != nil {
+			return err
 		}
 	case eg.Telemetry != nil:
 		if eg.Telemetry.Metrics != nil {
@@ -107,5 +138,176 @@ func ValidateEnvoyGateway(eg *v1alpha1.EnvoyGateway) error {
 			}
 		}
 	}
+
+	if err := validateXdsServer(eg.XdsServer); err != nil {
+		return err
+	}
+	if eg.Provider.Kubernetes != nil {
+		if err := validatePodDisruptionBudget("ratelimit", eg.Provider.Kubernetes.RateLimitPDB); err != nil {
+			return err
+		}
+		if err := validateDeployMode(eg.Provider.Kubernetes.Deploy); err != nil {
+			return err
+		}
+	}
+	if err := validateSharding(eg); err != nil {
+		return err
+	}
+	if err := validateUpdateCoalescing(eg.UpdateCoalescing); err != nil {
+		return err
+	}
+	if err := validateTracing(eg.GetEnvoyGatewayTracing()); err != nil {
+		return err
+	}
 	return nil
 }
+
+// validateSharding validates the settings of the sharding of the Gateways.
+func validateSharding(eg *v1alpha1.EnvoyGateway) error {
+	sharding := eg.GetSharding()
+	if sharding == nil {
+		return nil
+	}
+	// The configuration of the rate limit service is served by a single replica,
+	// which can't hold the rate limits of the Gateways of the other shards.
+	if eg.RateLimit != nil {
+		return fmt.Errorf("global ratelimit isn't supported when the gateways are sharded")
+	}
+	switch sharding.GetShardBy() {
+	case v1alpha1.ShardingKeyTypeGatewayClass, v1alpha1.ShardingKeyTypeGateway:
+	default:
+		return fmt.Errorf("unsupported sharding key %v", *sharding.ShardBy)
+	}
+
+	leaseDuration, err := parseOptionalDuration(sharding.LeaseDuration, v1alpha1.DefaultShardingLeaseDuration)
+	if err != nil {
+		return fmt.Errorf("invalid sharding leaseDuration: %w", err)
+	}
+	renewPeriod, err := parseOptionalDuration(sharding.RenewPeriod, v1alpha1.DefaultShardingRenewPeriod)
+	if err != nil {
+		return fmt.Errorf("invalid sharding renewPeriod: %w", err)
+	}
+	if renewPeriod <= 0 || renewPeriod >= leaseDuration {
+		return fmt.Errorf("sharding renewPeriod %v must be positive and shorter than leaseDuration %v", renewPeriod, leaseDuration)
+	}
+	return nil
+}
+
+// validateDeployMode validates the namespace the managed resources are deployed to.
+func validateDeployMode(deploy *v1alpha1.KubernetesDeployMode) error {
+	if deploy == nil || deploy.Type == nil {
+		return nil
+	}
+	switch *deploy.Type {
+	case v1alpha1.KubernetesDeployModeTypeControllerNamespace, v1alpha1.KubernetesDeployModeTypeGatewayNamespace:
+		return nil
+	default:
+		return fmt.Errorf("unsupported deploy mode %v", *deploy.Type)
+	}
+}
+
+// validateTracing validates the tracing of the translation of the provider resources.
+func validateTracing(tracing *v1alpha1.EnvoyGatewayTracing) error {
+	if tracing == nil {
+		return nil
+	}
+	if tracing.GetSamplingRate() > 100 {
+		return fmt.Errorf("tracing samplingRate %d must not be greater than 100", tracing.GetSamplingRate())
+	}
+	for _, sink := range tracing.Sinks {
+		if sink.Type == v1alpha1.TraceSinkTypeOpenTelemetry && sink.OpenTelemetry == nil {
+			return fmt.Errorf("OpenTelemetry is required when trace sink Type is OpenTelemetry")
+		}
+	}
+	return nil
+}
+
+// validateUpdateCoalescing validates the debounce window and maximum delay of
+// the updates of the provider resources.
+func validateUpdateCoalescing(coalescing *v1alpha1.EnvoyGatewayUpdateCoalescing) error {
+	if coalescing == nil {
+		return nil
+	}
+	debounce, err := time.ParseDuration(string(coalescing.Debounce))
+	if err != nil {
+		return fmt.Errorf("invalid update coalescing debounce: %w", err)
+	}
+	if debounce <= 0 {
+		return fmt.Errorf("update coalescing debounce %v must be positive", debounce)
+	}
+	maxDelay, err := parseOptionalDuration(coalescing.MaxDelay, 10*debounce)
+	if err != nil {
+		return fmt.Errorf("invalid update coalescing maxDelay: %w", err)
+	}
+	if maxDelay < debounce {
+		return fmt.Errorf("update coalescing maxDelay %v must not be shorter than debounce %v", maxDelay, debounce)
+	}
+	return nil
+}
+
+// validateXdsServer validates the storage the xDS resources are persisted to.
+func validateXdsServer(xdsServer *v1alpha1.EnvoyGatewayXdsServer) error {
+	if xdsServer == nil || xdsServer.SnapshotPersistence == nil {
+		return nil
+	}
+	persistence := xdsServer.SnapshotPersistence
+	if persistence.Type != v1alpha1.XdsSnapshotPersistenceTypeFile {
+		return fmt.Errorf("unsupported xds snapshot persistence %v", persistence.Type)
+	}
+	if persistence.File == nil || persistence.File.Path == "" {
+		return fmt.Errorf("empty xds snapshot persistence file path")
+	}
+	return nil
+}
+
+// validateExtensionServiceAddress validates the extension service is reached
+// either through a host or through a Unix domain socket.
+func validateExtensionServiceAddress(svc *v1alpha1.ExtensionService) error {
+	switch {
+	case svc.Host != "" && svc.Unix != nil:
+		return errors.New("only one of host and unix can be specified in extension service config")
+	case svc.Unix != nil:
+		if !path.IsAbs(svc.Unix.Path) {
+			return fmt.Errorf("extension service unix socket path %q must be absolute", svc.Unix.Path)
+		}
+	case svc.Host == "":
+		return errors.New("either host or unix must be specified in extension service config")
+	}
+	return nil
+}
+
+// validateExtensionManagerCalls validates the fail open hooks, the timeout
+// and the retry policy of the calls to the extension hooks.
+func validateExtensionManagerCalls(em *v1alpha1.ExtensionManager) error {
+	hooks := em.Hooks.XDSTranslator
+	for _, hook := range hooks.FailOpen {
+		if !slices.Contains(hooks.Pre, hook) && !slices.Contains(hooks.Post, hook) {
+			return fmt.Errorf("fail open extension hook %v is not registered", hook)
+		}
+	}
+
+	if em.Timeout != nil && em.Timeout.Duration <= 0 {
+		return fmt.Errorf("invalid extension hook timeout %v", em.Timeout.Duration)
+	}
+
+	if retry := em.Retry; retry != nil {
+		if retry.MaxAttempts != nil && *retry.MaxAttempts < 1 {
+			return fmt.Errorf("invalid extension hook retry max attempts %d", *retry.MaxAttempts)
+		}
+		if retry.InitialBackoff != nil && retry.InitialBackoff.Duration <= 0 {
+			return fmt.Errorf("invalid extension hook retry initial backoff %v", retry.InitialBackoff.Duration)
+		}
+		if retry.MaxBackoff != nil && retry.MaxBackoff.Duration <= 0 {
+			return fmt.Errorf("invalid extension hook retry max backoff %v", retry.MaxBackoff.Duration)
+		}
+	}
+	return nil
+}
+
+// parseOptionalDuration parses the duration, or returns the default duration if nil.
+func parseOptionalDuration(d *gwapiv1.Duration, defaultDuration time.Duration) (time.Duration, error) {
+	if d == nil {
+		return defaultDuration, nil
+	}
+	return time.ParseDuration(string(*d))
+}
@@ -7,10 +7,13 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/envoyproxy/gateway/api/v1alpha1"
@@ -77,6 +80,60 @@ func TestValidateEnvoyGateway(t *testing.T) {
 			},
 			expect: false,
 		},
+		{
+			name: "custom provider with file resource provider",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeCustom,
+						Custom: &v1alpha1.EnvoyGatewayCustomProvider{
+							Resource: v1alpha1.EnvoyGatewayResourceProvider{
+								Type: v1alpha1.ResourceProviderTypeFile,
+								File: &v1alpha1.EnvoyGatewayFileResourceProvider{
+									Paths: []string{"/etc/envoy-gateway/resources"},
+								},
+							},
+							Infrastructure: v1alpha1.EnvoyGatewayInfrastructureProvider{
+								Type: v1alpha1.InfrastructureProviderTypeHost,
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "unspecified custom provider",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{Type: v1alpha1.ProviderTypeCustom},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "custom provider without file paths",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeCustom,
+						Custom: &v1alpha1.EnvoyGatewayCustomProvider{
+							Resource: v1alpha1.EnvoyGatewayResourceProvider{
+								Type: v1alpha1.ResourceProviderTypeFile,
+								File: &v1alpha1.EnvoyGatewayFileResourceProvider{},
+							},
+							Infrastructure: v1alpha1.EnvoyGatewayInfrastructureProvider{
+								Type: v1alpha1.InfrastructureProviderTypeHost,
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
 		{
 			name: "empty ratelimit",
 			eg: &v1alpha1.EnvoyGateway{
@@ -341,14 +398,24 @@ func TestValidateEnvoyGateway(t *testing.T) {
 			expect: false,
 		},
 		{
-			name: "valid gateway logging level info",
+			name: "happy extension settings pre hooks",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
 					Gateway:  v1alpha1.DefaultGateway(),
 					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
-					Logging: &v1alpha1.EnvoyGatewayLogging{
-						Level: map[v1alpha1.EnvoyGatewayLogComponent]v1alpha1.LogLevel{
-							v1alpha1.LogComponentGatewayDefault: v1alpha1.LogLevelInfo,
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Pre: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSHTTPListener,
+									v1alpha1.XDSTranslation,
+									v1alpha1.XDSRoute,
+								},
+							},
+						},
+						Service: &v1alpha1.ExtensionService{
+							Host: "foo.extension",
+							Port: 8080,
 						},
 					},
 				},
@@ -356,29 +423,56 @@ func TestValidateEnvoyGateway(t *testing.T) {
 			expect: true,
 		},
 		{
-			name: "valid gateway logging level warn",
+			name: "unsupported virtual host pre hook in extension settings",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
 					Gateway:  v1alpha1.DefaultGateway(),
 					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
-					Logging: &v1alpha1.EnvoyGatewayLogging{
-						Level: map[v1alpha1.EnvoyGatewayLogComponent]v1alpha1.LogLevel{
-							v1alpha1.LogComponentGatewayDefault: v1alpha1.LogLevelWarn,
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Pre: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSVirtualHost,
+								},
+							},
+						},
+						Service: &v1alpha1.ExtensionService{
+							Host: "foo.extension",
+							Port: 8080,
 						},
 					},
 				},
 			},
-			expect: true,
+			expect: false,
 		},
 		{
-			name: "valid gateway logging level error",
+			name: "happy extension settings mtls",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
 					Gateway:  v1alpha1.DefaultGateway(),
 					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
-					Logging: &v1alpha1.EnvoyGatewayLogging{
-						Level: map[v1alpha1.EnvoyGatewayLogComponent]v1alpha1.LogLevel{
-							v1alpha1.LogComponentGatewayDefault: v1alpha1.LogLevelError,
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Post: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
+								},
+							},
+						},
+						Service: &v1alpha1.ExtensionService{
+							Host: "foo.extension",
+							Port: 443,
+							TLS: &v1alpha1.ExtensionTLS{
+								CertificateRef: v1.SecretObjectReference{
+									Kind: &TLSSecretKind,
+									Name: v1.ObjectName("ca"),
+								},
+								ClientCertificateRef: &v1.SecretObjectReference{
+									Kind: &TLSSecretKind,
+									Name: v1.ObjectName("client-certificate"),
+								},
+								SubjectAltNames: []string{"foo.extension", "spiffe://cluster.local/ns/foo/sa/extension"},
+							},
 						},
 					},
 				},
@@ -386,156 +480,443 @@ func TestValidateEnvoyGateway(t *testing.T) {
 			expect: true,
 		},
 		{
-			name: "valid gateway logging level debug",
+			name: "unknown TLS clientCertificateRef in extension settings",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
 					Gateway:  v1alpha1.DefaultGateway(),
 					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
-					Logging: &v1alpha1.EnvoyGatewayLogging{
-						Level: map[v1alpha1.EnvoyGatewayLogComponent]v1alpha1.LogLevel{
-							v1alpha1.LogComponentGatewayDefault: v1alpha1.LogLevelDebug,
-							v1alpha1.LogComponentProviderRunner: v1alpha1.LogLevelDebug,
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Post: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
+								},
+							},
+						},
+						Service: &v1alpha1.ExtensionService{
+							Host: "foo.extension",
+							Port: 443,
+							TLS: &v1alpha1.ExtensionTLS{
+								CertificateRef: v1.SecretObjectReference{
+									Kind: &TLSSecretKind,
+									Name: v1.ObjectName("ca"),
+								},
+								ClientCertificateRef: &v1.SecretObjectReference{
+									Kind: &TLSUnrecognizedKind,
+									Name: v1.ObjectName("client-certificate"),
+								},
+							},
 						},
 					},
 				},
 			},
-			expect: true,
+			expect: false,
 		},
 		{
-			name: "invalid gateway logging level",
+			name: "happy extension settings unix socket",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
 					Gateway:  v1alpha1.DefaultGateway(),
 					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
-					Logging: &v1alpha1.EnvoyGatewayLogging{
-						Level: map[v1alpha1.EnvoyGatewayLogComponent]v1alpha1.LogLevel{
-							v1alpha1.LogComponentGatewayDefault: "inffo",
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Post: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
+								},
+							},
+						},
+						Service: &v1alpha1.ExtensionService{
+							Unix: &v1alpha1.ExtensionUnixSocket{
+								Path: "/var/run/extension/extension.sock",
+							},
 						},
 					},
 				},
 			},
-			expect: false,
+			expect: true,
 		},
 		{
-			name: "valid gateway metrics sink",
+			name: "relative unix socket path in extension settings",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
 					Gateway:  v1alpha1.DefaultGateway(),
 					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
-					Telemetry: &v1alpha1.EnvoyGatewayTelemetry{
-						Metrics: &v1alpha1.EnvoyGatewayMetrics{
-							Sinks: []v1alpha1.EnvoyGatewayMetricSink{
-								{
-									Type: v1alpha1.MetricSinkTypeOpenTelemetry,
-									OpenTelemetry: &v1alpha1.EnvoyGatewayOpenTelemetrySink{
-										Host:     "x.x.x.x",
-										Port:     4317,
-										Protocol: "grpc",
-									},
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Post: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
 								},
 							},
 						},
+						Service: &v1alpha1.ExtensionService{
+							Unix: &v1alpha1.ExtensionUnixSocket{
+								Path: "extension.sock",
+							},
+						},
 					},
 				},
 			},
-			expect: true,
+			expect: false,
 		},
 		{
-			name: "invalid gateway metrics sink",
+			name: "both host and unix socket in extension settings",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
 					Gateway:  v1alpha1.DefaultGateway(),
 					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
-					Telemetry: &v1alpha1.EnvoyGatewayTelemetry{
-						Metrics: &v1alpha1.EnvoyGatewayMetrics{
-							Sinks: []v1alpha1.EnvoyGatewayMetricSink{
-								{
-									Type: v1alpha1.MetricSinkTypeOpenTelemetry,
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Post: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
 								},
 							},
 						},
+						Service: &v1alpha1.ExtensionService{
+							Host: "foo.extension",
+							Port: 8080,
+							Unix: &v1alpha1.ExtensionUnixSocket{
+								Path: "/var/run/extension/extension.sock",
+							},
+						},
 					},
 				},
 			},
 			expect: false,
 		},
 		{
-			name: "invalid gateway watch mode",
+			name: "neither host nor unix socket in extension settings",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
-					Gateway: v1alpha1.DefaultGateway(),
-					Provider: &v1alpha1.EnvoyGatewayProvider{
-						Type: v1alpha1.ProviderTypeKubernetes,
-						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
-							Watch: &v1alpha1.KubernetesWatchMode{
-								Type: "foobar",
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Post: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
+								},
 							},
 						},
+						Service: &v1alpha1.ExtensionService{
+							Port: 8080,
+						},
 					},
 				},
 			},
 			expect: false,
 		},
 		{
-			name: "happy namespaces must be set when watch mode is Namespaces",
+			name: "happy extension settings fail open, timeout and retry",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
-					Gateway: v1alpha1.DefaultGateway(),
-					Provider: &v1alpha1.EnvoyGatewayProvider{
-						Type: v1alpha1.ProviderTypeKubernetes,
-						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
-							Watch: &v1alpha1.KubernetesWatchMode{
-								Type:       v1alpha1.KubernetesWatchModeTypeNamespaces,
-								Namespaces: []string{"foo"},
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Pre: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
+								},
+								Post: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
+									v1alpha1.XDSCluster,
+								},
+								FailOpen: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
+									v1alpha1.XDSCluster,
+								},
 							},
 						},
+						Service: &v1alpha1.ExtensionService{
+							Host: "foo.extension",
+							Port: 8080,
+						},
+						Timeout: &metav1.Duration{Duration: 5 * time.Second},
+						Retry: &v1alpha1.ExtensionRetry{
+							MaxAttempts:    ptr.To(2),
+							InitialBackoff: &metav1.Duration{Duration: 50 * time.Millisecond},
+							MaxBackoff:     &metav1.Duration{Duration: 500 * time.Millisecond},
+						},
 					},
 				},
 			},
 			expect: true,
 		},
 		{
-			name: "fail namespaces is not be set when watch mode is Namespaces",
+			name: "unregistered fail open hook in extension settings",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
-					Gateway: v1alpha1.DefaultGateway(),
-					Provider: &v1alpha1.EnvoyGatewayProvider{
-						Type: v1alpha1.ProviderTypeKubernetes,
-						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
-							Watch: &v1alpha1.KubernetesWatchMode{
-								Type:              v1alpha1.KubernetesWatchModeTypeNamespaces,
-								NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": ""}},
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Post: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
+								},
+								FailOpen: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSCluster,
+								},
 							},
 						},
+						Service: &v1alpha1.ExtensionService{
+							Host: "foo.extension",
+							Port: 8080,
+						},
 					},
 				},
 			},
 			expect: false,
 		},
 		{
-			name: "happy namespaceSelector must be set when watch mode is NamespaceSelector",
+			name: "invalid timeout in extension settings",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
-					Gateway: v1alpha1.DefaultGateway(),
-					Provider: &v1alpha1.EnvoyGatewayProvider{
-						Type: v1alpha1.ProviderTypeKubernetes,
-						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
-							Watch: &v1alpha1.KubernetesWatchMode{
-								Type:              v1alpha1.KubernetesWatchModeTypeNamespaceSelector,
-								NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": ""}},
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Post: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
+								},
 							},
 						},
+						Service: &v1alpha1.ExtensionService{
+							Host: "foo.extension",
+							Port: 8080,
+						},
+						Timeout: &metav1.Duration{Duration: 0},
 					},
 				},
 			},
-			expect: true,
+			expect: false,
 		},
 		{
-			name: "fail namespaceSelector is not be set when watch mode is NamespaceSelector",
+			name: "invalid retry max attempts in extension settings",
 			eg: &v1alpha1.EnvoyGateway{
 				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
-					Gateway: v1alpha1.DefaultGateway(),
-					Provider: &v1alpha1.EnvoyGatewayProvider{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					ExtensionManager: &v1alpha1.ExtensionManager{
+						Hooks: &v1alpha1.ExtensionHooks{
+							XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+								Post: []v1alpha1.XDSTranslatorHook{
+									v1alpha1.XDSRoute,
+								},
+							},
+						},
+						Service: &v1alpha1.ExtensionService{
+							Host: "foo.extension",
+							Port: 8080,
+						},
+						Retry: &v1alpha1.ExtensionRetry{
+							MaxAttempts: ptr.To(0),
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "valid gateway logging level info",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					Logging: &v1alpha1.EnvoyGatewayLogging{
+						Level: map[v1alpha1.EnvoyGatewayLogComponent]v1alpha1.LogLevel{
+							v1alpha1.LogComponentGatewayDefault: v1alpha1.LogLevelInfo,
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "valid gateway logging level warn",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					Logging: &v1alpha1.EnvoyGatewayLogging{
+						Level: map[v1alpha1.EnvoyGatewayLogComponent]v1alpha1.LogLevel{
+							v1alpha1.LogComponentGatewayDefault: v1alpha1.LogLevelWarn,
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "valid gateway logging level error",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					Logging: &v1alpha1.EnvoyGatewayLogging{
+						Level: map[v1alpha1.EnvoyGatewayLogComponent]v1alpha1.LogLevel{
+							v1alpha1.LogComponentGatewayDefault: v1alpha1.LogLevelError,
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "valid gateway logging level debug",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					Logging: &v1alpha1.EnvoyGatewayLogging{
+						Level: map[v1alpha1.EnvoyGatewayLogComponent]v1alpha1.LogLevel{
+							v1alpha1.LogComponentGatewayDefault: v1alpha1.LogLevelDebug,
+							v1alpha1.LogComponentProviderRunner: v1alpha1.LogLevelDebug,
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "invalid gateway logging level",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					Logging: &v1alpha1.EnvoyGatewayLogging{
+						Level: map[v1alpha1.EnvoyGatewayLogComponent]v1alpha1.LogLevel{
+							v1alpha1.LogComponentGatewayDefault: "inffo",
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "valid gateway metrics sink",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					Telemetry: &v1alpha1.EnvoyGatewayTelemetry{
+						Metrics: &v1alpha1.EnvoyGatewayMetrics{
+							Sinks: []v1alpha1.EnvoyGatewayMetricSink{
+								{
+									Type: v1alpha1.MetricSinkTypeOpenTelemetry,
+									OpenTelemetry: &v1alpha1.EnvoyGatewayOpenTelemetrySink{
+										Host:     "x.x.x.x",
+										Port:     4317,
+										Protocol: "grpc",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "invalid gateway metrics sink",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					Telemetry: &v1alpha1.EnvoyGatewayTelemetry{
+						Metrics: &v1alpha1.EnvoyGatewayMetrics{
+							Sinks: []v1alpha1.EnvoyGatewayMetricSink{
+								{
+									Type: v1alpha1.MetricSinkTypeOpenTelemetry,
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "invalid gateway watch mode",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Watch: &v1alpha1.KubernetesWatchMode{
+								Type: "foobar",
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "happy namespaces must be set when watch mode is Namespaces",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Watch: &v1alpha1.KubernetesWatchMode{
+								Type:       v1alpha1.KubernetesWatchModeTypeNamespaces,
+								Namespaces: []string{"foo"},
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "fail namespaces is not be set when watch mode is Namespaces",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Watch: &v1alpha1.KubernetesWatchMode{
+								Type:              v1alpha1.KubernetesWatchModeTypeNamespaces,
+								NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": ""}},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "happy namespaceSelector must be set when watch mode is NamespaceSelector",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Watch: &v1alpha1.KubernetesWatchMode{
+								Type:              v1alpha1.KubernetesWatchModeTypeNamespaceSelector,
+								NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": ""}},
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "fail namespaceSelector is not be set when watch mode is NamespaceSelector",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
 						Type: v1alpha1.ProviderTypeKubernetes,
 						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
 							Watch: &v1alpha1.KubernetesWatchMode{
@@ -547,6 +928,334 @@ func TestValidateEnvoyGateway(t *testing.T) {
 			},
 			expect: false,
 		},
+		{
+			name: "valid ratelimit pdb",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							RateLimitPDB: &v1alpha1.KubernetesPodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt32(1))},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "ratelimit pdb without minAvailable or maxUnavailable",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							RateLimitPDB: &v1alpha1.KubernetesPodDisruptionBudgetSpec{},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "invalid ratelimit pdb percentage",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							RateLimitPDB: &v1alpha1.KubernetesPodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromString("half"))},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "valid gateway namespace deploy mode",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Deploy: &v1alpha1.KubernetesDeployMode{
+								Type: ptr.To(v1alpha1.KubernetesDeployModeTypeGatewayNamespace),
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "unsupported deploy mode",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Deploy: &v1alpha1.KubernetesDeployMode{
+								Type: ptr.To(v1alpha1.KubernetesDeployModeType("ClusterNamespace")),
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "valid sharding",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Sharding: &v1alpha1.KubernetesSharding{},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "valid sharding by gateway class",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Sharding: &v1alpha1.KubernetesSharding{
+								ShardBy:       ptr.To(v1alpha1.ShardingKeyTypeGatewayClass),
+								LeaseDuration: ptr.To(v1.Duration("30s")),
+								RenewPeriod:   ptr.To(v1.Duration("10s")),
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "unsupported sharding key",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Sharding: &v1alpha1.KubernetesSharding{ShardBy: ptr.To(v1alpha1.ShardingKeyType("Namespace"))},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "sharding renew period longer than lease duration",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Sharding: &v1alpha1.KubernetesSharding{RenewPeriod: ptr.To(v1.Duration("20s"))},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "invalid sharding lease duration",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Sharding: &v1alpha1.KubernetesSharding{LeaseDuration: ptr.To(v1.Duration("forever"))},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "sharding with global ratelimit",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway: v1alpha1.DefaultGateway(),
+					Provider: &v1alpha1.EnvoyGatewayProvider{
+						Type: v1alpha1.ProviderTypeKubernetes,
+						Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+							Sharding: &v1alpha1.KubernetesSharding{},
+						},
+					},
+					RateLimit: &v1alpha1.RateLimit{
+						Backend: v1alpha1.RateLimitDatabaseBackend{
+							Type:  v1alpha1.RedisBackendType,
+							Redis: &v1alpha1.RateLimitRedisSettings{URL: "localhost:6379"},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "valid update coalescing",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:          v1alpha1.DefaultGateway(),
+					Provider:         v1alpha1.DefaultEnvoyGatewayProvider(),
+					UpdateCoalescing: &v1alpha1.EnvoyGatewayUpdateCoalescing{Debounce: "100ms"},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "valid update coalescing with max delay",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:          v1alpha1.DefaultGateway(),
+					Provider:         v1alpha1.DefaultEnvoyGatewayProvider(),
+					UpdateCoalescing: &v1alpha1.EnvoyGatewayUpdateCoalescing{Debounce: "100ms", MaxDelay: ptr.To(v1.Duration("1s"))},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "invalid update coalescing debounce",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:          v1alpha1.DefaultGateway(),
+					Provider:         v1alpha1.DefaultEnvoyGatewayProvider(),
+					UpdateCoalescing: &v1alpha1.EnvoyGatewayUpdateCoalescing{Debounce: "soon"},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "zero update coalescing debounce",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:          v1alpha1.DefaultGateway(),
+					Provider:         v1alpha1.DefaultEnvoyGatewayProvider(),
+					UpdateCoalescing: &v1alpha1.EnvoyGatewayUpdateCoalescing{Debounce: "0s"},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "update coalescing max delay shorter than debounce",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:          v1alpha1.DefaultGateway(),
+					Provider:         v1alpha1.DefaultEnvoyGatewayProvider(),
+					UpdateCoalescing: &v1alpha1.EnvoyGatewayUpdateCoalescing{Debounce: "1s", MaxDelay: ptr.To(v1.Duration("100ms"))},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "valid tracing",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					Telemetry: &v1alpha1.EnvoyGatewayTelemetry{
+						Tracing: &v1alpha1.EnvoyGatewayTracing{
+							SamplingRate: ptr.To[uint32](10),
+							Sinks: []v1alpha1.EnvoyGatewayTraceSink{{
+								Type:          v1alpha1.TraceSinkTypeOpenTelemetry,
+								OpenTelemetry: &v1alpha1.EnvoyGatewayOpenTelemetrySink{Host: "otel-collector.monitoring", Protocol: "grpc", Port: 4317},
+							}},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "tracing sampling rate above 100",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					Telemetry: &v1alpha1.EnvoyGatewayTelemetry{
+						Tracing: &v1alpha1.EnvoyGatewayTracing{SamplingRate: ptr.To[uint32](101)},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "tracing sink without OpenTelemetry",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					Telemetry: &v1alpha1.EnvoyGatewayTelemetry{
+						Tracing: &v1alpha1.EnvoyGatewayTracing{
+							Sinks: []v1alpha1.EnvoyGatewayTraceSink{{Type: v1alpha1.TraceSinkTypeOpenTelemetry}},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "valid xds snapshot file persistence",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					XdsServer: &v1alpha1.EnvoyGatewayXdsServer{
+						SnapshotPersistence: &v1alpha1.XdsSnapshotPersistence{
+							Type: v1alpha1.XdsSnapshotPersistenceTypeFile,
+							File: &v1alpha1.XdsSnapshotFilePersistence{Path: "/var/lib/envoy-gateway/xds"},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "unsupported xds snapshot persistence",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					XdsServer: &v1alpha1.EnvoyGatewayXdsServer{
+						SnapshotPersistence: &v1alpha1.XdsSnapshotPersistence{
+							Type: "ConfigMap",
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "empty xds snapshot persistence file path",
+			eg: &v1alpha1.EnvoyGateway{
+				EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+					Gateway:  v1alpha1.DefaultGateway(),
+					Provider: v1alpha1.DefaultEnvoyGatewayProvider(),
+					XdsServer: &v1alpha1.EnvoyGatewayXdsServer{
+						SnapshotPersistence: &v1alpha1.XdsSnapshotPersistence{
+							Type: v1alpha1.XdsSnapshotPersistenceTypeFile,
+							File: &v1alpha1.XdsSnapshotFilePersistence{},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
 	}
 
 	for _, tc := range testCases {
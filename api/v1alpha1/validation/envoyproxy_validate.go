@@ -15,6 +15,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/utils/proto"
@@ -75,6 +77,15 @@ func validateProvider(spec *egv1a1.EnvoyProxySpec) []error {
 		if len(validateDeploymentErrs) != 0 {
 			errs = append(errs, validateDeploymentErrs...)
 		}
+		validateDaemonSetErrs := validateDaemonSet(spec)
+		if len(validateDaemonSetErrs) != 0 {
+			errs = append(errs, validateDaemonSetErrs...)
+		}
+		if spec.Provider.Kubernetes != nil {
+			if err := validatePodDisruptionBudget("envoy", spec.Provider.Kubernetes.EnvoyPDB); err != nil {
+				errs = append(errs, err)
+			}
+		}
 		validateServiceErrs := validateService(spec)
 		if len(validateServiceErrs) != 0 {
 			errs = append(errs, validateServiceErrs...)
@@ -99,40 +110,105 @@ func validateDeployment(spec *egv1a1.EnvoyProxySpec) []error {
 }
 
 // TODO: remove this function if CEL validation became stable
-func validateService(spec *egv1a1.EnvoyProxySpec) []error {
+func validateDaemonSet(spec *egv1a1.EnvoyProxySpec) []error {
 	var errs []error
-	if spec.Provider.Kubernetes != nil && spec.Provider.Kubernetes.EnvoyService != nil {
-		if serviceType := spec.Provider.Kubernetes.EnvoyService.Type; serviceType != nil {
-			if *serviceType != egv1a1.ServiceTypeLoadBalancer &&
-				*serviceType != egv1a1.ServiceTypeClusterIP &&
-				*serviceType != egv1a1.ServiceTypeNodePort {
-				errs = append(errs, fmt.Errorf("unsupported envoy service type %v", serviceType))
-			}
+	if spec.Provider.Kubernetes != nil && spec.Provider.Kubernetes.EnvoyDaemonSet != nil {
+		if spec.Provider.Kubernetes.EnvoyDeployment != nil {
+			errs = append(errs, fmt.Errorf("only one of envoyDeployment or envoyDaemonSet can be specified"))
 		}
-		if serviceType, serviceAllocateLoadBalancerNodePorts :=
-			spec.Provider.Kubernetes.EnvoyService.Type, spec.Provider.Kubernetes.EnvoyService.AllocateLoadBalancerNodePorts; serviceType != nil && serviceAllocateLoadBalancerNodePorts != nil {
-			if *serviceType != egv1a1.ServiceTypeLoadBalancer {
-				errs = append(errs, fmt.Errorf("allocateLoadBalancerNodePorts can only be set for %v type", egv1a1.ServiceTypeLoadBalancer))
-			}
+		if spec.Provider.Kubernetes.EnvoyHpa != nil {
+			errs = append(errs, fmt.Errorf("envoyHpa can only be specified with envoyDeployment"))
 		}
-		if serviceType, serviceLoadBalancerIP := spec.Provider.Kubernetes.EnvoyService.Type, spec.Provider.Kubernetes.EnvoyService.LoadBalancerIP; serviceType != nil && serviceLoadBalancerIP != nil {
-			if *serviceType != egv1a1.ServiceTypeLoadBalancer {
-				errs = append(errs, fmt.Errorf("loadBalancerIP can only be set for %v type", egv1a1.ServiceTypeLoadBalancer))
-			}
-
-			if ip, err := netip.ParseAddr(*serviceLoadBalancerIP); err != nil || !ip.Unmap().Is4() {
-				errs = append(errs, fmt.Errorf("loadBalancerIP:%s is an invalid IPv4 address", *serviceLoadBalancerIP))
-			}
+		if spec.Provider.Kubernetes.EnvoyPDB != nil {
+			errs = append(errs, fmt.Errorf("envoyPDB can only be specified with envoyDeployment"))
 		}
-		if patch := spec.Provider.Kubernetes.EnvoyService.Patch; patch != nil {
+		if patch := spec.Provider.Kubernetes.EnvoyDaemonSet.Patch; patch != nil {
 			if patch.Value.Raw == nil {
-				errs = append(errs, fmt.Errorf("envoy service patch object cannot be empty"))
+				errs = append(errs, fmt.Errorf("envoy daemonset patch object cannot be empty"))
 			}
 			if patch.Type != nil && *patch.Type != egv1a1.JSONMerge && *patch.Type != egv1a1.StrategicMerge {
-				errs = append(errs, fmt.Errorf("unsupported envoy service patch type %s", *patch.Type))
+				errs = append(errs, fmt.Errorf("unsupported envoy daemonset patch type %s", *patch.Type))
 			}
 		}
+	}
+	return errs
+}
+
+// TODO: remove this function if CEL validation became stable
+func validatePodDisruptionBudget(name string, pdb *egv1a1.KubernetesPodDisruptionBudgetSpec) error {
+	if pdb == nil {
+		return nil
+	}
+	if (pdb.MinAvailable == nil) == (pdb.MaxUnavailable == nil) {
+		return fmt.Errorf("exactly one of minAvailable or maxUnavailable must be specified for the %s pdb", name)
+	}
+	for _, value := range []*intstr.IntOrString{pdb.MinAvailable, pdb.MaxUnavailable} {
+		if value == nil {
+			continue
+		}
+		if v, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true); err != nil || v < 0 {
+			return fmt.Errorf("invalid %s pdb value %s, must be a non-negative integer or percentage", name, value.String())
+		}
+	}
+	return nil
+}
 
+// TODO: remove this function if CEL validation became stable
+func validateService(spec *egv1a1.EnvoyProxySpec) []error {
+	var errs []error
+	if spec.Provider.Kubernetes == nil {
+		return errs
+	}
+
+	if spec.Provider.Kubernetes.EnvoyService != nil {
+		errs = append(errs, validateServiceSpec("envoy service", spec.Provider.Kubernetes.EnvoyService)...)
+	}
+
+	names := sets.New[string]()
+	for i := range spec.Provider.Kubernetes.EnvoyAdditionalServices {
+		service := &spec.Provider.Kubernetes.EnvoyAdditionalServices[i]
+		if names.Has(service.Name) {
+			errs = append(errs, fmt.Errorf("duplicate envoy additional service name %s", service.Name))
+		}
+		names.Insert(service.Name)
+		errs = append(errs, validateServiceSpec(fmt.Sprintf("envoy additional service %s", service.Name), &service.KubernetesServiceSpec)...)
+	}
+
+	return errs
+}
+
+// validateServiceSpec validates the spec of the Envoy service, or of an
+// additional Envoy service, described by name in the returned errors.
+func validateServiceSpec(name string, service *egv1a1.KubernetesServiceSpec) []error {
+	var errs []error
+	if serviceType := service.Type; serviceType != nil {
+		if *serviceType != egv1a1.ServiceTypeLoadBalancer &&
+			*serviceType != egv1a1.ServiceTypeClusterIP &&
+			*serviceType != egv1a1.ServiceTypeNodePort {
+			errs = append(errs, fmt.Errorf("unsupported %s type %v", name, serviceType))
+		}
+	}
+	if serviceType, serviceAllocateLoadBalancerNodePorts := service.Type, service.AllocateLoadBalancerNodePorts; serviceType != nil && serviceAllocateLoadBalancerNodePorts != nil {
+		if *serviceType != egv1a1.ServiceTypeLoadBalancer {
+			errs = append(errs, fmt.Errorf("allocateLoadBalancerNodePorts can only be set for %v type", egv1a1.ServiceTypeLoadBalancer))
+		}
+	}
+	if serviceType, serviceLoadBalancerIP := service.Type, service.LoadBalancerIP; serviceType != nil && serviceLoadBalancerIP != nil {
+		if *serviceType != egv1a1.ServiceTypeLoadBalancer {
+			errs = append(errs, fmt.Errorf("loadBalancerIP can only be set for %v type", egv1a1.ServiceTypeLoadBalancer))
+		}
+
+		if ip, err := netip.ParseAddr(*serviceLoadBalancerIP); err != nil || !ip.Unmap().Is4() {
+			errs = append(errs, fmt.Errorf("loadBalancerIP:%s is an invalid IPv4 address", *serviceLoadBalancerIP))
+		}
+	}
+	if patch := service.Patch; patch != nil {
+		if patch.Value.Raw == nil {
+			errs = append(errs, fmt.Errorf("%s patch object cannot be empty", name))
+		}
+		if patch.Type != nil && *patch.Type != egv1a1.JSONMerge && *patch.Type != egv1a1.StrategicMerge {
+			errs = append(errs, fmt.Errorf("unsupported %s patch type %s", name, *patch.Type))
+		}
 	}
 	return errs
 }
@@ -72,14 +72,23 @@ type WasmCodeSource struct {
 	HTTP *HTTPWasmCodeSource `json:"http,omitempty"`
 
 	// Image is the OCI image containing the wasm code.
+	// The wasm code is pulled and cached by Envoy Gateway, and served to the
+	// Envoy proxy over an authenticated HTTP endpoint, so that the Envoy proxy
+	// does not need the credentials of the registry.
+	// The image is pulled in the background, and the policy is not accepted,
+	// with the "Pending" reason, until it is pulled.
 	//
 	// Note that the image must be accessible from the Envoy Gateway.
 	// +optional
 	Image *ImageWasmCodeSource `json:"image,omitempty"`
 
 	// SHA256 checksum that will be used to verify the wasm code.
+	// It is required when the wasm code is fetched by the Envoy proxy from an HTTP URL.
+	// It is optional for an OCI image, whose wasm code is verified by Envoy Gateway
+	// against the digests of the image.
+	//
 	// +optional
-	// SHA256 *string `json:"sha256,omitempty"`
+	SHA256 *string `json:"sha256,omitempty"`
 }
 
 // WasmCodeSourceType specifies the types of sources for the wasm code.
@@ -106,6 +115,7 @@ type ImageWasmCodeSource struct {
 	URL string `json:"url"`
 
 	// PullSecretRef is a reference to the secret containing the credentials to pull the image.
+	// The secret must be of type kubernetes.io/dockerconfigjson.
 	PullSecretRef gwapiv1b1.SecretObjectReference `json:"pullSecret"`
 
 	// PullPolicy is the policy to use when pulling the image.
@@ -16,6 +16,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -892,6 +893,16 @@ func (in *EnvoyGatewayFileResourceProvider) DeepCopy() *EnvoyGatewayFileResource
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvoyGatewayHostInfrastructureProvider) DeepCopyInto(out *EnvoyGatewayHostInfrastructureProvider) {
 	*out = *in
+	if in.ConfigHome != nil {
+		in, out := &in.ConfigHome, &out.ConfigHome
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnvoyBinary != nil {
+		in, out := &in.EnvoyBinary, &out.EnvoyBinary
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayHostInfrastructureProvider.
@@ -910,7 +921,7 @@ func (in *EnvoyGatewayInfrastructureProvider) DeepCopyInto(out *EnvoyGatewayInfr
 	if in.Host != nil {
 		in, out := &in.Host, &out.Host
 		*out = new(EnvoyGatewayHostInfrastructureProvider)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -932,6 +943,11 @@ func (in *EnvoyGatewayKubernetesProvider) DeepCopyInto(out *EnvoyGatewayKubernet
 		*out = new(KubernetesDeploymentSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RateLimitPDB != nil {
+		in, out := &in.RateLimitPDB, &out.RateLimitPDB
+		*out = new(KubernetesPodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Watch != nil {
 		in, out := &in.Watch, &out.Watch
 		*out = new(KubernetesWatchMode)
@@ -940,7 +956,7 @@ func (in *EnvoyGatewayKubernetesProvider) DeepCopyInto(out *EnvoyGatewayKubernet
 	if in.Deploy != nil {
 		in, out := &in.Deploy, &out.Deploy
 		*out = new(KubernetesDeployMode)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.OverwriteControlPlaneCerts != nil {
 		in, out := &in.OverwriteControlPlaneCerts, &out.OverwriteControlPlaneCerts
@@ -952,6 +968,11 @@ func (in *EnvoyGatewayKubernetesProvider) DeepCopyInto(out *EnvoyGatewayKubernet
 		*out = new(LeaderElection)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Sharding != nil {
+		in, out := &in.Sharding, &out.Sharding
+		*out = new(KubernetesSharding)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayKubernetesProvider.
@@ -1151,6 +1172,16 @@ func (in *EnvoyGatewaySpec) DeepCopyInto(out *EnvoyGatewaySpec) {
 		*out = new(ExtensionAPISettings)
 		**out = **in
 	}
+	if in.XdsServer != nil {
+		in, out := &in.XdsServer, &out.XdsServer
+		*out = new(EnvoyGatewayXdsServer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdateCoalescing != nil {
+		in, out := &in.UpdateCoalescing, &out.UpdateCoalescing
+		*out = new(EnvoyGatewayUpdateCoalescing)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewaySpec.
@@ -1171,6 +1202,11 @@ func (in *EnvoyGatewayTelemetry) DeepCopyInto(out *EnvoyGatewayTelemetry) {
 		*out = new(EnvoyGatewayMetrics)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(EnvoyGatewayTracing)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayTelemetry.
@@ -1183,6 +1219,93 @@ func (in *EnvoyGatewayTelemetry) DeepCopy() *EnvoyGatewayTelemetry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyGatewayTraceSink) DeepCopyInto(out *EnvoyGatewayTraceSink) {
+	*out = *in
+	if in.OpenTelemetry != nil {
+		in, out := &in.OpenTelemetry, &out.OpenTelemetry
+		*out = new(EnvoyGatewayOpenTelemetrySink)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayTraceSink.
+func (in *EnvoyGatewayTraceSink) DeepCopy() *EnvoyGatewayTraceSink {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyGatewayTraceSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyGatewayTracing) DeepCopyInto(out *EnvoyGatewayTracing) {
+	*out = *in
+	if in.SamplingRate != nil {
+		in, out := &in.SamplingRate, &out.SamplingRate
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]EnvoyGatewayTraceSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayTracing.
+func (in *EnvoyGatewayTracing) DeepCopy() *EnvoyGatewayTracing {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyGatewayTracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyGatewayUpdateCoalescing) DeepCopyInto(out *EnvoyGatewayUpdateCoalescing) {
+	*out = *in
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayUpdateCoalescing.
+func (in *EnvoyGatewayUpdateCoalescing) DeepCopy() *EnvoyGatewayUpdateCoalescing {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyGatewayUpdateCoalescing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyGatewayXdsServer) DeepCopyInto(out *EnvoyGatewayXdsServer) {
+	*out = *in
+	if in.SnapshotPersistence != nil {
+		in, out := &in.SnapshotPersistence, &out.SnapshotPersistence
+		*out = new(XdsSnapshotPersistence)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyGatewayXdsServer.
+func (in *EnvoyGatewayXdsServer) DeepCopy() *EnvoyGatewayXdsServer {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyGatewayXdsServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvoyJSONPatchConfig) DeepCopyInto(out *EnvoyJSONPatchConfig) {
 	*out = *in
@@ -1316,16 +1439,33 @@ func (in *EnvoyProxyKubernetesProvider) DeepCopyInto(out *EnvoyProxyKubernetesPr
 		*out = new(KubernetesDeploymentSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EnvoyDaemonSet != nil {
+		in, out := &in.EnvoyDaemonSet, &out.EnvoyDaemonSet
+		*out = new(KubernetesDaemonSetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnvoyService != nil {
 		in, out := &in.EnvoyService, &out.EnvoyService
 		*out = new(KubernetesServiceSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EnvoyAdditionalServices != nil {
+		in, out := &in.EnvoyAdditionalServices, &out.EnvoyAdditionalServices
+		*out = make([]KubernetesAdditionalServiceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.EnvoyHpa != nil {
 		in, out := &in.EnvoyHpa, &out.EnvoyHpa
 		*out = new(KubernetesHorizontalPodAutoscalerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EnvoyPDB != nil {
+		in, out := &in.EnvoyPDB, &out.EnvoyPDB
+		*out = new(KubernetesPodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyProxyKubernetesProvider.
@@ -1566,6 +1706,11 @@ func (in *ExtensionManager) DeepCopyInto(out *ExtensionManager) {
 		*out = make([]GroupVersionKind, len(*in))
 		copy(*out, *in)
 	}
+	if in.PolicyResources != nil {
+		in, out := &in.PolicyResources, &out.PolicyResources
+		*out = make([]GroupVersionKind, len(*in))
+		copy(*out, *in)
+	}
 	if in.Hooks != nil {
 		in, out := &in.Hooks, &out.Hooks
 		*out = new(ExtensionHooks)
@@ -1576,6 +1721,16 @@ func (in *ExtensionManager) DeepCopyInto(out *ExtensionManager) {
 		*out = new(ExtensionService)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(ExtensionRetry)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionManager.
@@ -1588,9 +1743,44 @@ func (in *ExtensionManager) DeepCopy() *ExtensionManager {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionRetry) DeepCopyInto(out *ExtensionRetry) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int)
+		**out = **in
+	}
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionRetry.
+func (in *ExtensionRetry) DeepCopy() *ExtensionRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtensionService) DeepCopyInto(out *ExtensionService) {
 	*out = *in
+	if in.Unix != nil {
+		in, out := &in.Unix, &out.Unix
+		*out = new(ExtensionUnixSocket)
+		**out = **in
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(ExtensionTLS)
@@ -1612,6 +1802,16 @@ func (in *ExtensionService) DeepCopy() *ExtensionService {
 func (in *ExtensionTLS) DeepCopyInto(out *ExtensionTLS) {
 	*out = *in
 	in.CertificateRef.DeepCopyInto(&out.CertificateRef)
+	if in.ClientCertificateRef != nil {
+		in, out := &in.ClientCertificateRef, &out.ClientCertificateRef
+		*out = new(v1.SecretObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubjectAltNames != nil {
+		in, out := &in.SubjectAltNames, &out.SubjectAltNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionTLS.
@@ -1624,6 +1824,21 @@ func (in *ExtensionTLS) DeepCopy() *ExtensionTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionUnixSocket) DeepCopyInto(out *ExtensionUnixSocket) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionUnixSocket.
+func (in *ExtensionUnixSocket) DeepCopy() *ExtensionUnixSocket {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionUnixSocket)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FaultInjection) DeepCopyInto(out *FaultInjection) {
 	*out = *in
@@ -2214,6 +2429,27 @@ func (in *JWTProvider) DeepCopy() *JWTProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAdditionalServiceSpec) DeepCopyInto(out *KubernetesAdditionalServiceSpec) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]v1.PortNumber, len(*in))
+		copy(*out, *in)
+	}
+	in.KubernetesServiceSpec.DeepCopyInto(&out.KubernetesServiceSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAdditionalServiceSpec.
+func (in *KubernetesAdditionalServiceSpec) DeepCopy() *KubernetesAdditionalServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAdditionalServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesContainerSpec) DeepCopyInto(out *KubernetesContainerSpec) {
 	*out = *in
@@ -2258,9 +2494,56 @@ func (in *KubernetesContainerSpec) DeepCopy() *KubernetesContainerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesDaemonSetSpec) DeepCopyInto(out *KubernetesDaemonSetSpec) {
+	*out = *in
+	if in.Patch != nil {
+		in, out := &in.Patch, &out.Patch
+		*out = new(KubernetesPatchSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(appsv1.DaemonSetUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pod != nil {
+		in, out := &in.Pod, &out.Pod
+		*out = new(KubernetesPodSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Container != nil {
+		in, out := &in.Container, &out.Container
+		*out = new(KubernetesContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesDaemonSetSpec.
+func (in *KubernetesDaemonSetSpec) DeepCopy() *KubernetesDaemonSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesDaemonSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesDeployMode) DeepCopyInto(out *KubernetesDeployMode) {
 	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(KubernetesDeployModeType)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesDeployMode.
@@ -2378,6 +2661,31 @@ func (in *KubernetesPatchSpec) DeepCopy() *KubernetesPatchSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesPodDisruptionBudgetSpec) DeepCopyInto(out *KubernetesPodDisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesPodDisruptionBudgetSpec.
+func (in *KubernetesPodDisruptionBudgetSpec) DeepCopy() *KubernetesPodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesPodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesPodSpec) DeepCopyInto(out *KubernetesPodSpec) {
 	*out = *in
@@ -2502,6 +2810,36 @@ func (in *KubernetesServiceSpec) DeepCopy() *KubernetesServiceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesSharding) DeepCopyInto(out *KubernetesSharding) {
+	*out = *in
+	if in.ShardBy != nil {
+		in, out := &in.ShardBy, &out.ShardBy
+		*out = new(ShardingKeyType)
+		**out = **in
+	}
+	if in.LeaseDuration != nil {
+		in, out := &in.LeaseDuration, &out.LeaseDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewPeriod != nil {
+		in, out := &in.RenewPeriod, &out.RenewPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSharding.
+func (in *KubernetesSharding) DeepCopy() *KubernetesSharding {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesSharding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesWatchMode) DeepCopyInto(out *KubernetesWatchMode) {
 	*out = *in
@@ -2813,6 +3151,47 @@ func (in *PerRetryPolicy) DeepCopy() *PerRetryPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTargetReference) DeepCopyInto(out *PolicyTargetReference) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(v1.Namespace)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTargetReference.
+func (in *PolicyTargetReference) DeepCopy() *PolicyTargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyTargetReferenceWithSectionName) DeepCopyInto(out *PolicyTargetReferenceWithSectionName) {
+	*out = *in
+	in.PolicyTargetReference.DeepCopyInto(&out.PolicyTargetReference)
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(v1.SectionName)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyTargetReferenceWithSectionName.
+func (in *PolicyTargetReferenceWithSectionName) DeepCopy() *PolicyTargetReferenceWithSectionName {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyTargetReferenceWithSectionName)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyAccessLog) DeepCopyInto(out *ProxyAccessLog) {
 	*out = *in
@@ -3851,6 +4230,11 @@ func (in *WasmCodeSource) DeepCopyInto(out *WasmCodeSource) {
 		*out = new(ImageWasmCodeSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SHA256 != nil {
+		in, out := &in.SHA256, &out.SHA256
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmCodeSource.
@@ -3876,6 +4260,11 @@ func (in *XDSTranslatorHooks) DeepCopyInto(out *XDSTranslatorHooks) {
 		*out = make([]XDSTranslatorHook, len(*in))
 		copy(*out, *in)
 	}
+	if in.FailOpen != nil {
+		in, out := &in.FailOpen, &out.FailOpen
+		*out = make([]XDSTranslatorHook, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XDSTranslatorHooks.
@@ -3907,3 +4296,38 @@ func (in *XForwardedForSettings) DeepCopy() *XForwardedForSettings {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XdsSnapshotFilePersistence) DeepCopyInto(out *XdsSnapshotFilePersistence) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XdsSnapshotFilePersistence.
+func (in *XdsSnapshotFilePersistence) DeepCopy() *XdsSnapshotFilePersistence {
+	if in == nil {
+		return nil
+	}
+	out := new(XdsSnapshotFilePersistence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XdsSnapshotPersistence) DeepCopyInto(out *XdsSnapshotPersistence) {
+	*out = *in
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(XdsSnapshotFilePersistence)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XdsSnapshotPersistence.
+func (in *XdsSnapshotPersistence) DeepCopy() *XdsSnapshotPersistence {
+	if in == nil {
+		return nil
+	}
+	out := new(XdsSnapshotPersistence)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -60,3 +60,11 @@ Create the name of the service account to use
 {{- default "default" .Values.serviceAccount.name }}
 {{- end }}
 {{- end }}
+
+{{/*
+Whether the Gateways are sharded across the Envoy Gateway replicas, which then run
+as a StatefulSet so that each replica has a stable DNS name.
+*/}}
+{{- define "eg.sharded" -}}
+{{- if dig "provider" "kubernetes" "sharding" "" .Values.config.envoyGateway }}true{{- end }}
+{{- end }}
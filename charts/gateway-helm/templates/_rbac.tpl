@@ -42,6 +42,7 @@ verbs:
 apiGroups:
 - apps
 resources:
+- daemonsets
 - deployments
 verbs:
 - get
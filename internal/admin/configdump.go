@@ -0,0 +1,358 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/status/sink"
+)
+
+// ConfigDumpSources holds the messages exchanged by the runners, whose latest
+// values are served by the admin server when the config dump is enabled.
+type ConfigDumpSources struct {
+	ProviderResources *message.ProviderResources
+	XdsIR             *message.XdsIR
+	InfraIR           *message.InfraIR
+	// XdsSnapshots returns the last xDS snapshot held by the cache of the
+	// xDS server for each IR key.
+	XdsSnapshots func() map[string]*cachev3.Snapshot
+
+	// dumpSecrets is true if the secrets are dumped, instead of being redacted.
+	dumpSecrets bool
+}
+
+// redacted replaces the secrets in the config dumps.
+var redacted = []byte("[redacted]")
+
+// xdsResourceTypes are the types of the xDS resources served by Envoy Gateway,
+// by the name used to filter them.
+var xdsResourceTypes = []struct {
+	name    string
+	typeURL string
+}{
+	{name: "cluster", typeURL: resourcev3.ClusterType},
+	{name: "endpoint", typeURL: resourcev3.EndpointType},
+	{name: "listener", typeURL: resourcev3.ListenerType},
+	{name: "route", typeURL: resourcev3.RouteType},
+	{name: "secret", typeURL: resourcev3.SecretType},
+}
+
+// configDumpFilters are the filters of a config dump, set with the "gatewayClass",
+// "irKey" and "type" query parameters. Empty filters match everything.
+type configDumpFilters struct {
+	gatewayClass string
+	irKey        string
+	// types are the lower case names of the resource types to dump.
+	types sets.Set[string]
+}
+
+// registerConfigDumpHandlers serves the latest values of every stage of the
+// translation pipeline. The secrets are redacted, unless dumpSecrets is true.
+func registerConfigDumpHandlers(handlers *http.ServeMux, sources *ConfigDumpSources, dumpSecrets bool) {
+	s := *sources
+	s.dumpSecrets = dumpSecrets
+	sources = &s
+	handlers.Handle("/api/config_dump/resources", configDumpHandler(sources.dumpResources))
+	handlers.Handle("/api/config_dump/xds_ir", configDumpHandler(sources.dumpXdsIR))
+	handlers.Handle("/api/config_dump/infra_ir", configDumpHandler(sources.dumpInfraIR))
+	handlers.Handle("/api/config_dump/statuses", configDumpHandler(sources.dumpStatuses))
+	handlers.Handle("/api/config_dump/xds", configDumpHandler(sources.dumpXds))
+}
+
+// configDumpHandler serves the dump matching the filters of the request, as JSON,
+// or as YAML if the "output" query parameter is set to "yaml".
+func configDumpHandler(dump func(configDumpFilters) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		output := query.Get("output")
+		if output != "" && output != "json" && output != "yaml" {
+			http.Error(w, fmt.Sprintf("unsupported output %s, must be json or yaml", output), http.StatusBadRequest)
+			return
+		}
+		filters := configDumpFilters{
+			gatewayClass: query.Get("gatewayClass"),
+			irKey:        query.Get("irKey"),
+			types:        sets.New[string](),
+		}
+		for _, t := range strings.Split(query.Get("type"), ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				filters.types.Insert(strings.ToLower(t))
+			}
+		}
+
+		v, err := dump(filters)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		contentType := "application/json"
+		if output == "yaml" {
+			if data, err = yaml.JSONToYAML(data); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			contentType = "application/yaml"
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write(data)
+	}
+}
+
+// matchesType returns whether the resource type is dumped.
+func (f configDumpFilters) matchesType(name string) bool {
+	return f.types.Len() == 0 || f.types.Has(strings.ToLower(name))
+}
+
+// filterTypes returns the fields of the JSON object of v whose names match the
+// resource types of the filters, or v if the filters have no resource types.
+func (f configDumpFilters) filterTypes(v any) (any, error) {
+	if f.types.Len() == 0 {
+		return v, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name := range fields {
+		if !f.matchesType(name) {
+			delete(fields, name)
+		}
+	}
+	return fields, nil
+}
+
+// dumpResources dumps the Gateway API and other related resources by GatewayClass.
+// The resource types are the fields of the resources, e.g. "httpRoutes".
+func (s *ConfigDumpSources) dumpResources(f configDumpFilters) (any, error) {
+	dump := map[string]any{}
+	for _, resources := range s.ProviderResources.GetResources() {
+		if resources == nil || resources.GatewayClass == nil {
+			continue
+		}
+		if f.gatewayClass != "" && resources.GatewayClass.Name != f.gatewayClass {
+			continue
+		}
+		if !s.dumpSecrets {
+			r := *resources
+			r.Secrets = redactSecrets(resources.Secrets)
+			resources = &r
+		}
+		v, err := f.filterTypes(resources)
+		if err != nil {
+			return nil, err
+		}
+		dump[resources.GatewayClass.Name] = v
+	}
+	return dump, nil
+}
+
+// dumpXdsIR dumps the xDS IRs by IR key. The resource types are the fields of
+// the IR, e.g. "http".
+func (s *ConfigDumpSources) dumpXdsIR(f configDumpFilters) (any, error) {
+	dump := map[string]any{}
+	for key, xdsIR := range s.XdsIR.LoadAll() {
+		if f.irKey != "" && key != f.irKey {
+			continue
+		}
+		if !s.dumpSecrets {
+			xdsIR = xdsIR.Printable()
+		}
+		v, err := f.filterTypes(xdsIR)
+		if err != nil {
+			return nil, err
+		}
+		dump[key] = v
+	}
+	return dump, nil
+}
+
+// dumpInfraIR dumps the infra IRs by IR key. The resource types are the fields
+// of the IR, e.g. "proxy".
+func (s *ConfigDumpSources) dumpInfraIR(f configDumpFilters) (any, error) {
+	dump := map[string]any{}
+	for key, infraIR := range s.InfraIR.LoadAll() {
+		if f.irKey != "" && key != f.irKey {
+			continue
+		}
+		v, err := f.filterTypes(infraIR)
+		if err != nil {
+			return nil, err
+		}
+		dump[key] = v
+	}
+	return dump, nil
+}
+
+// dumpStatuses dumps the statuses computed for the resources, sorted by kind,
+// namespace and name. The resource types are the kinds of the resources.
+func (s *ConfigDumpSources) dumpStatuses(f configDumpFilters) (any, error) {
+	r := s.ProviderResources
+	list := []sink.ResourceStatus{}
+	list = appendStatuses(list, f, gatewayapi.KindGateway, r.GatewayStatuses.LoadAll())
+	list = appendStatuses(list, f, gatewayapi.KindHTTPRoute, r.HTTPRouteStatuses.LoadAll())
+	list = appendStatuses(list, f, gatewayapi.KindGRPCRoute, r.GRPCRouteStatuses.LoadAll())
+	list = appendStatuses(list, f, gatewayapi.KindTLSRoute, r.TLSRouteStatuses.LoadAll())
+	list = appendStatuses(list, f, gatewayapi.KindTCPRoute, r.TCPRouteStatuses.LoadAll())
+	list = appendStatuses(list, f, gatewayapi.KindUDPRoute, r.UDPRouteStatuses.LoadAll())
+	list = appendStatuses(list, f, v1alpha1.KindClientTrafficPolicy, r.ClientTrafficPolicyStatuses.LoadAll())
+	list = appendStatuses(list, f, v1alpha1.KindBackendTrafficPolicy, r.BackendTrafficPolicyStatuses.LoadAll())
+	list = appendStatuses(list, f, v1alpha1.KindEnvoyPatchPolicy, r.EnvoyPatchPolicyStatuses.LoadAll())
+	list = appendStatuses(list, f, v1alpha1.KindSecurityPolicy, r.SecurityPolicyStatuses.LoadAll())
+	list = appendStatuses(list, f, gatewayapi.KindBackendTLSPolicy, r.BackendTLSPolicyStatuses.LoadAll())
+	list = appendStatuses(list, f, v1alpha1.KindEnvoyExtensionPolicy, r.EnvoyExtensionPolicyStatuses.LoadAll())
+	for key, status := range r.ExtensionPolicyStatuses.LoadAll() {
+		list = appendStatuses(list, f, key.Kind, map[types.NamespacedName]any{key.NamespacedName: status})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Kind != list[j].Kind {
+			return list[i].Kind < list[j].Kind
+		}
+		if list[i].Namespace != list[j].Namespace {
+			return list[i].Namespace < list[j].Namespace
+		}
+		return list[i].Name < list[j].Name
+	})
+	return list, nil
+}
+
+func appendStatuses[V any](list []sink.ResourceStatus, f configDumpFilters, kind string, statuses map[types.NamespacedName]V) []sink.ResourceStatus {
+	if !f.matchesType(kind) {
+		return list
+	}
+	for key, status := range statuses {
+		list = append(list, sink.ResourceStatus{
+			Kind:      kind,
+			Namespace: key.Namespace,
+			Name:      key.Name,
+			Status:    status,
+		})
+	}
+	return list
+}
+
+// dumpXds dumps the xDS snapshots held by the cache of the xDS server by IR key,
+// with their version and resources sorted by name. The resource types are the
+// types of the xDS resources, e.g. "listener".
+func (s *ConfigDumpSources) dumpXds(f configDumpFilters) (any, error) {
+	dump := map[string]any{}
+	if s.XdsSnapshots == nil {
+		return dump, nil
+	}
+	for key, snapshot := range s.XdsSnapshots() {
+		if f.irKey != "" && key != f.irKey {
+			continue
+		}
+		snapshotDump := map[string]any{
+			"version": snapshot.GetVersion(resourcev3.ListenerType),
+		}
+		for _, t := range xdsResourceTypes {
+			if !f.matchesType(t.name) {
+				continue
+			}
+			resources := snapshot.GetResources(t.typeURL)
+			names := make([]string, 0, len(resources))
+			for name := range resources {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			list := make([]json.RawMessage, 0, len(names))
+			for _, name := range names {
+				resource := resources[name]
+				if secret, ok := resource.(*tlsv3.Secret); ok && !s.dumpSecrets {
+					resource = redactXdsSecret(secret)
+				}
+				data, err := protojson.Marshal(resource)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, data)
+			}
+			snapshotDump[t.name] = list
+		}
+		dump[key] = snapshotDump
+	}
+	return dump, nil
+}
+
+// redactSecrets returns a copy of the Secrets, with their data redacted.
+func redactSecrets(secrets []*corev1.Secret) []*corev1.Secret {
+	out := make([]*corev1.Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		secret = secret.DeepCopy()
+		for key := range secret.Data {
+			secret.Data[key] = redacted
+		}
+		for key := range secret.StringData {
+			secret.StringData[key] = string(redacted)
+		}
+		out = append(out, secret)
+	}
+	return out
+}
+
+// redactXdsSecret returns a copy of the xDS Secret, with its private keys and
+// secrets redacted.
+func redactXdsSecret(secret *tlsv3.Secret) *tlsv3.Secret {
+	secret = proto.Clone(secret).(*tlsv3.Secret)
+	redactedSource := func() *corev3.DataSource {
+		return &corev3.DataSource{Specifier: &corev3.DataSource_InlineBytes{InlineBytes: redacted}}
+	}
+	switch t := secret.Type.(type) {
+	case *tlsv3.Secret_TlsCertificate:
+		if t.TlsCertificate.PrivateKey != nil {
+			t.TlsCertificate.PrivateKey = redactedSource()
+		}
+		if t.TlsCertificate.Password != nil {
+			t.TlsCertificate.Password = redactedSource()
+		}
+	case *tlsv3.Secret_GenericSecret:
+		if t.GenericSecret.Secret != nil {
+			t.GenericSecret.Secret = redactedSource()
+		}
+	case *tlsv3.Secret_SessionTicketKeys:
+		for i := range t.SessionTicketKeys.Keys {
+			t.SessionTicketKeys.Keys[i] = redactedSource()
+		}
+	}
+	return secret
+}
@@ -0,0 +1,215 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package admin
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/message"
+)
+
+func newTestConfigDumpServer(t *testing.T, dumpSecrets bool) *httptest.Server {
+	pResources := new(message.ProviderResources)
+	resources := gatewayapi.NewResources()
+	resources.GatewayClass = &gwapiv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "eg"}}
+	resources.Gateways = append(resources.Gateways, &gwapiv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "default"}})
+	resources.HTTPRoutes = append(resources.HTTPRoutes, &gwapiv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"}})
+	resources.Secrets = append(resources.Secrets, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "default"},
+		Data:       map[string][]byte{"tls.key": []byte("key")},
+	})
+	pResources.GatewayAPIResources.Store("gateway.envoyproxy.io/gatewayclass-controller", &gatewayapi.ControllerResources{resources})
+	pResources.GatewayStatuses.Store(ktypes.NamespacedName{Namespace: "default", Name: "gateway"}, &gwapiv1.GatewayStatus{})
+	pResources.HTTPRouteStatuses.Store(ktypes.NamespacedName{Namespace: "default", Name: "route"}, &gwapiv1.HTTPRouteStatus{})
+
+	xdsIR := new(message.XdsIR)
+	xdsIR.Store("default/gateway", &ir.Xds{HTTP: []*ir.HTTPListener{{Name: "default/gateway/http", Port: 10080}}})
+	xdsIR.Store("default/other", &ir.Xds{HTTP: []*ir.HTTPListener{{
+		Name: "default/other/https",
+		TLS:  &ir.TLSConfig{Certificates: []ir.TLSCertificate{{Name: "tls", PrivateKey: []byte("key")}}},
+	}}})
+	infraIR := new(message.InfraIR)
+	infraIR.Store("default/gateway", &ir.Infra{Proxy: &ir.ProxyInfra{Name: "default/gateway"}})
+
+	snapshot, err := cachev3.NewSnapshot("1", map[resourcev3.Type][]types.Resource{
+		resourcev3.ListenerType: {&listenerv3.Listener{Name: "default/gateway/http"}},
+		resourcev3.SecretType: {&tlsv3.Secret{Name: "tls", Type: &tlsv3.Secret_TlsCertificate{
+			TlsCertificate: &tlsv3.TlsCertificate{
+				PrivateKey: &corev3.DataSource{Specifier: &corev3.DataSource_InlineBytes{InlineBytes: []byte("key")}},
+			},
+		}}},
+	})
+	require.NoError(t, err)
+
+	handlers := http.NewServeMux()
+	registerConfigDumpHandlers(handlers, &ConfigDumpSources{
+		ProviderResources: pResources,
+		XdsIR:             xdsIR,
+		InfraIR:           infraIR,
+		XdsSnapshots: func() map[string]*cachev3.Snapshot {
+			return map[string]*cachev3.Snapshot{"default/gateway": snapshot}
+		},
+	}, dumpSecrets)
+	srv := httptest.NewServer(handlers)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestConfigDump(t *testing.T) {
+	srv := newTestConfigDumpServer(t, false)
+
+	testCases := []struct {
+		name     string
+		path     string
+		wantCode int
+		want     string
+	}{
+		{
+			name:     "resources filtered by type",
+			path:     "/api/config_dump/resources?gatewayClass=eg&type=gateways",
+			wantCode: http.StatusOK,
+			want:     `{"eg":{"gateways":[{"metadata":{"creationTimestamp":null,"name":"gateway","namespace":"default"},"spec":{"gatewayClassName":"","listeners":null},"status":{}}]}}`,
+		},
+		{
+			name:     "resources of another gateway class",
+			path:     "/api/config_dump/resources?gatewayClass=other",
+			wantCode: http.StatusOK,
+			want:     `{}`,
+		},
+		{
+			name:     "xds ir filtered by ir key",
+			path:     "/api/config_dump/xds_ir?irKey=default/gateway&type=http",
+			wantCode: http.StatusOK,
+			want:     `{"default/gateway":{"http":[{"name":"default/gateway/http","address":"","port":10080,"hostnames":null,"isHTTP2":false,"path":{"mergeSlashes":false,"escapedSlashesAction":""}}]}}`,
+		},
+		{
+			name:     "infra ir",
+			path:     "/api/config_dump/infra_ir",
+			wantCode: http.StatusOK,
+			want:     `{"default/gateway":{"proxy":{"name":"default/gateway"}}}`,
+		},
+		{
+			name:     "statuses filtered by kind",
+			path:     "/api/config_dump/statuses?type=httproute",
+			wantCode: http.StatusOK,
+			want:     `[{"kind":"HTTPRoute","namespace":"default","name":"route","status":{"parents":null}}]`,
+		},
+		{
+			name:     "xds snapshot filtered by type",
+			path:     "/api/config_dump/xds?type=listener",
+			wantCode: http.StatusOK,
+			want:     `{"default/gateway":{"listener":[{"name":"default/gateway/http"}],"version":"1"}}`,
+		},
+		{
+			name:     "unsupported output",
+			path:     "/api/config_dump/xds?output=text",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := http.Get(srv.URL + tc.path)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, tc.wantCode, resp.StatusCode)
+			if tc.wantCode != http.StatusOK {
+				return
+			}
+			require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.want, string(body))
+		})
+	}
+}
+
+func TestConfigDumpSecrets(t *testing.T) {
+	testCases := []struct {
+		name        string
+		dumpSecrets bool
+		path        string
+		want        string
+	}{
+		{
+			name: "redacted secrets",
+			path: "/api/config_dump/resources?type=secrets",
+			want: `{"eg":{"secrets":[{"metadata":{"creationTimestamp":null,"name":"tls","namespace":"default"},"data":{"tls.key":"W3JlZGFjdGVkXQ=="}}]}}`,
+		},
+		{
+			name:        "secrets",
+			dumpSecrets: true,
+			path:        "/api/config_dump/resources?type=secrets",
+			want:        `{"eg":{"secrets":[{"metadata":{"creationTimestamp":null,"name":"tls","namespace":"default"},"data":{"tls.key":"a2V5"}}]}}`,
+		},
+		{
+			name: "redacted xds ir private keys",
+			path: "/api/config_dump/xds_ir?irKey=default/other&type=http",
+			want: `{"default/other":{"http":[{"name":"default/other/https","address":"","port":0,"hostnames":null,"isHTTP2":false,"path":{"mergeSlashes":false,"escapedSlashesAction":""},"tls":{"certificates":[{"name":"tls","privateKey":"W3JlZGFjdGVkXQ=="}]}}]}}`,
+		},
+		{
+			name: "redacted xds private keys",
+			path: "/api/config_dump/xds?type=secret",
+			want: `{"default/gateway":{"secret":[{"name":"tls","tlsCertificate":{"privateKey":{"inlineBytes":"W3JlZGFjdGVkXQ=="}}}],"version":"1"}}`,
+		},
+		{
+			name:        "xds private keys",
+			dumpSecrets: true,
+			path:        "/api/config_dump/xds?type=secret",
+			want:        `{"default/gateway":{"secret":[{"name":"tls","tlsCertificate":{"privateKey":{"inlineBytes":"a2V5"}}}],"version":"1"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newTestConfigDumpServer(t, tc.dumpSecrets)
+			resp, err := http.Get(srv.URL + tc.path)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.want, string(body))
+		})
+	}
+}
+
+func TestConfigDumpYAML(t *testing.T) {
+	srv := newTestConfigDumpServer(t, false)
+
+	resp, err := http.Get(srv.URL + "/api/config_dump/infra_ir?irKey=default/gateway&output=yaml")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/yaml", resp.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	data, err := yaml.YAMLToJSON(body)
+	require.NoError(t, err)
+	var dump map[string]any
+	require.NoError(t, json.Unmarshal(data, &dump))
+	require.Equal(t, map[string]any{"default/gateway": map[string]any{"proxy": map[string]any{"name": "default/gateway"}}}, dump)
+}
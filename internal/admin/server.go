@@ -15,28 +15,37 @@ import (
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/internal/status/sink"
+	"github.com/envoyproxy/gateway/internal/supervisor"
 )
 
 var (
 	adminLogger = logging.DefaultLogger(v1alpha1.LogLevelInfo).WithName("admin")
 )
 
-func Init(cfg *config.Server) error {
+// Init starts the admin server. The statuses recorded by the status store,
+// if any, are served on "/api/status". If the config dump is enabled, the
+// latest values of the config dump sources, if any, are served on "/api/config_dump/".
+// The health and readiness of the runners supervised by sup, if any, are
+// served on "/healthz" and "/readyz".
+func Init(cfg *config.Server, statusStore *sink.Store, configDumpSources *ConfigDumpSources, sup *supervisor.Supervisor) error {
 	if cfg.EnvoyGateway.GetEnvoyGatewayAdmin().EnableDumpConfig {
 		spewConfig := spew.NewDefaultConfig()
 		spewConfig.DisableMethods = true
 		spewConfig.Dump(cfg)
 	}
 
-	return start(cfg)
+	return start(cfg, statusStore, configDumpSources, sup)
 }
 
-func start(cfg *config.Server) error {
+func start(cfg *config.Server, statusStore *sink.Store, configDumpSources *ConfigDumpSources, sup *supervisor.Supervisor) error {
 	handlers := http.NewServeMux()
 	address := cfg.EnvoyGateway.GetEnvoyGatewayAdminAddress()
 	enablePprof := cfg.EnvoyGateway.GetEnvoyGatewayAdmin().EnablePprof
+	enableDumpConfig := cfg.EnvoyGateway.GetEnvoyGatewayAdmin().EnableDumpConfig
 
-	adminLogger.Info("starting admin server", "address", address, "enablePprof", enablePprof)
+	adminLogger.Info("starting admin server", "address", address, "enablePprof", enablePprof,
+		"enableDumpConfig", enableDumpConfig)
 
 	if enablePprof {
 		// Serve pprof endpoints to aid in live debugging.
@@ -47,6 +56,23 @@ func start(cfg *config.Server) error {
 		handlers.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
 	}
 
+	if statusStore != nil {
+		handlers.Handle("/api/status", statusStore)
+	}
+
+	if sup != nil {
+		// Serve the health of every runner, and of each runner on its own path.
+		handlers.Handle(supervisor.HealthzPath, sup.HealthzHandler())
+		handlers.Handle(supervisor.HealthzPath+"/", sup.HealthzHandler())
+		handlers.Handle(supervisor.ReadyzPath, sup.ReadyzHandler())
+		handlers.Handle(supervisor.ReadyzPath+"/", sup.ReadyzHandler())
+	}
+
+	if enableDumpConfig && configDumpSources != nil {
+		// Serve the latest values of every stage of the translation pipeline.
+		registerConfigDumpHandlers(handlers, configDumpSources, cfg.EnvoyGateway.GetEnvoyGatewayAdmin().DumpConfigSecrets)
+	}
+
 	adminServer := &http.Server{
 		Handler:           handlers,
 		Addr:              address,
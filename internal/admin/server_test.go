@@ -12,6 +12,7 @@ import (
 
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/status/sink"
 )
 
 func TestInitAdminServer(t *testing.T) {
@@ -20,6 +21,6 @@ func TestInitAdminServer(t *testing.T) {
 			EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{},
 		},
 	}
-	err := Init(svrConfig)
+	err := Init(svrConfig, sink.NewStore(), nil, nil)
 	require.NoError(t, err)
 }
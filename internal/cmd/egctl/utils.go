@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -86,6 +87,9 @@ func newGatewayScheme() (*runtime.Scheme, error) {
 	if err := gwv1a2.AddToScheme(scheme); err != nil {
 		return nil, err
 	}
+	if err := gwv1a3.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
 	if err := egv1a1.AddToScheme(scheme); err != nil {
 		return nil, err
 	}
@@ -6,19 +6,33 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
 	"github.com/spf13/cobra"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/envoyproxy/gateway/internal/admin"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	extensionregistry "github.com/envoyproxy/gateway/internal/extension/registry"
 	gatewayapirunner "github.com/envoyproxy/gateway/internal/gatewayapi/runner"
 	ratelimitrunner "github.com/envoyproxy/gateway/internal/globalratelimit/runner"
+	"github.com/envoyproxy/gateway/internal/infrastructure/host"
 	infrarunner "github.com/envoyproxy/gateway/internal/infrastructure/runner"
 	"github.com/envoyproxy/gateway/internal/logging"
 	"github.com/envoyproxy/gateway/internal/message"
 	"github.com/envoyproxy/gateway/internal/metrics"
 	providerrunner "github.com/envoyproxy/gateway/internal/provider/runner"
+	"github.com/envoyproxy/gateway/internal/sharding"
+	"github.com/envoyproxy/gateway/internal/status/sink"
+	"github.com/envoyproxy/gateway/internal/supervisor"
+	"github.com/envoyproxy/gateway/internal/tracing"
+	"github.com/envoyproxy/gateway/internal/wasm"
+	"github.com/envoyproxy/gateway/internal/xds/bootstrap"
 	xdsserverrunner "github.com/envoyproxy/gateway/internal/xds/server/runner"
 	xdstranslatorrunner "github.com/envoyproxy/gateway/internal/xds/translator/runner"
 )
@@ -28,6 +42,9 @@ var (
 	cfgPath string
 )
 
+// tracingShutdownTimeout bounds the flush of the pending traces on shutdown.
+const tracingShutdownTimeout = 5 * time.Second
+
 // getServerCommand returns the server cobra command to be executed.
 func getServerCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -51,17 +68,31 @@ func server() error {
 		return err
 	}
 
-	// Init eg admin servers.
-	if err := admin.Init(cfg); err != nil {
-		return err
-	}
+	// The status store keeps the statuses of the managed resources,
+	// and serves them over the admin server.
+	statusStore := sink.NewStore()
+
 	// Init eg metrics servers.
 	if err := metrics.Init(cfg); err != nil {
 		return err
 	}
 
+	// Init eg tracing.
+	// The pending traces are flushed when shutting down.
+	shutdownTracing, err := tracing.Init(cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			cfg.Logger.Error(err, "failed to shut down tracing")
+		}
+	}()
+
 	// init eg runners.
-	if err := setupRunners(cfg); err != nil {
+	if err := setupRunners(cfg, statusStore); err != nil {
 		return err
 	}
 
@@ -110,7 +141,7 @@ func getConfigByPath(cfgPath string) (*config.Server, error) {
 
 // setupRunners starts all the runners required for the Envoy Gateway to
 // fulfill its tasks.
-func setupRunners(cfg *config.Server) error {
+func setupRunners(cfg *config.Server, statusStore *sink.Store) error {
 	// TODO - Setup a Config Manager
 	// https://github.com/envoyproxy/gateway/issues/43
 	ctx := ctrl.SetupSignalHandler()
@@ -121,6 +152,30 @@ func setupRunners(cfg *config.Server) error {
 		return err
 	}
 
+	// Setup the Wasm Cache
+	// It pulls the wasm modules of the OCI images used by EnvoyExtensionPolicies,
+	// which are served to the Envoy Proxies by the xDS Server.
+	wasmCache, err := newWasmCache(cfg)
+	if err != nil {
+		return err
+	}
+	// Wasm images can't be pulled without the cache directory, which is reported
+	// in the status of the EnvoyExtensionPolicies, but it is not fatal.
+	if err := wasmCache.Start(ctx); err != nil {
+		cfg.Logger.Error(err, "failed to start the wasm cache")
+	}
+
+	// Join the sharding of the Gateways if enabled
+	// Each replica then translates, and serves xDS for, the Gateways of its shard.
+	shard, err := startSharding(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Setup the Supervisor
+	// It restarts the runners whose goroutines panic, and reports their health.
+	sup := supervisor.New(cfg.Logger)
+
 	pResources := new(message.ProviderResources)
 	// Start the Provider Service
 	// It fetches the resources from the configured provider type
@@ -130,12 +185,15 @@ func setupRunners(cfg *config.Server) error {
 	providerRunner := providerrunner.New(&providerrunner.Config{
 		Server:            *cfg,
 		ProviderResources: pResources,
+		StatusSinks:       []sink.Sink{statusStore},
+		Supervisor:        sup,
 	})
-	if err := providerRunner.Start(ctx); err != nil {
+	if err := sup.Start(ctx, providerRunner); err != nil {
 		return err
 	}
 
 	xdsIR := new(message.XdsIR)
+	xdsIRKeys := new(message.XdsIRKeys)
 	infraIR := new(message.InfraIR)
 	// Start the GatewayAPI Translator Runner
 	// It subscribes to the provider resources, translates it to xDS IR
@@ -144,25 +202,31 @@ func setupRunners(cfg *config.Server) error {
 		Server:            *cfg,
 		ProviderResources: pResources,
 		XdsIR:             xdsIR,
+		XdsIRKeys:         xdsIRKeys,
 		InfraIR:           infraIR,
 		ExtensionManager:  extMgr,
+		WasmCache:         wasmCache,
+		Shard:             shard,
 	})
-	if err := gwRunner.Start(ctx); err != nil {
+	if err := sup.Start(ctx, gwRunner); err != nil {
 		return err
 	}
 
 	xds := new(message.Xds)
+	xdsNACKs := new(message.XdsNACKs)
 	// Start the Xds Translator Service
 	// It subscribes to the xdsIR, translates it into xds Resources and publishes it.
-	// It also computes the EnvoyPatchPolicy statuses and publishes it.
+	// It also computes the EnvoyPatchPolicy statuses and publishes it, and
+	// maps the xDS responses rejected by the Envoy Proxies back to the resources.
 	xdsTranslatorRunner := xdstranslatorrunner.New(&xdstranslatorrunner.Config{
 		Server:            *cfg,
 		XdsIR:             xdsIR,
 		Xds:               xds,
+		XdsNACKs:          xdsNACKs,
 		ExtensionManager:  extMgr,
 		ProviderResources: pResources,
 	})
-	if err := xdsTranslatorRunner.Start(ctx); err != nil {
+	if err := sup.Start(ctx, xdsTranslatorRunner); err != nil {
 		return err
 	}
 
@@ -173,18 +237,34 @@ func setupRunners(cfg *config.Server) error {
 		Server:  *cfg,
 		InfraIR: infraIR,
 	})
-	if err := infraRunner.Start(ctx); err != nil {
+	if err := sup.Start(ctx, infraRunner); err != nil {
 		return err
 	}
 
 	// Start the xDS Server
 	// It subscribes to the xds Resources and configures the remote Envoy Proxy
-	// via the xDS Protocol.
+	// via the xDS Protocol. It publishes the xDS responses rejected by the
+	// Envoy Proxies.
 	xdsServerRunner := xdsserverrunner.New(&xdsserverrunner.Config{
-		Server: *cfg,
-		Xds:    xds,
+		Server:      *cfg,
+		Xds:         xds,
+		XdsIRKeys:   xdsIRKeys,
+		XdsNACKs:    xdsNACKs,
+		WasmHandler: wasmCache,
 	})
-	if err := xdsServerRunner.Start(ctx); err != nil {
+	if err := sup.Start(ctx, xdsServerRunner); err != nil {
+		return err
+	}
+
+	// Init eg admin servers.
+	// It is started once the runners are, so that it can serve the config
+	// dump of their messages.
+	if err := admin.Init(cfg, statusStore, &admin.ConfigDumpSources{
+		ProviderResources: pResources,
+		XdsIR:             xdsIR,
+		InfraIR:           infraIR,
+		XdsSnapshots:      xdsServerRunner.XdsSnapshots,
+	}, sup); err != nil {
 		return err
 	}
 
@@ -196,7 +276,7 @@ func setupRunners(cfg *config.Server) error {
 			Server: *cfg,
 			XdsIR:  xdsIR,
 		})
-		if err := rateLimitRunner.Start(ctx); err != nil {
+		if err := sup.Start(ctx, rateLimitRunner); err != nil {
 			return err
 		}
 	}
@@ -206,8 +286,10 @@ func setupRunners(cfg *config.Server) error {
 	// Close messages
 	pResources.Close()
 	xdsIR.Close()
+	xdsIRKeys.Close()
 	infraIR.Close()
 	xds.Close()
+	xdsNACKs.Close()
 
 	cfg.Logger.Info("shutting down")
 
@@ -216,5 +298,67 @@ func setupRunners(cfg *config.Server) error {
 		mgr.CleanupHookConns()
 	}
 
+	// Stop the Envoy processes of the host infrastructure
+	infraRunner.Close()
+
 	return nil
 }
+
+// startSharding joins the replica to the sharding of the Gateways, and returns
+// its shard, or nil if the Gateways aren't sharded.
+func startSharding(ctx context.Context, cfg *config.Server) (*sharding.Shard, error) {
+	shardingCfg := cfg.EnvoyGateway.GetSharding()
+	if shardingCfg == nil {
+		return nil, nil
+	}
+
+	restCfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	cli, err := client.New(restCfg, client.Options{Scheme: envoygateway.GetScheme()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sharding client: %w", err)
+	}
+
+	shard := sharding.NewShard(sharding.Member{Name: cfg.PodName, Address: cfg.ReplicaAddress()})
+	sharding.NewLeaseMembership(cli, cfg.Namespace, shard,
+		shardingCfg.GetLeaseDuration(), shardingCfg.GetRenewPeriod(), cfg.Logger).Start(ctx)
+	return shard, nil
+}
+
+// wasmURLKeyFile is the key of the xDS server certificate of Envoy Gateway.
+const wasmURLKeyFile = "/certs/tls.key"
+
+// newWasmCache returns the cache of the wasm modules pulled by Envoy Gateway,
+// served to the Envoy Proxies by the wasm HTTP server of the xDS Server.
+func newWasmCache(cfg *config.Server) (*wasm.LocalCache, error) {
+	opts := wasm.CacheOptions{
+		Dir: wasm.DefaultCacheDir,
+		ServingURL: fmt.Sprintf("https://%s.%s.svc.%s:%d",
+			config.EnvoyGatewayServiceName, cfg.Namespace, cfg.DNSDomain, bootstrap.DefaultWasmServerPort),
+	}
+	// The Envoy Proxies of a shard pull the wasm modules from the replica owning it
+	if cfg.EnvoyGateway.GetSharding() != nil {
+		opts.ServingURL = fmt.Sprintf("https://%s:%d", cfg.ReplicaAddress(), bootstrap.DefaultWasmServerPort)
+	}
+	if cfg.EnvoyGateway.Provider.IsRunningOnHost() {
+		paths, err := host.GetPaths(cfg.EnvoyGateway.Provider.Custom.Infrastructure.Host)
+		if err != nil {
+			return nil, err
+		}
+		opts.Dir = paths.WasmCacheDir()
+		opts.ServingURL = fmt.Sprintf("https://localhost:%d", bootstrap.DefaultWasmServerPort)
+	} else {
+		// The replicas share the Secret of the xDS server certificate, so its
+		// key signs the URLs of the modules, which may be fetched from any replica.
+		// Otherwise, the URLs are only valid for the replica which returned them.
+		key, err := os.ReadFile(wasmURLKeyFile)
+		if err != nil {
+			cfg.Logger.Error(err, "failed to read the wasm URL key, using a random key")
+		}
+		opts.URLKey = key
+	}
+
+	return wasm.NewLocalCache(opts, cfg.Logger.WithName("wasm-cache")), nil
+}
@@ -71,6 +71,7 @@ const (
 // the CA Cert along with Envoy Gateway & Envoy certificates.
 type Certificates struct {
 	CACertificate             []byte
+	CAPrivateKey              []byte
 	EnvoyGatewayCertificate   []byte
 	EnvoyGatewayPrivateKey    []byte
 	EnvoyCertificate          []byte
@@ -109,9 +110,19 @@ func GenerateCerts(cfg *config.Server) (*Certificates, error) {
 		switch egProvider {
 		case v1alpha1.ProviderTypeKubernetes:
 			egDNSNames = kubeServiceNames(DefaultEnvoyGatewayDNSPrefix, cfg.Namespace, cfg.DNSDomain)
+			if cfg.EnvoyGateway.GetSharding() != nil {
+				// The Envoy proxies of a shard connect to the replica owning it with
+				// its DNS name under the headless service of the replicas.
+				egDNSNames = append(egDNSNames, fmt.Sprintf("*.%s.%s.svc.%s",
+					config.EnvoyGatewayReplicasServiceName, cfg.Namespace, cfg.DNSDomain))
+			}
 			envoyDNSNames = append(envoyDNSNames, fmt.Sprintf("*.%s", cfg.Namespace))
+		case v1alpha1.ProviderTypeCustom:
+			// Envoy connects to Envoy Gateway on the same host, and validates its
+			// certificate against the Envoy Gateway service name.
+			egDNSNames = []string{DefaultEnvoyGatewayDNSPrefix, "localhost"}
+			envoyDNSNames = append(envoyDNSNames, "localhost")
 		default:
-			// Kubernetes is the only supported Envoy Gateway provider.
 			return nil, fmt.Errorf("unsupported provider type %v", egProvider)
 		}
 
@@ -161,6 +172,7 @@ func GenerateCerts(cfg *config.Server) (*Certificates, error) {
 
 		return &Certificates{
 			CACertificate:             caCertPEM,
+			CAPrivateKey:              caKeyPEM,
 			EnvoyGatewayCertificate:   egCert,
 			EnvoyGatewayPrivateKey:    egKey,
 			EnvoyCertificate:          envoyCert,
@@ -175,6 +187,28 @@ func GenerateCerts(cfg *config.Server) (*Certificates, error) {
 	}
 }
 
+// GenerateEnvoyCert generates the xDS client certificate of the Envoy proxies deployed
+// to the given namespace, signed by the given CA and expiring along with it.
+// The return values are cert, key, err.
+func GenerateEnvoyCert(caCertPEM, caKeyPEM []byte, namespace string) ([]byte, []byte, error) {
+	block, _ := pem.Decode(caCertPEM)
+	if block == nil {
+		return nil, nil, fmt.Errorf("failed to decode CA certificate from PEM form")
+	}
+	caCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newCert(&certificateRequest{
+		caCertPEM:  caCertPEM,
+		caKeyPEM:   caKeyPEM,
+		expiry:     caCert.NotAfter,
+		commonName: DefaultEnvoyDNSPrefix,
+		altNames:   []string{fmt.Sprintf("*.%s", namespace)},
+	})
+}
+
 // newCert generates a new keypair based on the given the request.
 // The return values are cert, key, err.
 func newCert(request *certificateRequest) ([]byte, []byte, error) {
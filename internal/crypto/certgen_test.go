@@ -6,6 +6,7 @@
 package crypto
 
 import (
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 )
 
@@ -58,6 +60,81 @@ func TestGenerateCerts(t *testing.T) {
 	})
 }
 
+func TestGenerateEnvoyCert(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+	certs, err := GenerateCerts(cfg)
+	require.NoError(t, err)
+
+	cert, key, err := GenerateEnvoyCert(certs.CACertificate, certs.CAPrivateKey, "default")
+	require.NoError(t, err)
+	_, err = tls.X509KeyPair(cert, key)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(certs.CACertificate))
+	require.NoError(t, verifyCert(cert, roots, "eg.default", time.Now()))
+	require.Error(t, verifyCert(cert, roots, fmt.Sprintf("eg.%s", config.DefaultNamespace), time.Now()))
+}
+
+func TestGenerateCertsCustomProvider(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+	cfg.EnvoyGateway.Provider = &v1alpha1.EnvoyGatewayProvider{
+		Type: v1alpha1.ProviderTypeCustom,
+		Custom: &v1alpha1.EnvoyGatewayCustomProvider{
+			Resource: v1alpha1.EnvoyGatewayResourceProvider{
+				Type: v1alpha1.ResourceProviderTypeFile,
+				File: &v1alpha1.EnvoyGatewayFileResourceProvider{
+					Paths: []string{"/etc/envoy-gateway/resources"},
+				},
+			},
+			Infrastructure: v1alpha1.EnvoyGatewayInfrastructureProvider{
+				Type: v1alpha1.InfrastructureProviderTypeHost,
+				Host: &v1alpha1.EnvoyGatewayHostInfrastructureProvider{},
+			},
+		},
+	}
+
+	got, err := GenerateCerts(cfg)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	ok := roots.AppendCertsFromPEM(got.CACertificate)
+	require.Truef(t, ok, "Failed to set up CA cert for testing, maybe it's an invalid PEM")
+
+	now := time.Now()
+	require.NoError(t, verifyCert(got.EnvoyGatewayCertificate, roots, DefaultEnvoyGatewayDNSPrefix, now))
+	require.NoError(t, verifyCert(got.EnvoyGatewayCertificate, roots, "localhost", now))
+	require.NoError(t, verifyCert(got.EnvoyCertificate, roots, "localhost", now))
+}
+
+func TestGenerateCertsSharding(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+	replica := fmt.Sprintf("envoy-gateway-0.%s.%s.svc.%s",
+		config.EnvoyGatewayReplicasServiceName, config.DefaultNamespace, config.DefaultDNSDomain)
+
+	got, err := GenerateCerts(cfg)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(got.CACertificate))
+	// The replicas are only addressed individually when the Gateways are sharded
+	require.Error(t, verifyCert(got.EnvoyGatewayCertificate, roots, replica, time.Now()))
+
+	cfg.EnvoyGateway.Provider = &v1alpha1.EnvoyGatewayProvider{
+		Type: v1alpha1.ProviderTypeKubernetes,
+		Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+			Sharding: &v1alpha1.KubernetesSharding{},
+		},
+	}
+	got, err = GenerateCerts(cfg)
+	require.NoError(t, err)
+	roots = x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(got.CACertificate))
+	require.NoError(t, verifyCert(got.EnvoyGatewayCertificate, roots, replica, time.Now()))
+}
+
 func TestGeneratedValidKubeCerts(t *testing.T) {
 	now := time.Now()
 	expiry := now.Add(24 * 365 * time.Hour)
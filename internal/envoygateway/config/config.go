@@ -7,6 +7,8 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"sync"
 
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/api/v1alpha1/validation"
@@ -21,6 +23,10 @@ const (
 	DefaultDNSDomain = "cluster.local"
 	// EnvoyGatewayServiceName is the name of the Envoy Gateway service.
 	EnvoyGatewayServiceName = "envoy-gateway"
+	// EnvoyGatewayReplicasServiceName is the name of the headless service of the
+	// Envoy Gateway replicas, which gives each replica a stable DNS name when the
+	// Gateways are sharded.
+	EnvoyGatewayReplicasServiceName = "envoy-gateway-replicas"
 	// EnvoyPrefix is the prefix applied to the Envoy ConfigMap, Service, Deployment, and ServiceAccount.
 	EnvoyPrefix = "envoy"
 )
@@ -34,10 +40,16 @@ type Server struct {
 	Namespace string
 	// DNSDomain is the dns domain used by k8s services. Defaults to "cluster.local".
 	DNSDomain string
+	// PodName is the name of the Envoy Gateway pod, which identifies the replica
+	// when the Gateways are sharded across the replicas.
+	PodName string
 	// Logger is the logr implementation used by Envoy Gateway.
 	Logger logging.Logger
 	// Elected chan is used to signal what a leader is elected
 	Elected chan struct{}
+	// electedOnce guards the close of Elected, which is shared by the copies
+	// of the Server, as the providers signaling it are recreated on restart.
+	electedOnce *sync.Once
 }
 
 // New returns a Server with default parameters.
@@ -46,12 +58,22 @@ func New() (*Server, error) {
 		EnvoyGateway: v1alpha1.DefaultEnvoyGateway(),
 		Namespace:    env.Lookup("ENVOY_GATEWAY_NAMESPACE", DefaultNamespace),
 		DNSDomain:    env.Lookup("KUBERNETES_CLUSTER_DOMAIN", DefaultDNSDomain),
+		PodName:      env.Lookup("ENVOY_GATEWAY_POD_NAME", ""),
 		// the default logger
-		Logger:  logging.DefaultLogger(v1alpha1.LogLevelInfo),
-		Elected: make(chan struct{}),
+		Logger:      logging.DefaultLogger(v1alpha1.LogLevelInfo),
+		Elected:     make(chan struct{}),
+		electedOnce: new(sync.Once),
 	}, nil
 }
 
+// CloseElected closes Elected to signal that a leader is elected. It can be
+// called more than once, e.g. by a provider restarted by its supervisor.
+func (s *Server) CloseElected() {
+	s.electedOnce.Do(func() {
+		close(s.Elected)
+	})
+}
+
 // Validate validates a Server config.
 func (s *Server) Validate() error {
 	switch {
@@ -59,6 +81,8 @@ func (s *Server) Validate() error {
 		return errors.New("server config is unspecified")
 	case len(s.Namespace) == 0:
 		return errors.New("namespace is empty string")
+	case s.EnvoyGateway != nil && s.EnvoyGateway.GetSharding() != nil && len(s.PodName) == 0:
+		return errors.New("pod name must be set when the gateways are sharded")
 	}
 	if err := validation.ValidateEnvoyGateway(s.EnvoyGateway); err != nil {
 		return err
@@ -66,3 +90,11 @@ func (s *Server) Validate() error {
 
 	return nil
 }
+
+// ReplicaAddress returns the DNS name of the Envoy Gateway replica under the
+// headless service of the replicas, which the Envoy proxies of the shard of the
+// replica connect to when the Gateways are sharded. Unlike the pod IP, it doesn't
+// change when the pod of the replica is rescheduled.
+func (s *Server) ReplicaAddress() string {
+	return fmt.Sprintf("%s.%s.%s.svc.%s", s.PodName, EnvoyGatewayReplicasServiceName, s.Namespace, s.DNSDomain)
+}
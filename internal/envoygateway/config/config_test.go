@@ -60,6 +60,43 @@ func TestValidate(t *testing.T) {
 			},
 			expect: false,
 		},
+		{
+			name: "sharded with pod name",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway: v1alpha1.DefaultGateway(),
+						Provider: &v1alpha1.EnvoyGatewayProvider{
+							Type: v1alpha1.ProviderTypeKubernetes,
+							Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+								Sharding: &v1alpha1.KubernetesSharding{},
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+				PodName:   "envoy-gateway-0",
+			},
+			expect: true,
+		},
+		{
+			name: "sharded without pod name",
+			cfg: &Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Gateway: v1alpha1.DefaultGateway(),
+						Provider: &v1alpha1.EnvoyGatewayProvider{
+							Type: v1alpha1.ProviderTypeKubernetes,
+							Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+								Sharding: &v1alpha1.KubernetesSharding{},
+							},
+						},
+					},
+				},
+				Namespace: "test-ns",
+			},
+			expect: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -74,3 +111,10 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestReplicaAddress(t *testing.T) {
+	cfg, err := New()
+	require.NoError(t, err)
+	cfg.PodName = "envoy-gateway-1"
+	require.Equal(t, "envoy-gateway-1.envoy-gateway-replicas.envoy-gateway-system.svc.cluster.local", cfg.ReplicaAddress())
+}
@@ -10,6 +10,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	mcsapi "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
 
@@ -44,6 +45,9 @@ func init() {
 	if err := gwapiv1a2.AddToScheme(scheme); err != nil {
 		panic(err)
 	}
+	if err := gwapiv1a3.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
 	// Add mcs api types.
 	if err := mcsapi.AddToScheme(scheme); err != nil {
 		panic(err)
@@ -0,0 +1,148 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package registry
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	k8scli "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+// rewatchDelay is the delay before the Secret of the client certificate is
+// watched again, once its watch ended.
+const rewatchDelay = 5 * time.Second
+
+// clientCertificate is the client certificate presented to the extension
+// service. It is reloaded when its Secret is updated, so that the connections
+// established after a rotation present the new certificate.
+type clientCertificate struct {
+	client k8scli.WithWatch
+	secret types.NamespacedName
+	logger logging.Logger
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+	// resourceVersion is the resource version of the Secret of cert.
+	resourceVersion string
+}
+
+// newClientCertificate loads the client certificate from the Secret.
+func newClientCertificate(secret *corev1.Secret, client k8scli.WithWatch, logger logging.Logger) (*clientCertificate, error) {
+	c := &clientCertificate{
+		client: client,
+		secret: types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name},
+		logger: logger.WithValues("secret", fmt.Sprintf("%s/%s", secret.Namespace, secret.Name)),
+	}
+	if err := c.load(secret); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetClientCertificate returns the current client certificate, see
+// tls.Config.GetClientCertificate.
+func (c *clientCertificate) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cert, nil
+}
+
+// load parses the client certificate of the Secret. The current certificate is
+// kept if the Secret is invalid.
+func (c *clientCertificate) load(secret *corev1.Secret) error {
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("error parsing client certificate in Secret %s in namespace %s: %w",
+			secret.Name, secret.Namespace, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cert = &cert
+	c.resourceVersion = secret.ResourceVersion
+	return nil
+}
+
+// watch reloads the client certificate whenever its Secret is updated, until
+// ctx is done.
+func (c *clientCertificate) watch(ctx context.Context) {
+	for {
+		if err := c.watchOnce(ctx); err != nil {
+			c.logger.Error(err, "failed to watch the client certificate")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rewatchDelay):
+		}
+	}
+}
+
+// watchOnce reloads the client certificate from the Secret, then watches the
+// Secret from its resource version, until the watch ends or ctx is done.
+func (c *clientCertificate) watchOnce(ctx context.Context) error {
+	secret := &corev1.Secret{}
+	if err := c.client.Get(ctx, c.secret, secret); err != nil {
+		return err
+	}
+	c.reload(secret)
+
+	w, err := c.client.Watch(ctx, &corev1.SecretList{},
+		k8scli.InNamespace(c.secret.Namespace),
+		k8scli.MatchingFields{"metadata.name": c.secret.Name},
+		&k8scli.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: secret.ResourceVersion}})
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				if secret, ok := event.Object.(*corev1.Secret); ok && secret.Name == c.secret.Name {
+					c.reload(secret)
+				}
+			case watch.Error:
+				return fmt.Errorf("watch error: %v", event.Object)
+			}
+		}
+	}
+}
+
+// reload loads the client certificate of the Secret, unless it is already
+// loaded.
+func (c *clientCertificate) reload(secret *corev1.Secret) {
+	c.mu.RLock()
+	loaded := c.resourceVersion == secret.ResourceVersion
+	c.mu.RUnlock()
+	if loaded {
+		return
+	}
+
+	if err := c.load(secret); err != nil {
+		c.logger.Error(err, "failed to reload the client certificate, keeping the current one")
+		return
+	}
+	c.logger.Info("loaded the client certificate", "resourceVersion", secret.ResourceVersion)
+}
@@ -0,0 +1,77 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package registry
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/crypto"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+func TestClientCertificateReload(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+	certs, err := crypto.GenerateCerts(cfg)
+	require.NoError(t, err)
+	rotated, err := crypto.GenerateCerts(cfg)
+	require.NoError(t, err)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-certificate", Namespace: cfg.Namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certs.EnvoyCertificate,
+			corev1.TLSPrivateKeyKey: certs.EnvoyPrivateKey,
+		},
+	}
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(secret).Build()
+	require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(secret), secret))
+
+	cert, err := newClientCertificate(secret, cli, logging.DefaultLogger(v1alpha1.LogLevelInfo))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go cert.watch(ctx)
+
+	leaf := func() []byte {
+		c, err := cert.GetClientCertificate(&tls.CertificateRequestInfo{})
+		require.NoError(t, err)
+		return c.Certificate[0]
+	}
+	initial := leaf()
+
+	// A rotated certificate is presented once the Secret is updated.
+	secret.Data = map[string][]byte{
+		corev1.TLSCertKey:       rotated.EnvoyCertificate,
+		corev1.TLSPrivateKeyKey: rotated.EnvoyPrivateKey,
+	}
+	require.NoError(t, cli.Update(ctx, secret))
+	require.Eventually(t, func() bool {
+		return string(leaf()) != string(initial)
+	}, 10*time.Second, 10*time.Millisecond, "the rotated certificate was not loaded")
+	current := leaf()
+
+	// An invalid certificate keeps the current one.
+	secret.Data = map[string][]byte{corev1.TLSCertKey: []byte("invalid")}
+	require.NoError(t, cli.Update(ctx, secret))
+	require.Never(t, func() bool {
+		return string(leaf()) != string(current)
+	}, 500*time.Millisecond, 10*time.Millisecond, "the invalid certificate was loaded")
+}
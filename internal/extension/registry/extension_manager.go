@@ -7,9 +7,13 @@ package registry
 
 import (
 	"context"
+	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -25,34 +29,108 @@ import (
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	extTypes "github.com/envoyproxy/gateway/internal/extension/types"
 	"github.com/envoyproxy/gateway/internal/kubernetes"
+	"github.com/envoyproxy/gateway/internal/logging"
 	"github.com/envoyproxy/gateway/proto/extension"
 )
 
-const grpcServiceConfig = `{
-"methodConfig": [{
-	"name": [{"service": "envoygateway.extension.EnvoyGatewayExtension"}],
-	"waitForReady": true,
+const (
+	// defaultHookTimeout is the default timeout of a hook call, retries included.
+	defaultHookTimeout = 10 * time.Second
+	// defaultRetryMaxAttempts, defaultRetryInitialBackoff and defaultRetryMaxBackoff
+	// are the defaults of the retry policy of the hook calls.
+	defaultRetryMaxAttempts    = 4
+	defaultRetryInitialBackoff = 100 * time.Millisecond
+	defaultRetryMaxBackoff     = time.Second
+)
+
+// hookMethods are the methods of the extension service called by the pre and
+// post hooks of each hook type.
+var hookMethods = map[v1alpha1.XDSTranslatorHook][]string{
+	v1alpha1.XDSRoute:        {"PreRouteModify", "PostRouteModify"},
+	v1alpha1.XDSVirtualHost:  {"PostVirtualHostModify"},
+	v1alpha1.XDSHTTPListener: {"PreHTTPListenerModify", "PostHTTPListenerModify"},
+	v1alpha1.XDSTranslation:  {"PreTranslateModify", "PostTranslateModify"},
+	v1alpha1.XDSCluster:      {"PostClusterModify"},
+}
+
+// grpcServiceConfig returns the gRPC service config of the extension service,
+// which retries the calls failing with an UNAVAILABLE status. The calls wait for
+// the connection to be ready, except the calls of the fail open hooks, which
+// fail fast instead of blocking the translation until the timeout when the
+// extension is down.
+func grpcServiceConfig(ext *v1alpha1.ExtensionManager) string {
+	maxAttempts := defaultRetryMaxAttempts
+	initialBackoff := defaultRetryInitialBackoff
+	maxBackoff := defaultRetryMaxBackoff
+	if retry := ext.Retry; retry != nil {
+		if retry.MaxAttempts != nil {
+			maxAttempts = *retry.MaxAttempts
+		}
+		if retry.InitialBackoff != nil {
+			initialBackoff = retry.InitialBackoff.Duration
+		}
+		if retry.MaxBackoff != nil {
+			maxBackoff = retry.MaxBackoff.Duration
+		}
+	}
+	// A retry policy is only valid with more than one attempt.
+	retryPolicy := ""
+	if maxAttempts > 1 {
+		retryPolicy = fmt.Sprintf(`,
 	"retryPolicy": {
-		"MaxAttempts": 4,
-		"InitialBackoff": "0.1s",
-		"MaxBackoff": "1s",
+		"MaxAttempts": %d,
+		"InitialBackoff": "%s",
+		"MaxBackoff": "%s",
 		"BackoffMultiplier": 2.0,
 		"RetryableStatusCodes": [ "UNAVAILABLE" ]
+	}`, maxAttempts, durationString(initialBackoff), durationString(maxBackoff))
 	}
-}]}`
+
+	const service = "envoygateway.extension.EnvoyGatewayExtension"
+	// The configs of the methods of the fail open hooks take precedence over
+	// the config of the service.
+	var failOpenNames []string
+	if ext.Hooks != nil && ext.Hooks.XDSTranslator != nil {
+		for _, hook := range ext.Hooks.XDSTranslator.FailOpen {
+			for _, method := range hookMethods[hook] {
+				failOpenNames = append(failOpenNames, fmt.Sprintf(`{"service": %q, "method": %q}`, service, method))
+			}
+		}
+	}
+	methodConfigs := []string{fmt.Sprintf(`{
+	"name": [{"service": %q}],
+	"waitForReady": true%s
+}`, service, retryPolicy)}
+	if len(failOpenNames) > 0 {
+		methodConfigs = append(methodConfigs, fmt.Sprintf(`{
+	"name": [%s],
+	"waitForReady": false%s
+}`, strings.Join(failOpenNames, ", "), retryPolicy))
+	}
+	return `{
+"methodConfig": [` + strings.Join(methodConfigs, ", ") + `]}`
+}
+
+// durationString formats the duration as a JSON encoded protobuf Duration, e.g. "0.1s".
+func durationString(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
 
 var _ extTypes.Manager = (*Manager)(nil)
 
 type Manager struct {
-	k8sClient          k8scli.Client
+	k8sClient          k8scli.WithWatch
 	namespace          string
 	extension          v1alpha1.ExtensionManager
 	extensionConnCache *grpc.ClientConn
+	// cancelConn stops the watch of the client certificate of the connection.
+	cancelConn context.CancelFunc
+	logger     logging.Logger
 }
 
 // NewManager returns a new Manager
 func NewManager(cfg *config.Server) (extTypes.Manager, error) {
-	cli, err := k8scli.New(k8sclicfg.GetConfigOrDie(), k8scli.Options{Scheme: envoygateway.GetScheme()})
+	cli, err := k8scli.NewWithWatch(k8sclicfg.GetConfigOrDie(), k8scli.Options{Scheme: envoygateway.GetScheme()})
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +149,7 @@ func NewManager(cfg *config.Server) (extTypes.Manager, error) {
 		k8sClient: cli,
 		namespace: cfg.Namespace,
 		extension: *extension,
+		logger:    cfg.Logger.WithName("extension-manager"),
 	}, nil
 }
 
@@ -92,7 +171,6 @@ func (m *Manager) HasExtension(g v1.Group, k v1.Kind) bool {
 // If the extension makes use of the hook then the XDS Hook Client is returned. If it does not support
 // the hook type then nil is returned
 func (m *Manager) GetPreXDSHookClient(xdsHookType v1alpha1.XDSTranslatorHook) extTypes.XDSHookClient {
-	ctx := context.Background()
 	ext := m.extension
 
 	if ext.Hooks == nil {
@@ -114,26 +192,13 @@ func (m *Manager) GetPreXDSHookClient(xdsHookType v1alpha1.XDSTranslatorHook) ex
 	}
 
 	if m.extensionConnCache == nil {
-		serverAddr := fmt.Sprintf("%s:%d", ext.Service.Host, ext.Service.Port)
-
-		opts, err := setupGRPCOpts(ctx, m.k8sClient, &ext, m.namespace)
-		if err != nil {
-			return nil
-		}
-
-		conn, err := grpc.Dial(serverAddr, opts...)
-		if err != nil {
+		if err := m.connect(); err != nil {
 			return nil
 		}
-
-		m.extensionConnCache = conn
 	}
 
 	client := extension.NewEnvoyGatewayExtensionClient(m.extensionConnCache)
-	xdsHookClient := &XDSHook{
-		grpcClient: client,
-	}
-	return xdsHookClient
+	return m.newXDSHook(client)
 }
 
 // GetPostXDSHookClient checks if the registered extension makes use of a particular hook type that modifies
@@ -141,7 +206,6 @@ func (m *Manager) GetPreXDSHookClient(xdsHookType v1alpha1.XDSTranslatorHook) ex
 // If the extension makes use of the hook then the XDS Hook Client is returned. If it does not support
 // the hook type then nil is returned
 func (m *Manager) GetPostXDSHookClient(xdsHookType v1alpha1.XDSTranslatorHook) extTypes.XDSHookClient {
-	ctx := context.Background()
 	ext := m.extension
 
 	if ext.Hooks == nil {
@@ -163,32 +227,63 @@ func (m *Manager) GetPostXDSHookClient(xdsHookType v1alpha1.XDSTranslatorHook) e
 	}
 
 	if m.extensionConnCache == nil {
-		serverAddr := fmt.Sprintf("%s:%d", ext.Service.Host, ext.Service.Port)
-
-		opts, err := setupGRPCOpts(ctx, m.k8sClient, &ext, m.namespace)
-		if err != nil {
+		if err := m.connect(); err != nil {
 			return nil
 		}
+	}
 
-		conn, err := grpc.Dial(serverAddr, opts...)
-		if err != nil {
-			return nil
-		}
+	client := extension.NewEnvoyGatewayExtensionClient(m.extensionConnCache)
+	return m.newXDSHook(client)
+}
+
+// connect connects to the extension service. The client certificate, if any,
+// is watched until the connection is cleaned up.
+func (m *Manager) connect() error {
+	ext := m.extension
+	serverAddr := serverAddress(ext.Service)
 
-		m.extensionConnCache = conn
+	ctx, cancel := context.WithCancel(context.Background())
+	opts, err := setupGRPCOpts(ctx, m.k8sClient, &ext, m.namespace, m.logger)
+	if err != nil {
+		cancel()
+		m.logger.Error(err, "failed to setup the connection to the extension service")
+		return err
 	}
 
-	client := extension.NewEnvoyGatewayExtensionClient(m.extensionConnCache)
-	xdsHookClient := &XDSHook{
+	conn, err := grpc.Dial(serverAddr, opts...)
+	if err != nil {
+		cancel()
+		m.logger.Error(err, "failed to connect to the extension service", "address", serverAddr)
+		return err
+	}
+
+	m.extensionConnCache = conn
+	m.cancelConn = cancel
+	return nil
+}
+
+// newXDSHook returns an XDS Hook Client calling the hooks through the provided client,
+// with the call timeout and fail open hooks of the registered extension.
+func (m *Manager) newXDSHook(client extension.EnvoyGatewayExtensionClient) *XDSHook {
+	timeout := defaultHookTimeout
+	if m.extension.Timeout != nil {
+		timeout = m.extension.Timeout.Duration
+	}
+	return &XDSHook{
 		grpcClient: client,
+		timeout:    timeout,
+		failOpen:   m.extension.Hooks.XDSTranslator.FailOpen,
+		logger:     m.logger,
 	}
-	return xdsHookClient
 }
 
 func (m *Manager) CleanupHookConns() {
 	if m.extensionConnCache != nil {
 		m.extensionConnCache.Close()
 	}
+	if m.cancelConn != nil {
+		m.cancelConn()
+	}
 }
 
 func parseCA(caSecret *corev1.Secret) (*x509.CertPool, error) {
@@ -203,7 +298,17 @@ func parseCA(caSecret *corev1.Secret) (*x509.CertPool, error) {
 	return cp, nil
 }
 
-func setupGRPCOpts(ctx context.Context, client k8scli.Client, ext *v1alpha1.ExtensionManager, namespace string) ([]grpc.DialOption, error) {
+// serverAddress returns the gRPC target of the extension service.
+func serverAddress(svc *v1alpha1.ExtensionService) string {
+	if svc.Unix != nil {
+		return "unix://" + svc.Unix.Path
+	}
+	return fmt.Sprintf("%s:%d", svc.Host, svc.Port)
+}
+
+func setupGRPCOpts(ctx context.Context, client k8scli.WithWatch, ext *v1alpha1.ExtensionManager, namespace string,
+	logger logging.Logger,
+) ([]grpc.DialOption, error) {
 	// These two errors shouldn't happen since we check these conditions when loading the extension
 	if ext == nil {
 		return nil, errors.New("the registered extension's config is nil")
@@ -213,24 +318,96 @@ func setupGRPCOpts(ctx context.Context, client k8scli.Client, ext *v1alpha1.Exte
 	}
 
 	var opts []grpc.DialOption
-	var creds credentials.TransportCredentials
 	if ext.Service.TLS != nil {
-		certRef := ext.Service.TLS.CertificateRef
-		secret, secretNamespace, err := kubernetes.ValidateSecretObjectReference(ctx, client, &certRef, namespace)
+		tlsConfig, err := setupTLSConfig(ctx, client, ext.Service.TLS, namespace, logger)
 		if err != nil {
 			return nil, err
 		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	opts = append(opts, grpc.WithDefaultServiceConfig(grpcServiceConfig(ext)))
+	return opts, nil
+}
 
-		cp, err := parseCA(secret)
+// setupTLSConfig returns the TLS config used to connect to the extension server. The
+// certificate of the server is verified against the CA, and against the subject alt
+// names if any. The client certificate, if any, is presented for mutual TLS, and
+// is reloaded from its Secret until ctx is done.
+func setupTLSConfig(ctx context.Context, client k8scli.WithWatch, extTLS *v1alpha1.ExtensionTLS, namespace string,
+	logger logging.Logger,
+) (*tls.Config, error) {
+	certRef := extTLS.CertificateRef
+	secret, secretNamespace, err := kubernetes.ValidateSecretObjectReference(ctx, client, &certRef, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := parseCA(secret)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing cert in Secret %s in namespace %s", string(certRef.Name), secretNamespace)
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    cp,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if extTLS.ClientCertificateRef != nil {
+		clientCertRef := extTLS.ClientCertificateRef
+		secret, _, err := kubernetes.ValidateSecretObjectReference(ctx, client, clientCertRef, namespace)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing cert in Secret %s in namespace %s", string(certRef.Name), secretNamespace)
+			return nil, err
 		}
 
-		creds = credentials.NewClientTLSFromCert(cp, "")
-		opts = append(opts, grpc.WithTransportCredentials(creds))
-	} else {
-		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		cert, err := newClientCertificate(secret, client, logger)
+		if err != nil {
+			return nil, err
+		}
+		go cert.watch(ctx)
+		tlsConfig.GetClientCertificate = cert.GetClientCertificate
 	}
-	opts = append(opts, grpc.WithDefaultServiceConfig(grpcServiceConfig))
-	return opts, nil
+
+	if len(extTLS.SubjectAltNames) > 0 {
+		// The default verification checks the certificate against the server name,
+		// so it is replaced by the verification against the subject alt names.
+		sans := extTLS.SubjectAltNames
+		tlsConfig.InsecureSkipVerify = true // nolint:gosec
+		tlsConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+			return verifySubjectAltNames(cs, cp, sans)
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// verifySubjectAltNames verifies the certificate chain of the peer against the CA,
+// and verifies the certificate matches at least one of the subject alt names.
+func verifySubjectAltNames(cs tls.ConnectionState, roots *x509.CertPool, sans []string) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("no certificate presented by the extension server")
+	}
+
+	cert := cs.PeerCertificates[0]
+	intermediates := x509.NewCertPool()
+	for _, c := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(c)
+	}
+	if _, err := cert.Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates}); err != nil {
+		return err
+	}
+
+	for _, san := range sans {
+		// VerifyHostname matches the DNS names, wildcards included, and the IP addresses.
+		if cert.VerifyHostname(san) == nil {
+			return nil
+		}
+		for _, uri := range cert.URIs {
+			if uri.String() == san {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("the certificate of the extension server matches none of the subject alt names %v", sans)
 }
@@ -0,0 +1,208 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package registry
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/crypto"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/proto/extension"
+)
+
+func TestServerAddress(t *testing.T) {
+	require.Equal(t, "foo.extension:8080", serverAddress(&v1alpha1.ExtensionService{Host: "foo.extension", Port: 8080}))
+	require.Equal(t, "unix:///var/run/extension.sock", serverAddress(&v1alpha1.ExtensionService{
+		Unix: &v1alpha1.ExtensionUnixSocket{Path: "/var/run/extension.sock"},
+	}))
+}
+
+// startUnixExtensionServer serves the extension over a Unix domain socket, with the
+// server certificate of Envoy Gateway requiring a client certificate if certs is set.
+func startUnixExtensionServer(t *testing.T, certs *crypto.Certificates) string {
+	// The path of a Unix domain socket is limited to about a hundred characters.
+	dir, err := os.MkdirTemp("", "extension")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	path := filepath.Join(dir, "extension.sock")
+
+	lis, err := net.Listen("unix", path)
+	require.NoError(t, err)
+
+	var opts []grpc.ServerOption
+	if certs != nil {
+		cert, err := tls.X509KeyPair(certs.EnvoyGatewayCertificate, certs.EnvoyGatewayPrivateKey)
+		require.NoError(t, err)
+		cp := x509.NewCertPool()
+		require.True(t, cp.AppendCertsFromPEM(certs.CACertificate))
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{cert},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    cp,
+			MinVersion:   tls.VersionTLS12,
+		})))
+	}
+
+	s := grpc.NewServer(opts...)
+	extension.RegisterEnvoyGatewayExtensionServer(s, &fakeExtensionServer{})
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+	return path
+}
+
+func TestExtensionServiceConnection(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+	certs, err := crypto.GenerateCerts(cfg)
+	require.NoError(t, err)
+
+	secrets := []*corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: cfg.Namespace},
+			Data:       map[string][]byte{corev1.TLSCertKey: certs.CACertificate},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "client-certificate", Namespace: cfg.Namespace},
+			Type:       corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       certs.EnvoyCertificate,
+				corev1.TLSPrivateKeyKey: certs.EnvoyPrivateKey,
+			},
+		},
+	}
+	builder := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme())
+	for _, secret := range secrets {
+		builder = builder.WithObjects(secret)
+	}
+	cli := builder.Build()
+
+	testCases := []struct {
+		name         string
+		tls          *v1alpha1.ExtensionTLS
+		wantNoClient bool
+		wantErr      bool
+	}{
+		{
+			name: "plaintext",
+		},
+		{
+			name: "mtls with subject alt names",
+			tls: &v1alpha1.ExtensionTLS{
+				CertificateRef:       gwapiv1.SecretObjectReference{Name: "ca"},
+				ClientCertificateRef: &gwapiv1.SecretObjectReference{Name: "client-certificate"},
+				SubjectAltNames:      []string{"extension.example", "envoy-gateway.envoy-gateway-system"},
+			},
+		},
+		{
+			name: "mismatching subject alt names",
+			tls: &v1alpha1.ExtensionTLS{
+				CertificateRef:       gwapiv1.SecretObjectReference{Name: "ca"},
+				ClientCertificateRef: &gwapiv1.SecretObjectReference{Name: "client-certificate"},
+				SubjectAltNames:      []string{"extension.example"},
+			},
+			wantErr: true,
+		},
+		{
+			// The certificate of the server is verified against "localhost".
+			name: "mtls without subject alt names",
+			tls: &v1alpha1.ExtensionTLS{
+				CertificateRef:       gwapiv1.SecretObjectReference{Name: "ca"},
+				ClientCertificateRef: &gwapiv1.SecretObjectReference{Name: "client-certificate"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing client certificate",
+			tls: &v1alpha1.ExtensionTLS{
+				CertificateRef:  gwapiv1.SecretObjectReference{Name: "ca"},
+				SubjectAltNames: []string{"envoy-gateway"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown client certificate secret",
+			tls: &v1alpha1.ExtensionTLS{
+				CertificateRef:       gwapiv1.SecretObjectReference{Name: "ca"},
+				ClientCertificateRef: &gwapiv1.SecretObjectReference{Name: "unknown"},
+			},
+			wantNoClient: true,
+		},
+		{
+			name: "invalid client certificate secret",
+			tls: &v1alpha1.ExtensionTLS{
+				CertificateRef:       gwapiv1.SecretObjectReference{Name: "ca"},
+				ClientCertificateRef: &gwapiv1.SecretObjectReference{Name: "ca"},
+			},
+			wantNoClient: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serverCerts := certs
+			if tc.tls == nil {
+				serverCerts = nil
+			}
+			path := startUnixExtensionServer(t, serverCerts)
+
+			m := &Manager{
+				k8sClient: cli,
+				namespace: cfg.Namespace,
+				extension: v1alpha1.ExtensionManager{
+					Hooks: &v1alpha1.ExtensionHooks{
+						XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+							Post: []v1alpha1.XDSTranslatorHook{v1alpha1.XDSRoute},
+						},
+					},
+					Service: &v1alpha1.ExtensionService{
+						Unix: &v1alpha1.ExtensionUnixSocket{Path: path},
+						TLS:  tc.tls,
+					},
+					Retry: &v1alpha1.ExtensionRetry{MaxAttempts: ptr.To(1)},
+				},
+				logger: logging.DefaultLogger(v1alpha1.LogLevelInfo),
+			}
+			t.Cleanup(m.CleanupHookConns)
+
+			hook := m.GetPostXDSHookClient(v1alpha1.XDSRoute)
+			if tc.wantNoClient {
+				require.Nil(t, hook)
+				return
+			}
+			require.NotNil(t, hook)
+
+			modified, err := hook.PostRouteModifyHook(&route.Route{Name: "route"}, nil, nil, nil)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "route-modified", modified.Name)
+		})
+	}
+}
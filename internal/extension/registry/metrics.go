@@ -0,0 +1,20 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package registry
+
+import "github.com/envoyproxy/gateway/internal/metrics"
+
+var (
+	extensionHookDurationSeconds = metrics.NewHistogram("extension_hook_duration_seconds", "How long in seconds an extension hook call takes.", []float64{0.001, 0.01, 0.1, 1, 5, 10})
+
+	extensionHookTotal = metrics.NewCounter("extension_hook_total", "Total number of extension hook calls.")
+
+	extensionHookErrorsTotal = metrics.NewCounter("extension_hook_errors_total", "Total number of extension hook call errors.")
+
+	hookLabel = metrics.NewLabel("hook")
+
+	failOpenLabel = metrics.NewLabel("fail_open")
+)
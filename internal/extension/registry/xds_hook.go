@@ -7,6 +7,11 @@ package registry
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
 
 	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
@@ -14,7 +19,10 @@ import (
 	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/extension/types"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/logging"
 	"github.com/envoyproxy/gateway/proto/extension"
 )
 
@@ -22,90 +30,338 @@ var _ types.XDSHookClient = (*XDSHook)(nil)
 
 type XDSHook struct {
 	grpcClient extension.EnvoyGatewayExtensionClient
+	// timeout is the timeout of a hook call, retries included.
+	timeout time.Duration
+	// failOpen holds the hooks whose failures are ignored.
+	failOpen []v1alpha1.XDSTranslatorHook
+	logger   logging.Logger
 }
 
-func (h *XDSHook) PostRouteModifyHook(route *route.Route, routeHostnames []string, extensionResources []*unstructured.Unstructured) (*route.Route, error) {
-	// Take all of the unstructured resources for the extension and package them into bytes
-	extensionResourceBytes := []*extension.ExtensionResource{}
-	for _, res := range extensionResources {
-		if res != nil {
-			unstructuredBytes, err := res.MarshalJSON()
-			// This is probably a programming error, but just return the unmodified route if so
-			if err != nil {
-				return route, err
-			}
+// call calls an extension hook within the call timeout, and records its metrics.
+// The failure of a hook failing open is logged and nil is returned, in which case
+// the response is not set and the hook returns the unmodified resources.
+func (h *XDSHook) call(name string, hook v1alpha1.XDSTranslatorHook, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
 
-			extensionResourceBytes = append(extensionResourceBytes,
-				&extension.ExtensionResource{
-					UnstructuredBytes: unstructuredBytes,
-				},
-			)
-		}
+	start := time.Now()
+	err := fn(ctx)
+	extensionHookDurationSeconds.With(hookLabel.Value(name)).Record(time.Since(start).Seconds())
+	extensionHookTotal.With(hookLabel.Value(name)).Increment()
+	if err == nil {
+		return nil
+	}
+
+	failOpen := slices.Contains(h.failOpen, hook)
+	extensionHookErrorsTotal.With(hookLabel.Value(name), failOpenLabel.Value(strconv.FormatBool(failOpen))).Increment()
+	if !failOpen {
+		return fmt.Errorf("extension hook %s failed: %w", name, err)
+	}
+	h.logger.Error(err, "extension hook failed, ignoring the failure as the hook fails open", "hook", name)
+	return nil
+}
+
+func (h *XDSHook) PostRouteModifyHook(route *route.Route, routeHostnames []string, extensionResources, extensionPolicies []*unstructured.Unstructured) (*route.Route, error) {
+	// Take all of the unstructured resources for the extension and package them into bytes
+	extensionResourceBytes, err := marshalExtensionResources(extensionResources)
+	// This is probably a programming error, but just return the unmodified route if so
+	if err != nil {
+		return route, err
+	}
+	extensionPolicyBytes, err := marshalExtensionResources(extensionPolicies)
+	if err != nil {
+		return route, err
 	}
 
 	// Make the request to the extension server
-	ctx := context.Background()
-	resp, err := h.grpcClient.PostRouteModify(ctx,
-		&extension.PostRouteModifyRequest{
-			Route: route,
-			PostRouteContext: &extension.PostRouteExtensionContext{
-				Hostnames:          routeHostnames,
-				ExtensionResources: extensionResourceBytes,
-			},
-		})
+	var resp *extension.PostRouteModifyResponse
+	err = h.call("PostRouteModify", v1alpha1.XDSRoute, func(ctx context.Context) (err error) {
+		resp, err = h.grpcClient.PostRouteModify(ctx,
+			&extension.PostRouteModifyRequest{
+				Route: route,
+				PostRouteContext: &extension.PostRouteExtensionContext{
+					Hostnames:          routeHostnames,
+					ExtensionResources: extensionResourceBytes,
+					ExtensionPolicies:  extensionPolicyBytes,
+				},
+			})
+		return err
+	})
 
 	if err != nil {
 		return nil, err
 	}
+	// The hook failed open
+	if resp == nil {
+		return route, nil
+	}
 
 	return resp.Route, nil
 }
 
-func (h *XDSHook) PostVirtualHostModifyHook(vh *route.VirtualHost) (*route.VirtualHost, error) {
+func (h *XDSHook) PostVirtualHostModifyHook(vh *route.VirtualHost, extensionPolicies []*unstructured.Unstructured) (*route.VirtualHost, error) {
+	// Take all of the unstructured policies for the extension and package them into bytes
+	extensionPolicyBytes, err := marshalExtensionResources(extensionPolicies)
+	// This is probably a programming error, but just return the unmodified virtual host if so
+	if err != nil {
+		return vh, err
+	}
+
 	// Make the request to the extension server
-	ctx := context.Background()
-	resp, err := h.grpcClient.PostVirtualHostModify(ctx,
-		&extension.PostVirtualHostModifyRequest{
-			VirtualHost:            vh,
-			PostVirtualHostContext: &extension.PostVirtualHostExtensionContext{},
-		})
+	var resp *extension.PostVirtualHostModifyResponse
+	err = h.call("PostVirtualHostModify", v1alpha1.XDSVirtualHost, func(ctx context.Context) (err error) {
+		resp, err = h.grpcClient.PostVirtualHostModify(ctx,
+			&extension.PostVirtualHostModifyRequest{
+				VirtualHost: vh,
+				PostVirtualHostContext: &extension.PostVirtualHostExtensionContext{
+					ExtensionPolicies: extensionPolicyBytes,
+				},
+			})
+		return err
+	})
 
 	if err != nil {
 		return nil, err
 	}
+	// The hook failed open
+	if resp == nil {
+		return vh, nil
+	}
 
 	return resp.VirtualHost, nil
 }
 
-func (h *XDSHook) PostHTTPListenerModifyHook(l *listener.Listener) (*listener.Listener, error) {
+func (h *XDSHook) PostHTTPListenerModifyHook(l *listener.Listener, extensionPolicies []*unstructured.Unstructured) (*listener.Listener, error) {
+	// Take all of the unstructured policies for the extension and package them into bytes
+	extensionPolicyBytes, err := marshalExtensionResources(extensionPolicies)
+	// This is probably a programming error, but just return the unmodified listener if so
+	if err != nil {
+		return l, err
+	}
+
 	// Make the request to the extension server
-	ctx := context.Background()
-	resp, err := h.grpcClient.PostHTTPListenerModify(ctx,
-		&extension.PostHTTPListenerModifyRequest{
-			Listener:            l,
-			PostListenerContext: &extension.PostHTTPListenerExtensionContext{},
-		})
+	var resp *extension.PostHTTPListenerModifyResponse
+	err = h.call("PostHTTPListenerModify", v1alpha1.XDSHTTPListener, func(ctx context.Context) (err error) {
+		resp, err = h.grpcClient.PostHTTPListenerModify(ctx,
+			&extension.PostHTTPListenerModifyRequest{
+				Listener: l,
+				PostListenerContext: &extension.PostHTTPListenerExtensionContext{
+					ExtensionPolicies: extensionPolicyBytes,
+				},
+			})
+		return err
+	})
 
 	if err != nil {
 		return nil, err
 	}
+	// The hook failed open
+	if resp == nil {
+		return l, nil
+	}
 
 	return resp.Listener, nil
 }
 
 func (h *XDSHook) PostTranslateModifyHook(clusters []*cluster.Cluster, secrets []*tls.Secret) ([]*cluster.Cluster, []*tls.Secret, error) {
 	// Make the request to the extension server
-	ctx := context.Background()
-	resp, err := h.grpcClient.PostTranslateModify(ctx,
-		&extension.PostTranslateModifyRequest{
-			PostTranslateContext: &extension.PostTranslateExtensionContext{},
-			Clusters:             clusters,
-			Secrets:              secrets,
-		})
+	var resp *extension.PostTranslateModifyResponse
+	err := h.call("PostTranslateModify", v1alpha1.XDSTranslation, func(ctx context.Context) (err error) {
+		resp, err = h.grpcClient.PostTranslateModify(ctx,
+			&extension.PostTranslateModifyRequest{
+				PostTranslateContext: &extension.PostTranslateExtensionContext{},
+				Clusters:             clusters,
+				Secrets:              secrets,
+			})
+		return err
+	})
 
 	if err != nil {
 		return nil, nil, err
 	}
+	// The hook failed open
+	if resp == nil {
+		return clusters, secrets, nil
+	}
 
 	return resp.Clusters, resp.Secrets, nil
 }
+
+func (h *XDSHook) PostClusterModifyHook(c *cluster.Cluster, routeName string, backendRefs []*ir.BackendRef, extensionResources []*unstructured.Unstructured) (*cluster.Cluster, error) {
+	// Take all of the unstructured resources for the extension and package them into bytes
+	extensionResourceBytes, err := marshalExtensionResources(extensionResources)
+	// This is probably a programming error, but just return the unmodified cluster if so
+	if err != nil {
+		return c, err
+	}
+
+	backends := make([]*extension.BackendRef, 0, len(backendRefs))
+	for _, ref := range backendRefs {
+		if ref != nil {
+			backends = append(backends, &extension.BackendRef{
+				Group:     ref.Group,
+				Kind:      ref.Kind,
+				Name:      ref.Name,
+				Namespace: ref.Namespace,
+				Port:      ref.Port,
+			})
+		}
+	}
+
+	// Make the request to the extension server
+	var resp *extension.PostClusterModifyResponse
+	err = h.call("PostClusterModify", v1alpha1.XDSCluster, func(ctx context.Context) (err error) {
+		resp, err = h.grpcClient.PostClusterModify(ctx,
+			&extension.PostClusterModifyRequest{
+				Cluster: c,
+				PostClusterContext: &extension.PostClusterExtensionContext{
+					RouteName:          routeName,
+					BackendRefs:        backends,
+					ExtensionResources: extensionResourceBytes,
+				},
+			})
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	// The hook failed open
+	if resp == nil {
+		return c, nil
+	}
+
+	return resp.Cluster, nil
+}
+
+func (h *XDSHook) PreTranslateModifyHook(xdsIR *ir.Xds) (*ir.Xds, error) {
+	xdsIRBytes, err := json.Marshal(xdsIR)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the request to the extension server
+	var resp *extension.PreTranslateModifyResponse
+	err = h.call("PreTranslateModify", v1alpha1.XDSTranslation, func(ctx context.Context) (err error) {
+		resp, err = h.grpcClient.PreTranslateModify(ctx,
+			&extension.PreTranslateModifyRequest{
+				PreTranslateContext: &extension.PreTranslateExtensionContext{},
+				XdsIr:               xdsIRBytes,
+			})
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	// The hook failed open
+	if resp == nil {
+		return xdsIR, nil
+	}
+
+	if len(resp.XdsIr) == 0 {
+		return nil, nil
+	}
+	modifiedIR := &ir.Xds{}
+	if err := json.Unmarshal(resp.XdsIr, modifiedIR); err != nil {
+		return nil, fmt.Errorf("invalid xds ir returned by the extension: %w", err)
+	}
+	return modifiedIR, nil
+}
+
+func (h *XDSHook) PreHTTPListenerModifyHook(l *ir.HTTPListener) (*ir.HTTPListener, error) {
+	listenerBytes, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the request to the extension server
+	var resp *extension.PreHTTPListenerModifyResponse
+	err = h.call("PreHTTPListenerModify", v1alpha1.XDSHTTPListener, func(ctx context.Context) (err error) {
+		resp, err = h.grpcClient.PreHTTPListenerModify(ctx,
+			&extension.PreHTTPListenerModifyRequest{
+				Listener:           listenerBytes,
+				PreListenerContext: &extension.PreHTTPListenerExtensionContext{},
+			})
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	// The hook failed open
+	if resp == nil {
+		return l, nil
+	}
+
+	if len(resp.Listener) == 0 {
+		return nil, nil
+	}
+	modifiedListener := &ir.HTTPListener{}
+	if err := json.Unmarshal(resp.Listener, modifiedListener); err != nil {
+		return nil, fmt.Errorf("invalid listener returned by the extension: %w", err)
+	}
+	return modifiedListener, nil
+}
+
+func (h *XDSHook) PreRouteModifyHook(route *ir.HTTPRoute, extensionResources []*unstructured.Unstructured) (*ir.HTTPRoute, error) {
+	routeBytes, err := json.Marshal(route)
+	if err != nil {
+		return nil, err
+	}
+
+	extensionResourceBytes, err := marshalExtensionResources(extensionResources)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the request to the extension server
+	var resp *extension.PreRouteModifyResponse
+	err = h.call("PreRouteModify", v1alpha1.XDSRoute, func(ctx context.Context) (err error) {
+		resp, err = h.grpcClient.PreRouteModify(ctx,
+			&extension.PreRouteModifyRequest{
+				Route: routeBytes,
+				PreRouteContext: &extension.PreRouteExtensionContext{
+					ExtensionResources: extensionResourceBytes,
+				},
+			})
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	// The hook failed open
+	if resp == nil {
+		return route, nil
+	}
+
+	if len(resp.Route) == 0 {
+		return nil, nil
+	}
+	modifiedRoute := &ir.HTTPRoute{}
+	if err := json.Unmarshal(resp.Route, modifiedRoute); err != nil {
+		return nil, fmt.Errorf("invalid route returned by the extension: %w", err)
+	}
+	return modifiedRoute, nil
+}
+
+// marshalExtensionResources packages the unstructured resources of the extension into bytes.
+func marshalExtensionResources(extensionResources []*unstructured.Unstructured) ([]*extension.ExtensionResource, error) {
+	extensionResourceBytes := []*extension.ExtensionResource{}
+	for _, res := range extensionResources {
+		if res != nil {
+			unstructuredBytes, err := res.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+
+			extensionResourceBytes = append(extensionResourceBytes,
+				&extension.ExtensionResource{
+					UnstructuredBytes: unstructuredBytes,
+				},
+			)
+		}
+	}
+	return extensionResourceBytes, nil
+}
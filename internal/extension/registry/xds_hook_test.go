@@ -0,0 +1,277 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/proto/extension"
+)
+
+// fakeExtensionServer fails the first failures calls of PostRouteModify with
+// the code, and otherwise returns the route renamed after a delay.
+type fakeExtensionServer struct {
+	extension.UnimplementedEnvoyGatewayExtensionServer
+	code     codes.Code
+	failures int
+	delay    time.Duration
+	calls    atomic.Int32
+}
+
+func (s *fakeExtensionServer) PostRouteModify(ctx context.Context, req *extension.PostRouteModifyRequest) (*extension.PostRouteModifyResponse, error) {
+	if calls := s.calls.Add(1); int(calls) <= s.failures {
+		return nil, status.Error(s.code, "extension failure")
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(s.delay):
+	}
+	return &extension.PostRouteModifyResponse{Route: &route.Route{Name: req.Route.Name + "-modified"}}, nil
+}
+
+func newTestXDSHook(t *testing.T, srv *fakeExtensionServer, ext *v1alpha1.ExtensionManager) *XDSHook {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	extension.RegisterEnvoyGatewayExtensionServer(s, srv)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(grpcServiceConfig(ext)))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	m := &Manager{extension: *ext, logger: logging.DefaultLogger(v1alpha1.LogLevelInfo)}
+	return m.newXDSHook(extension.NewEnvoyGatewayExtensionClient(conn))
+}
+
+func TestXDSHookCall(t *testing.T) {
+	testCases := []struct {
+		name      string
+		server    *fakeExtensionServer
+		timeout   *metav1.Duration
+		retry     *v1alpha1.ExtensionRetry
+		failOpen  []v1alpha1.XDSTranslatorHook
+		wantRoute string
+		wantCalls int
+		wantErr   codes.Code
+	}{
+		{
+			name:      "success",
+			server:    &fakeExtensionServer{},
+			wantRoute: "route-modified",
+			wantCalls: 1,
+		},
+		{
+			name:      "retried unavailable extension",
+			server:    &fakeExtensionServer{code: codes.Unavailable, failures: 2},
+			wantRoute: "route-modified",
+			wantCalls: 3,
+		},
+		{
+			name:      "retries exhausted",
+			server:    &fakeExtensionServer{code: codes.Unavailable, failures: 3},
+			retry:     &v1alpha1.ExtensionRetry{MaxAttempts: ptr.To(3), InitialBackoff: &metav1.Duration{Duration: time.Millisecond}},
+			wantCalls: 3,
+			wantErr:   codes.Unavailable,
+		},
+		{
+			name:      "retries disabled",
+			server:    &fakeExtensionServer{code: codes.Unavailable, failures: 1},
+			retry:     &v1alpha1.ExtensionRetry{MaxAttempts: ptr.To(1)},
+			wantCalls: 1,
+			wantErr:   codes.Unavailable,
+		},
+		{
+			name:      "non retryable failure",
+			server:    &fakeExtensionServer{code: codes.Internal, failures: 1},
+			wantCalls: 1,
+			wantErr:   codes.Internal,
+		},
+		{
+			name:      "timeout",
+			server:    &fakeExtensionServer{delay: time.Minute},
+			timeout:   &metav1.Duration{Duration: 100 * time.Millisecond},
+			wantCalls: 1,
+			wantErr:   codes.DeadlineExceeded,
+		},
+		{
+			name:      "failure of a hook failing open",
+			server:    &fakeExtensionServer{code: codes.Internal, failures: 1},
+			failOpen:  []v1alpha1.XDSTranslatorHook{v1alpha1.XDSRoute},
+			wantRoute: "route",
+			wantCalls: 1,
+		},
+		{
+			name:      "timeout of a hook failing open",
+			server:    &fakeExtensionServer{delay: time.Minute},
+			timeout:   &metav1.Duration{Duration: 100 * time.Millisecond},
+			failOpen:  []v1alpha1.XDSTranslatorHook{v1alpha1.XDSRoute},
+			wantRoute: "route",
+			wantCalls: 1,
+		},
+		{
+			name:      "failure of another hook failing open",
+			server:    &fakeExtensionServer{code: codes.Internal, failures: 1},
+			failOpen:  []v1alpha1.XDSTranslatorHook{v1alpha1.XDSCluster},
+			wantCalls: 1,
+			wantErr:   codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hook := newTestXDSHook(t, tc.server, &v1alpha1.ExtensionManager{
+				Hooks: &v1alpha1.ExtensionHooks{
+					XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+						Post:     []v1alpha1.XDSTranslatorHook{v1alpha1.XDSRoute},
+						FailOpen: tc.failOpen,
+					},
+				},
+				Timeout: tc.timeout,
+				Retry:   tc.retry,
+			})
+
+			modified, err := hook.PostRouteModifyHook(&route.Route{Name: "route"}, nil, nil, nil)
+			require.Equal(t, tc.wantCalls, int(tc.server.calls.Load()))
+			if tc.wantErr != codes.OK {
+				require.Error(t, err)
+				require.Equal(t, tc.wantErr, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantRoute, modified.Name)
+		})
+	}
+}
+
+func TestGRPCServiceConfig(t *testing.T) {
+	testCases := []struct {
+		name                string
+		ext                 *v1alpha1.ExtensionManager
+		wantFailOpenMethods []string
+		wantRetry           map[string]any
+	}{
+		{
+			name: "default",
+			ext: &v1alpha1.ExtensionManager{
+				Hooks: &v1alpha1.ExtensionHooks{XDSTranslator: &v1alpha1.XDSTranslatorHooks{}},
+			},
+			wantRetry: map[string]any{
+				"MaxAttempts":          float64(4),
+				"InitialBackoff":       "0.1s",
+				"MaxBackoff":           "1s",
+				"BackoffMultiplier":    float64(2),
+				"RetryableStatusCodes": []any{"UNAVAILABLE"},
+			},
+		},
+		{
+			name: "custom",
+			ext: &v1alpha1.ExtensionManager{
+				Retry: &v1alpha1.ExtensionRetry{
+					MaxAttempts:    ptr.To(2),
+					InitialBackoff: &metav1.Duration{Duration: 250 * time.Millisecond},
+					MaxBackoff:     &metav1.Duration{Duration: 2 * time.Second},
+				},
+			},
+			wantRetry: map[string]any{
+				"MaxAttempts":          float64(2),
+				"InitialBackoff":       "0.25s",
+				"MaxBackoff":           "2s",
+				"BackoffMultiplier":    float64(2),
+				"RetryableStatusCodes": []any{"UNAVAILABLE"},
+			},
+		},
+		{
+			name: "disabled",
+			ext: &v1alpha1.ExtensionManager{
+				Retry: &v1alpha1.ExtensionRetry{MaxAttempts: ptr.To(1)},
+			},
+		},
+		{
+			name: "fail open",
+			ext: &v1alpha1.ExtensionManager{
+				Hooks: &v1alpha1.ExtensionHooks{
+					XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+						FailOpen: []v1alpha1.XDSTranslatorHook{v1alpha1.XDSRoute, v1alpha1.XDSCluster},
+					},
+				},
+				Retry: &v1alpha1.ExtensionRetry{MaxAttempts: ptr.To(2)},
+			},
+			wantFailOpenMethods: []string{"PreRouteModify", "PostRouteModify", "PostClusterModify"},
+			wantRetry: map[string]any{
+				"MaxAttempts":          float64(2),
+				"InitialBackoff":       "0.1s",
+				"MaxBackoff":           "1s",
+				"BackoffMultiplier":    float64(2),
+				"RetryableStatusCodes": []any{"UNAVAILABLE"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cfg struct {
+				MethodConfig []struct {
+					Name []struct {
+						Service string `json:"service"`
+						Method  string `json:"method"`
+					} `json:"name"`
+					WaitForReady bool           `json:"waitForReady"`
+					RetryPolicy  map[string]any `json:"retryPolicy"`
+				} `json:"methodConfig"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(grpcServiceConfig(tc.ext)), &cfg))
+
+			// The calls of the service wait for the connection to be ready,
+			// except the calls of the fail open hooks.
+			require.NotEmpty(t, cfg.MethodConfig)
+			require.Len(t, cfg.MethodConfig[0].Name, 1)
+			require.Empty(t, cfg.MethodConfig[0].Name[0].Method)
+			require.True(t, cfg.MethodConfig[0].WaitForReady)
+			require.Equal(t, tc.wantRetry, cfg.MethodConfig[0].RetryPolicy)
+			if len(tc.wantFailOpenMethods) == 0 {
+				require.Len(t, cfg.MethodConfig, 1)
+				return
+			}
+			require.Len(t, cfg.MethodConfig, 2)
+			var methods []string
+			for _, name := range cfg.MethodConfig[1].Name {
+				require.Equal(t, "envoygateway.extension.EnvoyGatewayExtension", name.Service)
+				methods = append(methods, name.Method)
+			}
+			require.Equal(t, tc.wantFailOpenMethods, methods)
+			require.False(t, cfg.MethodConfig[1].WaitForReady)
+			require.Equal(t, tc.wantRetry, cfg.MethodConfig[1].RetryPolicy)
+		})
+	}
+}
@@ -18,13 +18,18 @@ import (
 	tlsV3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/golang/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+
+	"github.com/envoyproxy/gateway/internal/ir"
 )
 
 type XDSHookClient struct{}
 
 // PostRouteModifyHook returns a modified version of the route using context info and the passed in extensionResources
-func (c *XDSHookClient) PostRouteModifyHook(route *routeV3.Route, routeHostnames []string, extensionResources []*unstructured.Unstructured) (*routeV3.Route, error) {
+// and extensionPolicies
+func (c *XDSHookClient) PostRouteModifyHook(route *routeV3.Route, routeHostnames []string, extensionResources, extensionPolicies []*unstructured.Unstructured) (*routeV3.Route, error) {
 	// Simulate an error an extension may return
 	if route.Name == "extension-post-xdsroute-hook-error" {
 		return nil, errors.New("route hook resource error")
@@ -73,11 +78,28 @@ func (c *XDSHookClient) PostRouteModifyHook(route *routeV3.Route, routeHostnames
 			},
 		)
 	}
+	for _, extensionPolicy := range extensionPolicies {
+		modifiedRoute.ResponseHeadersToAdd = append(modifiedRoute.ResponseHeadersToAdd,
+			&coreV3.HeaderValueOption{
+				Header: &coreV3.HeaderValue{
+					Key:   "mock-extension-was-here-policy-name",
+					Value: extensionPolicy.GetName(),
+				},
+			},
+			&coreV3.HeaderValueOption{
+				Header: &coreV3.HeaderValue{
+					Key:   "mock-extension-was-here-policy-kind",
+					Value: extensionPolicy.GetKind(),
+				},
+			},
+		)
+	}
 	return modifiedRoute, nil
 }
 
-// PostVirtualHostModifyHook returns a modified version of the virtualhost with a new route injected
-func (c *XDSHookClient) PostVirtualHostModifyHook(vh *routeV3.VirtualHost) (*routeV3.VirtualHost, error) {
+// PostVirtualHostModifyHook returns a modified version of the virtualhost with a new route injected, or with
+// a response header added for each of the passed in extensionPolicies
+func (c *XDSHookClient) PostVirtualHostModifyHook(vh *routeV3.VirtualHost, extensionPolicies []*unstructured.Unstructured) (*routeV3.VirtualHost, error) {
 	// Only make the change when the VirtualHost's name matches the expected testdata
 	// This prevents us from having to update every single testfile.out
 	if vh.Name == "extension-post-xdsvirtualhost-hook-error/*" {
@@ -95,6 +117,19 @@ func (c *XDSHookClient) PostVirtualHostModifyHook(vh *routeV3.VirtualHost) (*rou
 			},
 		})
 		return modifiedVH, nil
+	} else if len(extensionPolicies) > 0 {
+		modifiedVH := proto.Clone(vh).(*routeV3.VirtualHost)
+		for _, extensionPolicy := range extensionPolicies {
+			modifiedVH.ResponseHeadersToAdd = append(modifiedVH.ResponseHeadersToAdd,
+				&coreV3.HeaderValueOption{
+					Header: &coreV3.HeaderValue{
+						Key:   "mock-extension-was-here-policy-name",
+						Value: extensionPolicy.GetName(),
+					},
+				},
+			)
+		}
+		return modifiedVH, nil
 	}
 	return vh, nil
 }
@@ -102,7 +137,7 @@ func (c *XDSHookClient) PostVirtualHostModifyHook(vh *routeV3.VirtualHost) (*rou
 // PostHTTPListenerModifyHook returns a modified version of the listener with a changed statprefix of the listener
 // A more useful use-case for an extension would be looping through the FilterChains to find the
 // HTTPConnectionManager(s) and inject a custom HTTPFilter, but that for testing purposes we don't need to make a complex change
-func (c *XDSHookClient) PostHTTPListenerModifyHook(l *listenerV3.Listener) (*listenerV3.Listener, error) {
+func (c *XDSHookClient) PostHTTPListenerModifyHook(l *listenerV3.Listener, extensionPolicies []*unstructured.Unstructured) (*listenerV3.Listener, error) {
 
 	// Only make the change when the listener's name matches the expected testdata
 	// This prevents us from having to update every single testfile.out
@@ -114,6 +149,14 @@ func (c *XDSHookClient) PostHTTPListenerModifyHook(l *listenerV3.Listener) (*lis
 		modifiedListener := proto.Clone(l).(*listenerV3.Listener)
 		modifiedListener.StatPrefix = "mock-extension-inserted-prefix"
 		return modifiedListener, nil
+	} else if len(extensionPolicies) > 0 {
+		modifiedListener := proto.Clone(l).(*listenerV3.Listener)
+		names := make([]string, 0, len(extensionPolicies))
+		for _, extensionPolicy := range extensionPolicies {
+			names = append(names, extensionPolicy.GetName())
+		}
+		modifiedListener.StatPrefix = "mock-extension-policies-" + strings.Join(names, "-")
+		return modifiedListener, nil
 	}
 	return l, nil
 }
@@ -174,3 +217,105 @@ func (c *XDSHookClient) PostTranslateModifyHook(clusters []*clusterV3.Cluster, s
 
 	return clusters, secrets, nil
 }
+
+// PostClusterModifyHook returns a modified version of the cluster with metadata describing the route and its backends
+func (c *XDSHookClient) PostClusterModifyHook(cluster *clusterV3.Cluster, routeName string, backendRefs []*ir.BackendRef, extensionResources []*unstructured.Unstructured) (*clusterV3.Cluster, error) {
+	// Simulate an error an extension may return
+	if routeName == "extension-post-xdscluster-hook-error" {
+		return nil, errors.New("cluster hook resource error")
+	}
+
+	// Setup a new cluster to avoid operating directly on the passed in pointer for better test coverage that the
+	// cluster we are returning gets used properly
+	modifiedCluster := proto.Clone(cluster).(*clusterV3.Cluster)
+	fields := map[string]*structpb.Value{
+		"route-name": structpb.NewStringValue(routeName),
+	}
+	backends := make([]string, 0, len(backendRefs))
+	for _, ref := range backendRefs {
+		backends = append(backends, fmt.Sprintf("%s/%s/%s:%d", ref.Kind, ref.Namespace, ref.Name, ref.Port))
+	}
+	fields["backend-refs"] = structpb.NewStringValue(strings.Join(backends, ", "))
+	for _, extensionResource := range extensionResources {
+		fields["extensionRef-name"] = structpb.NewStringValue(extensionResource.GetName())
+	}
+	modifiedCluster.Metadata = &coreV3.Metadata{
+		FilterMetadata: map[string]*structpb.Struct{
+			"mock-extension": {Fields: fields},
+		},
+	}
+	return modifiedCluster, nil
+}
+
+// PreTranslateModifyHook returns a modified version of the xds IR with a new route injected
+func (c *XDSHookClient) PreTranslateModifyHook(xdsIR *ir.Xds) (*ir.Xds, error) {
+	// Only make the change when the listener's name matches the expected testdata
+	// This prevents us from having to update every single testfile.out
+	if xdsIR.GetHTTPListener("extension-pre-translation-hook-error") != nil {
+		return nil, fmt.Errorf("extension pre translation hook error")
+	}
+	listener := xdsIR.GetHTTPListener("extension-pre-listener")
+	if listener == nil {
+		return xdsIR, nil
+	}
+
+	// Setup a new IR to avoid operating directly on the passed in pointer for better test coverage that the
+	// IR we are returning gets used properly
+	modifiedIR := xdsIR.DeepCopy()
+	modifiedListener := modifiedIR.GetHTTPListener("extension-pre-listener")
+	modifiedListener.Routes = append(modifiedListener.Routes, &ir.HTTPRoute{
+		Name:     "mock-extension-pre-translation-inserted-route",
+		Hostname: "*",
+		PathMatch: &ir.StringMatch{
+			Exact: ptr.To("/mock-extension"),
+		},
+		DirectResponse: &ir.DirectResponse{
+			StatusCode: uint32(200),
+		},
+	})
+	return modifiedIR, nil
+}
+
+// PreHTTPListenerModifyHook returns a modified version of the listener with the proxy protocol enabled
+func (c *XDSHookClient) PreHTTPListenerModifyHook(l *ir.HTTPListener) (*ir.HTTPListener, error) {
+	// Only make the change when the listener's name matches the expected testdata
+	// This prevents us from having to update every single testfile.out
+	if l.Name == "extension-pre-xdslistener-hook-error" {
+		return nil, fmt.Errorf("extension pre xds listener hook error")
+	} else if l.Name == "extension-pre-listener" {
+		// Setup a new Listener to avoid operating directly on the passed in pointer for better test coverage that the
+		// Listener we are returning gets used properly
+		modifiedListener := l.DeepCopy()
+		modifiedListener.EnableProxyProtocol = true
+		return modifiedListener, nil
+	}
+	return l, nil
+}
+
+// PreRouteModifyHook returns a modified version of the route with request headers added using the passed in extensionResources
+func (c *XDSHookClient) PreRouteModifyHook(route *ir.HTTPRoute, extensionResources []*unstructured.Unstructured) (*ir.HTTPRoute, error) {
+	// Simulate an error an extension may return
+	if route.Name == "extension-pre-xdsroute-hook-error" {
+		return nil, errors.New("pre route hook resource error")
+	}
+
+	// Only make the change when the route's name matches the expected testdata
+	// This prevents us from having to update every single testfile.out
+	if route.Name != "extension-pre-route" {
+		return route, nil
+	}
+
+	// Setup a new route to avoid operating directly on the passed in pointer for better test coverage that the
+	// route we are returning gets used properly
+	modifiedRoute := route.DeepCopy()
+	for _, extensionResource := range extensionResources {
+		modifiedRoute.AddRequestHeaders = append(modifiedRoute.AddRequestHeaders,
+			ir.AddHeader{
+				Name:   "mock-extension-pre-route-extensionRef-name",
+				Value:  extensionResource.GetName(),
+				Append: true,
+			},
+		)
+	}
+	return modifiedRoute, nil
+}
@@ -11,6 +11,8 @@ import (
 	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/envoyproxy/gateway/internal/ir"
 )
 
 type XDSHookClient interface {
@@ -21,19 +23,24 @@ type XDSHookClient interface {
 	// PostRouteModifyHook also passes a list of Unstructured data for the externalRefs owned by the extension on the HTTPRoute that
 	// created this xDS route
 	// PostRouteModifyHook will only be executed if an extension is loaded and only on Routes which were generated from an HTTPRoute
-	// that uses extension resources as externalRef filters.
-	PostRouteModifyHook(route *route.Route, routeHostnames []string, extensionResources []*unstructured.Unstructured) (*route.Route, error)
+	// that uses extension resources as externalRef filters, or which is targeted by policies introduced by the extension.
+	// The policies introduced by the extension that target the HTTPRoute are passed as a list of Unstructured data too.
+	PostRouteModifyHook(route *route.Route, routeHostnames []string, extensionResources, extensionPolicies []*unstructured.Unstructured) (*route.Route, error)
 
 	// PostVirtualHostModifyHook provides a way for extensions to modify a VirtualHost generated by Envoy Gateway before it is finalized.
 	// An extension can also make use of this hook to generate and insert entirely new Routes not generated by Envoy Gateway.
 	// PostVirtualHostModifyHook is always executed when an extension is loaded. An extension may return nil to not make any changes
 	// to it.
-	PostVirtualHostModifyHook(*route.VirtualHost) (*route.VirtualHost, error)
+	// PostVirtualHostModifyHook also passes a list of Unstructured data for the policies introduced by the extension that
+	// target the Gateway or the Listener of the VirtualHost.
+	PostVirtualHostModifyHook(vh *route.VirtualHost, extensionPolicies []*unstructured.Unstructured) (*route.VirtualHost, error)
 
 	// PostHTTPListenerModifyHook allows an extension to make changes to a Listener generated by Envoy Gateway before it is finalized.
 	// PostHTTPListenerModifyHook is always executed when an extension is loaded. An extension may return nil
 	// in order to not make any changes to it.
-	PostHTTPListenerModifyHook(*listener.Listener) (*listener.Listener, error)
+	// PostHTTPListenerModifyHook also passes a list of Unstructured data for the policies introduced by the extension that
+	// target the Gateway or the Listener.
+	PostHTTPListenerModifyHook(l *listener.Listener, extensionPolicies []*unstructured.Unstructured) (*listener.Listener, error)
 
 	// PostTranslateModifyHook allows an extension to modify the clusters and secrets in the xDS config.
 	// This allows for inserting clusters that may change along with extension specific configuration to be dynamically created rather than
@@ -42,4 +49,32 @@ type XDSHookClient interface {
 	// The list of clusters and secrets returned by the extension are used as the final list of all clusters and secrets
 	// PostTranslateModifyHook is always executed when an extension is loaded
 	PostTranslateModifyHook([]*cluster.Cluster, []*tls.Secret) ([]*cluster.Cluster, []*tls.Secret, error)
+
+	// PostClusterModifyHook allows an extension to modify a cluster generated by Envoy Gateway for the backends of a route
+	// before it is finalized. Doing so allows extensions to configure per-backend settings, such as transport sockets or
+	// load balancing metadata.
+	// PostClusterModifyHook also passes the name of the route, the backendRefs of the cluster and a list of Unstructured data
+	// for the externalRefs owned by the extension on the HTTPRoute that created this cluster
+	// PostClusterModifyHook will only be executed if an extension is loaded and only on clusters which were generated from
+	// an HTTPRoute that uses extension resources as externalRef filters.
+	PostClusterModifyHook(cluster *cluster.Cluster, routeName string, backendRefs []*ir.BackendRef, extensionResources []*unstructured.Unstructured) (*cluster.Cluster, error)
+
+	// PreTranslateModifyHook allows an extension to modify the xDS IR before it is translated into xDS resources.
+	// The xDS IR returned by the extension is used as the input of the xDS translation.
+	// PreTranslateModifyHook is always executed when an extension is loaded. An extension may return nil
+	// in order to not make any changes to it.
+	PreTranslateModifyHook(*ir.Xds) (*ir.Xds, error)
+
+	// PreHTTPListenerModifyHook allows an extension to modify the IR of an HTTP listener, along with its routes,
+	// before it is translated into xDS resources.
+	// PreHTTPListenerModifyHook is always executed when an extension is loaded. An extension may return nil
+	// in order to not make any changes to it.
+	PreHTTPListenerModifyHook(*ir.HTTPListener) (*ir.HTTPListener, error)
+
+	// PreRouteModifyHook allows an extension to modify the IR of a route before it is translated into xDS resources.
+	// PreRouteModifyHook also passes a list of Unstructured data for the externalRefs owned by the extension on the HTTPRoute that
+	// created this route
+	// PreRouteModifyHook will only be executed if an extension is loaded and only on routes which were generated from an HTTPRoute
+	// that uses extension resources as externalRef filters.
+	PreRouteModifyHook(route *ir.HTTPRoute, extensionResources []*unstructured.Unstructured) (*ir.HTTPRoute, error)
 }
@@ -12,7 +12,9 @@ import (
 	"k8s.io/utils/ptr"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/status"
 )
@@ -21,13 +23,14 @@ func (t *Translator) processBackendTLSPolicy(
 	backendRef gwapiv1.BackendObjectReference,
 	backendNamespace string,
 	parent gwapiv1a2.ParentReference,
-	resources *Resources) *ir.TLSUpstreamConfig {
-	tlsBundle, err := getBackendTLSBundle(resources.BackendTLSPolicies, resources.ConfigMaps, backendRef, backendNamespace)
+	resources *Resources,
+	index *policyIndex) *ir.TLSUpstreamConfig {
+	tlsBundle, err := getBackendTLSBundle(index, resources.ConfigMaps, backendRef, backendNamespace)
 	if err == nil && tlsBundle == nil {
 		return nil
 	}
 
-	policy := getBackendTLSPolicy(resources.BackendTLSPolicies, backendRef, backendNamespace)
+	policy := index.backendTLSPolicy(backendRef, backendNamespace)
 
 	ancestorRefs := []gwapiv1a2.ParentReference{
 		parent,
@@ -43,82 +46,37 @@ func (t *Translator) processBackendTLSPolicy(
 		return nil
 	}
 
-	// Check if the reference from BackendTLSPolicy to BackendRef is permitted by
-	// any ReferenceGrant
-	backendRefKind := KindService
-	if backendRef.Kind != nil {
-		backendRefKind = string(*backendRef.Kind)
-	}
-	if policy.Namespace != backendNamespace {
-		if !t.validateCrossNamespaceRef(
-			crossNamespaceFrom{
-				group:     gwapiv1.GroupName,
-				kind:      KindBackendTLSPolicy,
-				namespace: policy.Namespace,
-			},
-			crossNamespaceTo{
-				group:     "",
-				kind:      backendRefKind,
-				namespace: backendNamespace,
-				name:      string(backendRef.Name),
-			},
-			resources.ReferenceGrants,
-		) {
-			err = fmt.Errorf("target ref to %s %s/%s not permitted by any ReferenceGrant",
-				backendRefKind, backendNamespace, backendRef.Name)
-
-			status.SetTranslationErrorForPolicyAncestors(&policy.Status,
-				ancestorRefs,
-				t.GatewayControllerName,
-				policy.Generation,
-				status.Error2ConditionMsg(err),
-			)
-			return nil
-		}
-	}
-
 	status.SetAcceptedForPolicyAncestors(&policy.Status, ancestorRefs, t.GatewayControllerName)
 
 	return tlsBundle
 }
 
-func backendTLSTargetMatched(policy gwapiv1a2.BackendTLSPolicy, target gwapiv1a2.PolicyTargetReferenceWithSectionName) bool {
-
-	policyTarget := policy.Spec.TargetRef
-
-	if target.Group == policyTarget.Group &&
-		target.Kind == policyTarget.Kind &&
-		target.Name == policyTarget.Name &&
-		NamespaceDerefOr(policyTarget.Namespace, policy.Namespace) == string(*target.Namespace) {
-		if policyTarget.SectionName != nil && *policyTarget.SectionName != *target.SectionName {
-			return false
+func backendTLSTargetMatched(policy gwapiv1a3.BackendTLSPolicy, target egv1a1.PolicyTargetReferenceWithSectionName) bool {
+	for _, policyTarget := range policy.Spec.TargetRefs {
+		if target.Group == policyTarget.Group &&
+			target.Kind == policyTarget.Kind &&
+			target.Name == policyTarget.Name &&
+			policy.Namespace == string(*target.Namespace) {
+			if policyTarget.SectionName != nil && (target.SectionName == nil || *policyTarget.SectionName != *target.SectionName) {
+				continue
+			}
+			return true
 		}
-		return true
 	}
 	return false
 }
 
-func getBackendTLSPolicy(policies []*gwapiv1a2.BackendTLSPolicy, backendRef gwapiv1a2.BackendObjectReference, backendNamespace string) *gwapiv1a2.BackendTLSPolicy {
-	target := GetTargetBackendReference(backendRef, backendNamespace)
-	for _, policy := range policies {
-		if backendTLSTargetMatched(*policy, target) {
-			return policy
-		}
-	}
-	return nil
-}
-
-func getBackendTLSBundle(policies []*gwapiv1a2.BackendTLSPolicy, configmaps []*corev1.ConfigMap, backendRef gwapiv1a2.BackendObjectReference, backendNamespace string) (*ir.TLSUpstreamConfig, error) {
+func getBackendTLSBundle(index *policyIndex, configmaps []*corev1.ConfigMap, backendRef gwapiv1a2.BackendObjectReference, backendNamespace string) (*ir.TLSUpstreamConfig, error) {
 
-	backendTLSPolicy := getBackendTLSPolicy(policies, backendRef, backendNamespace)
+	backendTLSPolicy := index.backendTLSPolicy(backendRef, backendNamespace)
 
 	if backendTLSPolicy == nil {
 		return nil, nil
 	}
 
 	tlsBundle := &ir.TLSUpstreamConfig{
-		SNI:                 string(backendTLSPolicy.Spec.TLS.Hostname),
-		UseSystemTrustStore: ptr.Deref(backendTLSPolicy.Spec.TLS.WellKnownCACerts, "") == gwapiv1a2.WellKnownCACertSystem,
+		SNI:                 string(backendTLSPolicy.Spec.Validation.Hostname),
+		UseSystemTrustStore: ptr.Deref(backendTLSPolicy.Spec.Validation.WellKnownCACertificates, "") == gwapiv1a3.WellKnownCACertificatesSystem,
 	}
 	if tlsBundle.UseSystemTrustStore {
 		return tlsBundle, nil
@@ -126,7 +84,7 @@ func getBackendTLSBundle(policies []*gwapiv1a2.BackendTLSPolicy, configmaps []*c
 
 	caRefMap := make(map[string]string)
 
-	for _, caRef := range backendTLSPolicy.Spec.TLS.CACertRefs {
+	for _, caRef := range backendTLSPolicy.Spec.Validation.CACertificateRefs {
 		caRefMap[string(caRef.Name)] = string(caRef.Kind)
 	}
 
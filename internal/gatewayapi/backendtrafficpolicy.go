@@ -29,33 +29,14 @@ import (
 )
 
 func (t *Translator) ProcessBackendTrafficPolicies(backendTrafficPolicies []*egv1a1.BackendTrafficPolicy,
-	gateways []*GatewayContext,
-	routes []RouteContext,
-	xdsIR XdsIRMap) []*egv1a1.BackendTrafficPolicy {
+	xdsIR XdsIRMap, index *policyIndex) []*egv1a1.BackendTrafficPolicy {
 	var res []*egv1a1.BackendTrafficPolicy
 
 	// Sort based on timestamp
-	sort.Slice(backendTrafficPolicies, func(i, j int) bool {
+	sort.SliceStable(backendTrafficPolicies, func(i, j int) bool {
 		return backendTrafficPolicies[i].CreationTimestamp.Before(&(backendTrafficPolicies[j].CreationTimestamp))
 	})
 
-	// First build a map out of the routes and gateways for faster lookup since users might have thousands of routes or more.
-	routeMap := map[policyTargetRouteKey]*policyRouteTargetContext{}
-	for _, route := range routes {
-		key := policyTargetRouteKey{
-			Kind:      string(GetRouteType(route)),
-			Name:      route.GetName(),
-			Namespace: route.GetNamespace(),
-		}
-		routeMap[key] = &policyRouteTargetContext{RouteContext: route}
-	}
-
-	gatewayMap := map[types.NamespacedName]*policyGatewayTargetContext{}
-	for _, gw := range gateways {
-		key := utils.NamespacedName(gw)
-		gatewayMap[key] = &policyGatewayTargetContext{GatewayContext: gw}
-	}
-
 	// Map of Gateway to the routes attached to it
 	gatewayRouteMap := make(map[string]sets.Set[string])
 
@@ -70,7 +51,7 @@ func (t *Translator) ProcessBackendTrafficPolicies(backendTrafficPolicies []*egv
 			res = append(res, policy)
 
 			// Negative statuses have already been assigned so its safe to skip
-			route, resolveErr := resolveBTPolicyRouteTargetRef(policy, routeMap)
+			route, resolveErr := resolveBTPolicyRouteTargetRef(policy, index.routes)
 			if route == nil {
 				continue
 			}
@@ -115,7 +96,7 @@ func (t *Translator) ProcessBackendTrafficPolicies(backendTrafficPolicies []*egv
 			}
 
 			// Set conditions for translation error if it got any
-			if err := t.translateBackendTrafficPolicyForRoute(policy, route, xdsIR); err != nil {
+			if err := t.translateBackendTrafficPolicyForRoute(policy, route, index); err != nil {
 				status.SetTranslationErrorForPolicyAncestors(&policy.Status,
 					ancestorRefs,
 					t.GatewayControllerName,
@@ -136,7 +117,7 @@ func (t *Translator) ProcessBackendTrafficPolicies(backendTrafficPolicies []*egv
 			res = append(res, policy)
 
 			// Negative statuses have already been assigned so its safe to skip
-			gateway, resolveErr := resolveBTPolicyGatewayTargetRef(policy, gatewayMap)
+			gateway, resolveErr := resolveBTPolicyGatewayTargetRef(policy, index.gateways)
 			if gateway == nil {
 				continue
 			}
@@ -228,7 +209,7 @@ func resolveBTPolicyGatewayTargetRef(policy *egv1a1.BackendTrafficPolicy, gatewa
 	}
 
 	// Check if another policy targeting the same Gateway exists
-	if gateway.attached {
+	if gateway.attached.Has(egv1a1.KindBackendTrafficPolicy) {
 		message := "Unable to target Gateway, another BackendTrafficPolicy has already attached to it"
 
 		return gateway.GatewayContext, &status.PolicyResolveError{
@@ -238,7 +219,7 @@ func resolveBTPolicyGatewayTargetRef(policy *egv1a1.BackendTrafficPolicy, gatewa
 	}
 
 	// Set context and save
-	gateway.attached = true
+	gateway.attached.Insert(egv1a1.KindBackendTrafficPolicy)
 	gateways[key] = gateway
 
 	return gateway.GatewayContext, nil
@@ -276,7 +257,7 @@ func resolveBTPolicyRouteTargetRef(policy *egv1a1.BackendTrafficPolicy, routes m
 	}
 
 	// Check if another policy targeting the same xRoute exists
-	if route.attached {
+	if route.attached.Has(egv1a1.KindBackendTrafficPolicy) {
 		message := fmt.Sprintf("Unable to target %s, another BackendTrafficPolicy has already attached to it",
 			string(policy.Spec.TargetRef.Kind))
 
@@ -287,13 +268,13 @@ func resolveBTPolicyRouteTargetRef(policy *egv1a1.BackendTrafficPolicy, routes m
 	}
 
 	// Set context and save
-	route.attached = true
+	route.attached.Insert(egv1a1.KindBackendTrafficPolicy)
 	routes[key] = route
 
 	return route.RouteContext, nil
 }
 
-func (t *Translator) translateBackendTrafficPolicyForRoute(policy *egv1a1.BackendTrafficPolicy, route RouteContext, xdsIR XdsIRMap) error {
+func (t *Translator) translateBackendTrafficPolicyForRoute(policy *egv1a1.BackendTrafficPolicy, route RouteContext, index *policyIndex) error {
 	var (
 		rl  *ir.RateLimit
 		lb  *ir.LoadBalancer
@@ -339,58 +320,45 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(policy *egv1a1.Backen
 	if policy.Spec.Retry != nil {
 		rt = t.buildRetry(policy)
 	}
-	// Apply IR to all relevant routes
-	prefix := irRoutePrefix(route)
-
 	if policy.Spec.Timeout != nil {
 		if to, err = t.buildTimeout(policy, nil); err != nil {
 			return errors.Wrap(err, "Timeout")
 		}
 	}
 
-	for _, ir := range xdsIR {
-		for _, tcp := range ir.TCP {
-			if strings.HasPrefix(tcp.Destination.Name, prefix) {
-				tcp.LoadBalancer = lb
-				tcp.ProxyProtocol = pp
-				tcp.HealthCheck = hc
-				tcp.CircuitBreaker = cb
-				tcp.TCPKeepalive = ka
-				tcp.Timeout = to
-			}
-		}
-
-		for _, udp := range ir.UDP {
-			if strings.HasPrefix(udp.Destination.Name, prefix) {
-				udp.LoadBalancer = lb
-				udp.Timeout = to
-			}
-		}
+	// Apply IR to all relevant routes
+	for _, tcp := range index.irTCPListeners(route) {
+		tcp.LoadBalancer = lb
+		tcp.ProxyProtocol = pp
+		tcp.HealthCheck = hc
+		tcp.CircuitBreaker = cb
+		tcp.TCPKeepalive = ka
+		tcp.Timeout = to
+	}
 
-		for _, http := range ir.HTTP {
-			for _, r := range http.Routes {
-				// Apply if there is a match
-				if strings.HasPrefix(r.Name, prefix) {
-					r.RateLimit = rl
-					r.LoadBalancer = lb
-					r.ProxyProtocol = pp
-					r.HealthCheck = hc
-					// Update the Host field in HealthCheck, now that we have access to the Route Hostname.
-					r.HealthCheck.SetHTTPHostIfAbsent(r.Hostname)
-					r.CircuitBreaker = cb
-					r.FaultInjection = fi
-					r.TCPKeepalive = ka
-					r.Retry = rt
-
-					// some timeout setting originate from the route
-					if policy.Spec.Timeout != nil {
-						if to, err = t.buildTimeout(policy, r); err != nil {
-							return errors.Wrap(err, "Timeout")
-						}
-						r.Timeout = to
-					}
-				}
+	for _, udp := range index.irUDPListeners(route) {
+		udp.LoadBalancer = lb
+		udp.Timeout = to
+	}
+
+	for _, r := range index.irHTTPRoutes(route) {
+		r.RateLimit = rl
+		r.LoadBalancer = lb
+		r.ProxyProtocol = pp
+		r.HealthCheck = hc
+		// Update the Host field in HealthCheck, now that we have access to the Route Hostname.
+		r.HealthCheck.SetHTTPHostIfAbsent(r.Hostname)
+		r.CircuitBreaker = cb
+		r.FaultInjection = fi
+		r.TCPKeepalive = ka
+		r.Retry = rt
+
+		// some timeout setting originate from the route
+		if policy.Spec.Timeout != nil {
+			if to, err = t.buildTimeout(policy, r); err != nil {
+				return errors.Wrap(err, "Timeout")
 			}
+			r.Timeout = to
 		}
 	}
 
@@ -36,25 +36,17 @@ func hasSectionName(policy *egv1a1.ClientTrafficPolicy) bool {
 }
 
 func (t *Translator) ProcessClientTrafficPolicies(resources *Resources,
-	gateways []*GatewayContext,
-	xdsIR XdsIRMap, infraIR InfraIRMap) []*egv1a1.ClientTrafficPolicy {
+	xdsIR XdsIRMap, infraIR InfraIRMap, index *policyIndex) []*egv1a1.ClientTrafficPolicy {
 	var res []*egv1a1.ClientTrafficPolicy
 
 	clientTrafficPolicies := resources.ClientTrafficPolicies
 	// Sort based on timestamp
-	sort.Slice(clientTrafficPolicies, func(i, j int) bool {
+	sort.SliceStable(clientTrafficPolicies, func(i, j int) bool {
 		return clientTrafficPolicies[i].CreationTimestamp.Before(&(clientTrafficPolicies[j].CreationTimestamp))
 	})
 
 	policyMap := make(map[types.NamespacedName]sets.Set[string])
 
-	// Build a map out of gateways for faster lookup since users might have hundreds of gateway or more.
-	gatewayMap := map[types.NamespacedName]*policyGatewayTargetContext{}
-	for _, gw := range gateways {
-		key := utils.NamespacedName(gw)
-		gatewayMap[key] = &policyGatewayTargetContext{GatewayContext: gw}
-	}
-
 	// Translate
 	// 1. First translate Policies with a sectionName set
 	// 2. Then loop again and translate the policies without a sectionName
@@ -66,7 +58,7 @@ func (t *Translator) ProcessClientTrafficPolicies(resources *Resources,
 			policy := policy.DeepCopy()
 			res = append(res, policy)
 
-			gateway, resolveErr := resolveCTPolicyTargetRef(policy, gatewayMap)
+			gateway, resolveErr := resolveCTPolicyTargetRef(policy, index.gateways)
 
 			// Negative statuses have already been assigned so its safe to skip
 			if gateway == nil {
@@ -155,7 +147,7 @@ func (t *Translator) ProcessClientTrafficPolicies(resources *Resources,
 			policy := policy.DeepCopy()
 			res = append(res, policy)
 
-			gateway, resolveErr := resolveCTPolicyTargetRef(policy, gatewayMap)
+			gateway, resolveErr := resolveCTPolicyTargetRef(policy, index.gateways)
 
 			// Negative statuses have already been assigned so its safe to skip
 			if gateway == nil {
@@ -15,6 +15,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
 
 // GatewayContext wraps a Gateway and provides helper methods for
@@ -23,6 +25,9 @@ type GatewayContext struct {
 	*gwapiv1.Gateway
 
 	listeners []*ListenerContext
+	// envoyProxy is the EnvoyProxy of the Gateway, i.e. the EnvoyProxy referenced by
+	// the Gateway merged on top of the EnvoyProxy of the GatewayClass.
+	envoyProxy *egv1a1.EnvoyProxy
 }
 
 // ResetListeners resets the listener statuses and re-generates the GatewayContext
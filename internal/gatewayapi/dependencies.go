@@ -0,0 +1,244 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"maps"
+	"reflect"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+	mcsapi "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+)
+
+// backendKey identifies the backend the EndpointSlices are looked up for.
+type backendKey struct {
+	types.NamespacedName
+	Kind string
+}
+
+// Dependencies records the resources shared by the Gateways of a GatewayClass,
+// e.g. Services, Secrets and EnvoyProxies, which are looked up while translating
+// a translation unit. The resources which weren't found are recorded as nil, since
+// creating them also changes the translation.
+type Dependencies struct {
+	namespaces     map[string]*v1.Namespace
+	services       map[types.NamespacedName]*v1.Service
+	serviceImports map[types.NamespacedName]*mcsapi.ServiceImport
+	secrets        map[types.NamespacedName]*v1.Secret
+	configMaps     map[types.NamespacedName]*v1.ConfigMap
+	envoyProxies   map[types.NamespacedName]*egv1a1.EnvoyProxy
+	endpointSlices map[backendKey][]*discoveryv1.EndpointSlice
+}
+
+func newDependencies() *Dependencies {
+	return &Dependencies{
+		namespaces:     map[string]*v1.Namespace{},
+		services:       map[types.NamespacedName]*v1.Service{},
+		serviceImports: map[types.NamespacedName]*mcsapi.ServiceImport{},
+		secrets:        map[types.NamespacedName]*v1.Secret{},
+		configMaps:     map[types.NamespacedName]*v1.ConfigMap{},
+		envoyProxies:   map[types.NamespacedName]*egv1a1.EnvoyProxy{},
+		endpointSlices: map[backendKey][]*discoveryv1.EndpointSlice{},
+	}
+}
+
+// DeepCopyInto copies the recorded resources into out. The resources themselves
+// aren't copied, since they are only compared with the resources of the next
+// translation.
+func (d *Dependencies) DeepCopyInto(out *Dependencies) {
+	out.namespaces = maps.Clone(d.namespaces)
+	out.services = maps.Clone(d.services)
+	out.serviceImports = maps.Clone(d.serviceImports)
+	out.secrets = maps.Clone(d.secrets)
+	out.configMaps = maps.Clone(d.configMaps)
+	out.envoyProxies = maps.Clone(d.envoyProxies)
+	out.endpointSlices = maps.Clone(d.endpointSlices)
+}
+
+// DeepCopy copies the recorded resources into a new Dependencies.
+func (d *Dependencies) DeepCopy() *Dependencies {
+	if d == nil {
+		return nil
+	}
+	out := new(Dependencies)
+	d.DeepCopyInto(out)
+	return out
+}
+
+// The record methods are no-ops on nil dependencies, i.e. when the resources
+// aren't translated as a translation unit.
+
+func (d *Dependencies) recordNamespace(name string, ns *v1.Namespace) {
+	if d != nil {
+		d.namespaces[name] = ns
+	}
+}
+
+func (d *Dependencies) recordService(namespace, name string, svc *v1.Service) {
+	if d != nil {
+		d.services[types.NamespacedName{Namespace: namespace, Name: name}] = svc
+	}
+}
+
+func (d *Dependencies) recordServiceImport(namespace, name string, svcImp *mcsapi.ServiceImport) {
+	if d != nil {
+		d.serviceImports[types.NamespacedName{Namespace: namespace, Name: name}] = svcImp
+	}
+}
+
+func (d *Dependencies) recordSecret(namespace, name string, secret *v1.Secret) {
+	if d != nil {
+		d.secrets[types.NamespacedName{Namespace: namespace, Name: name}] = secret
+	}
+}
+
+func (d *Dependencies) recordConfigMap(namespace, name string, configMap *v1.ConfigMap) {
+	if d != nil {
+		d.configMaps[types.NamespacedName{Namespace: namespace, Name: name}] = configMap
+	}
+}
+
+func (d *Dependencies) recordEnvoyProxy(namespace, name string, ep *egv1a1.EnvoyProxy) {
+	if d != nil {
+		d.envoyProxies[types.NamespacedName{Namespace: namespace, Name: name}] = ep
+	}
+}
+
+func (d *Dependencies) recordEndpointSlices(svcNamespace, svcName, backendKind string, endpointSlices []*discoveryv1.EndpointSlice) {
+	if d != nil {
+		d.endpointSlices[backendKey{NamespacedName: types.NamespacedName{Namespace: svcNamespace, Name: svcName}, Kind: backendKind}] = endpointSlices
+	}
+}
+
+// changed returns whether any of the recorded resources differs from the one
+// found in the indexed resources.
+func (d *Dependencies) changed(idx *resourceIndex) bool {
+	for key, ns := range d.namespaces {
+		if !reflect.DeepEqual(ns, idx.namespaces[key]) {
+			return true
+		}
+	}
+	for key, svc := range d.services {
+		if !reflect.DeepEqual(svc, idx.services[key]) {
+			return true
+		}
+	}
+	for key, svcImp := range d.serviceImports {
+		if !reflect.DeepEqual(svcImp, idx.serviceImports[key]) {
+			return true
+		}
+	}
+	for key, secret := range d.secrets {
+		if !reflect.DeepEqual(secret, idx.secrets[key]) {
+			return true
+		}
+	}
+	for key, configMap := range d.configMaps {
+		if !reflect.DeepEqual(configMap, idx.configMaps[key]) {
+			return true
+		}
+	}
+	for key, ep := range d.envoyProxies {
+		if !reflect.DeepEqual(ep, idx.envoyProxies[key]) {
+			return true
+		}
+	}
+	for key, endpointSlices := range d.endpointSlices {
+		if !reflect.DeepEqual(endpointSlices, idx.getEndpointSlices(key)) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceIndex indexes the resources shared by the Gateways of a GatewayClass,
+// so that the dependencies of every translation unit are checked without
+// scanning the resources for each of them.
+type resourceIndex struct {
+	resources      *Resources
+	namespaces     map[string]*v1.Namespace
+	services       map[types.NamespacedName]*v1.Service
+	serviceImports map[types.NamespacedName]*mcsapi.ServiceImport
+	secrets        map[types.NamespacedName]*v1.Secret
+	configMaps     map[types.NamespacedName]*v1.ConfigMap
+	envoyProxies   map[types.NamespacedName]*egv1a1.EnvoyProxy
+	endpointSlices map[backendKey][]*discoveryv1.EndpointSlice
+}
+
+// newResourceIndex indexes the resources. Like the getters of the resources,
+// the index holds the first resource found for each key.
+func newResourceIndex(resources *Resources) *resourceIndex {
+	idx := &resourceIndex{
+		resources:      resources,
+		namespaces:     map[string]*v1.Namespace{},
+		services:       map[types.NamespacedName]*v1.Service{},
+		serviceImports: map[types.NamespacedName]*mcsapi.ServiceImport{},
+		secrets:        map[types.NamespacedName]*v1.Secret{},
+		configMaps:     map[types.NamespacedName]*v1.ConfigMap{},
+		envoyProxies:   map[types.NamespacedName]*egv1a1.EnvoyProxy{},
+		endpointSlices: map[backendKey][]*discoveryv1.EndpointSlice{},
+	}
+	for _, ns := range resources.Namespaces {
+		if _, ok := idx.namespaces[ns.Name]; !ok {
+			idx.namespaces[ns.Name] = ns
+		}
+	}
+	for _, svc := range resources.Services {
+		key := types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}
+		if _, ok := idx.services[key]; !ok {
+			idx.services[key] = svc
+		}
+	}
+	for _, svcImp := range resources.ServiceImports {
+		key := types.NamespacedName{Namespace: svcImp.Namespace, Name: svcImp.Name}
+		if _, ok := idx.serviceImports[key]; !ok {
+			idx.serviceImports[key] = svcImp
+		}
+	}
+	for _, secret := range resources.Secrets {
+		key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}
+		if _, ok := idx.secrets[key]; !ok {
+			idx.secrets[key] = secret
+		}
+	}
+	for _, configMap := range resources.ConfigMaps {
+		key := types.NamespacedName{Namespace: configMap.Namespace, Name: configMap.Name}
+		if _, ok := idx.configMaps[key]; !ok {
+			idx.configMaps[key] = configMap
+		}
+	}
+	for _, ep := range resources.EnvoyProxiesForGateways {
+		key := types.NamespacedName{Namespace: ep.Namespace, Name: ep.Name}
+		if _, ok := idx.envoyProxies[key]; !ok {
+			idx.envoyProxies[key] = ep
+		}
+	}
+	for _, endpointSlice := range resources.EndpointSlices {
+		labels := endpointSlice.GetLabels()
+		if name, ok := labels[discoveryv1.LabelServiceName]; ok {
+			key := backendKey{NamespacedName: types.NamespacedName{Namespace: endpointSlice.Namespace, Name: name}, Kind: KindService}
+			idx.endpointSlices[key] = append(idx.endpointSlices[key], endpointSlice)
+		}
+		if name, ok := labels[mcsapi.LabelServiceName]; ok {
+			key := backendKey{NamespacedName: types.NamespacedName{Namespace: endpointSlice.Namespace, Name: name}, Kind: KindServiceImport}
+			idx.endpointSlices[key] = append(idx.endpointSlices[key], endpointSlice)
+		}
+	}
+	return idx
+}
+
+// getEndpointSlices returns the EndpointSlices GetEndpointSlicesForBackend returns
+// for the backend.
+func (idx *resourceIndex) getEndpointSlices(key backendKey) []*discoveryv1.EndpointSlice {
+	if key.Kind != KindService && key.Kind != KindServiceImport {
+		return idx.resources.GetEndpointSlicesForBackend(key.Namespace, key.Name, key.Kind)
+	}
+	return idx.endpointSlices[key]
+}
@@ -6,10 +6,17 @@
 package gatewayapi
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
+	"slices"
 	"sort"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -23,37 +30,19 @@ import (
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/status"
 	"github.com/envoyproxy/gateway/internal/utils"
+	"github.com/envoyproxy/gateway/internal/wasm"
 )
 
 func (t *Translator) ProcessEnvoyExtensionPolicies(envoyExtensionPolicies []*egv1a1.EnvoyExtensionPolicy,
-	gateways []*GatewayContext,
-	routes []RouteContext,
 	resources *Resources,
-	xdsIR XdsIRMap) []*egv1a1.EnvoyExtensionPolicy {
+	xdsIR XdsIRMap, index *policyIndex) []*egv1a1.EnvoyExtensionPolicy {
 	var res []*egv1a1.EnvoyExtensionPolicy
 
 	// Sort based on timestamp
-	sort.Slice(envoyExtensionPolicies, func(i, j int) bool {
+	sort.SliceStable(envoyExtensionPolicies, func(i, j int) bool {
 		return envoyExtensionPolicies[i].CreationTimestamp.Before(&(envoyExtensionPolicies[j].CreationTimestamp))
 	})
 
-	// First build a map out of the routes and gateways for faster lookup since users might have thousands of routes or more.
-	routeMap := map[policyTargetRouteKey]*policyRouteTargetContext{}
-	for _, route := range routes {
-		key := policyTargetRouteKey{
-			Kind:      string(GetRouteType(route)),
-			Name:      route.GetName(),
-			Namespace: route.GetNamespace(),
-		}
-		routeMap[key] = &policyRouteTargetContext{RouteContext: route}
-	}
-
-	gatewayMap := map[types.NamespacedName]*policyGatewayTargetContext{}
-	for _, gw := range gateways {
-		key := utils.NamespacedName(gw)
-		gatewayMap[key] = &policyGatewayTargetContext{GatewayContext: gw}
-	}
-
 	// Map of Gateway to the routes attached to it
 	gatewayRouteMap := make(map[string]sets.Set[string])
 
@@ -68,7 +57,7 @@ func (t *Translator) ProcessEnvoyExtensionPolicies(envoyExtensionPolicies []*egv
 			res = append(res, policy)
 
 			// Negative statuses have already been assigned so its safe to skip
-			route, resolveErr := resolveEEPolicyRouteTargetRef(policy, routeMap)
+			route, resolveErr := resolveEEPolicyRouteTargetRef(policy, index.routes)
 			if route == nil {
 				continue
 			}
@@ -113,13 +102,8 @@ func (t *Translator) ProcessEnvoyExtensionPolicies(envoyExtensionPolicies []*egv
 			}
 
 			// Set conditions for translation error if it got any
-			if err := t.translateEnvoyExtensionPolicyForRoute(policy, route, xdsIR, resources); err != nil {
-				status.SetTranslationErrorForPolicyAncestors(&policy.Status,
-					ancestorRefs,
-					t.GatewayControllerName,
-					policy.Generation,
-					status.Error2ConditionMsg(err),
-				)
+			if err := t.translateEnvoyExtensionPolicyForRoute(policy, route, resources, index); err != nil {
+				t.setEEPolicyTranslationError(policy, ancestorRefs, err)
 			}
 
 			// Set Accepted condition if it is unset
@@ -134,7 +118,7 @@ func (t *Translator) ProcessEnvoyExtensionPolicies(envoyExtensionPolicies []*egv
 			res = append(res, policy)
 
 			// Negative statuses have already been assigned so its safe to skip
-			gateway, resolveErr := resolveEEPolicyGatewayTargetRef(policy, gatewayMap)
+			gateway, resolveErr := resolveEEPolicyGatewayTargetRef(policy, index.gateways)
 			if gateway == nil {
 				continue
 			}
@@ -159,13 +143,8 @@ func (t *Translator) ProcessEnvoyExtensionPolicies(envoyExtensionPolicies []*egv
 			}
 
 			// Set conditions for translation error if it got any
-			if err := t.translateEnvoyExtensionPolicyForGateway(policy, gateway, xdsIR, resources); err != nil {
-				status.SetTranslationErrorForPolicyAncestors(&policy.Status,
-					ancestorRefs,
-					t.GatewayControllerName,
-					policy.Generation,
-					status.Error2ConditionMsg(err),
-				)
+			if err := t.translateEnvoyExtensionPolicyForGateway(policy, gateway, xdsIR, resources, index); err != nil {
+				t.setEEPolicyTranslationError(policy, ancestorRefs, err)
 			}
 
 			// Set Accepted condition if it is unset
@@ -195,6 +174,30 @@ func (t *Translator) ProcessEnvoyExtensionPolicies(envoyExtensionPolicies []*egv
 	return res
 }
 
+// setEEPolicyTranslationError sets the conditions for the translation error of
+// the policy, which is pending while the wasm modules it uses are being pulled.
+func (t *Translator) setEEPolicyTranslationError(policy *egv1a1.EnvoyExtensionPolicy, ancestorRefs []gwv1a2.ParentReference, err error) {
+	if errors.Is(err, wasm.ErrPullPending) {
+		status.SetConditionForPolicyAncestors(&policy.Status,
+			ancestorRefs,
+			t.GatewayControllerName,
+			gwv1a2.PolicyConditionAccepted,
+			metav1.ConditionFalse,
+			egv1a1.PolicyReasonPending,
+			status.Error2ConditionMsg(err),
+			policy.Generation,
+		)
+		return
+	}
+
+	status.SetTranslationErrorForPolicyAncestors(&policy.Status,
+		ancestorRefs,
+		t.GatewayControllerName,
+		policy.Generation,
+		status.Error2ConditionMsg(err),
+	)
+}
+
 func resolveEEPolicyGatewayTargetRef(policy *egv1a1.EnvoyExtensionPolicy, gateways map[types.NamespacedName]*policyGatewayTargetContext) (*GatewayContext, *status.PolicyResolveError) {
 	targetNs := policy.Spec.TargetRef.Namespace
 	// If empty, default to namespace of policy
@@ -226,7 +229,7 @@ func resolveEEPolicyGatewayTargetRef(policy *egv1a1.EnvoyExtensionPolicy, gatewa
 	}
 
 	// Check if another policy targeting the same Gateway exists
-	if gateway.attached {
+	if gateway.attached.Has(egv1a1.KindEnvoyExtensionPolicy) {
 		message := "Unable to target Gateway, another EnvoyExtensionPolicy has already attached to it"
 
 		return gateway.GatewayContext, &status.PolicyResolveError{
@@ -236,7 +239,7 @@ func resolveEEPolicyGatewayTargetRef(policy *egv1a1.EnvoyExtensionPolicy, gatewa
 	}
 
 	// Set context and save
-	gateway.attached = true
+	gateway.attached.Insert(egv1a1.KindEnvoyExtensionPolicy)
 	gateways[key] = gateway
 
 	return gateway.GatewayContext, nil
@@ -274,7 +277,7 @@ func resolveEEPolicyRouteTargetRef(policy *egv1a1.EnvoyExtensionPolicy, routes m
 	}
 
 	// Check if another policy targeting the same xRoute exists
-	if route.attached {
+	if route.attached.Has(egv1a1.KindEnvoyExtensionPolicy) {
 		message := fmt.Sprintf("Unable to target %s, another EnvoyExtensionPolicy has already attached to it",
 			string(policy.Spec.TargetRef.Kind))
 
@@ -285,35 +288,32 @@ func resolveEEPolicyRouteTargetRef(policy *egv1a1.EnvoyExtensionPolicy, routes m
 	}
 
 	// Set context and save
-	route.attached = true
+	route.attached.Insert(egv1a1.KindEnvoyExtensionPolicy)
 	routes[key] = route
 
 	return route.RouteContext, nil
 }
 
 func (t *Translator) translateEnvoyExtensionPolicyForRoute(policy *egv1a1.EnvoyExtensionPolicy, route RouteContext,
-	xdsIR XdsIRMap, resources *Resources) error {
+	resources *Resources, index *policyIndex) error {
 	// Apply IR to all relevant routes
-	prefix := irRoutePrefix(route)
-	for _, ir := range xdsIR {
-		for _, http := range ir.HTTP {
-			for _, r := range http.Routes {
-				// Apply if there is a match
-				if strings.HasPrefix(r.Name, prefix) {
-					if extProcs, err := t.buildExtProcs(policy, resources); err == nil {
-						r.ExtProcs = extProcs
-					} else {
-						return err
-					}
-				}
-			}
+	for _, r := range index.irHTTPRoutes(route) {
+		extProcs, err := t.buildExtProcs(policy, resources, index)
+		if err != nil {
+			return err
+		}
+		wasms, err := t.buildWasms(policy, resources)
+		if err != nil {
+			return err
 		}
+		r.ExtProcs = extProcs
+		r.Wasms = wasms
 	}
 
 	return nil
 }
 
-func (t *Translator) buildExtProcs(policy *egv1a1.EnvoyExtensionPolicy, resources *Resources) ([]ir.ExtProc, error) {
+func (t *Translator) buildExtProcs(policy *egv1a1.EnvoyExtensionPolicy, resources *Resources, index *policyIndex) ([]ir.ExtProc, error) {
 	var extProcIRList []ir.ExtProc
 
 	if policy == nil {
@@ -323,7 +323,7 @@ func (t *Translator) buildExtProcs(policy *egv1a1.EnvoyExtensionPolicy, resource
 	if len(policy.Spec.ExtProc) > 0 {
 		for idx, ep := range policy.Spec.ExtProc {
 			name := irConfigNameForEEP(policy, idx)
-			extProcIR, err := t.buildExtProc(name, utils.NamespacedName(policy), ep, idx, resources)
+			extProcIR, err := t.buildExtProc(name, utils.NamespacedName(policy), ep, idx, resources, index)
 			if err != nil {
 				return nil, err
 			}
@@ -334,7 +334,7 @@ func (t *Translator) buildExtProcs(policy *egv1a1.EnvoyExtensionPolicy, resource
 }
 
 func (t *Translator) translateEnvoyExtensionPolicyForGateway(policy *egv1a1.EnvoyExtensionPolicy,
-	gateway *GatewayContext, xdsIR XdsIRMap, resources *Resources) error {
+	gateway *GatewayContext, xdsIR XdsIRMap, resources *Resources, index *policyIndex) error {
 
 	irKey := t.getIRKey(gateway.Gateway)
 	// Should exist since we've validated this
@@ -345,7 +345,12 @@ func (t *Translator) translateEnvoyExtensionPolicyForGateway(policy *egv1a1.Envo
 		string(policy.Spec.TargetRef.Name),
 	)
 
-	extProcs, err := t.buildExtProcs(policy, resources)
+	extProcs, err := t.buildExtProcs(policy, resources, index)
+	if err != nil {
+		return err
+	}
+
+	wasms, err := t.buildWasms(policy, resources)
 	if err != nil {
 		return err
 	}
@@ -360,8 +365,9 @@ func (t *Translator) translateEnvoyExtensionPolicyForGateway(policy *egv1a1.Envo
 		// targeting a lesser specific scope(Gateway).
 		for _, r := range http.Routes {
 			// if already set - there's a route level policy, so skip
-			if r.ExtProcs == nil {
+			if r.ExtProcs == nil && r.Wasms == nil {
 				r.ExtProcs = extProcs
+				r.Wasms = wasms
 			}
 		}
 	}
@@ -374,7 +380,8 @@ func (t *Translator) buildExtProc(
 	policyNamespacedName types.NamespacedName,
 	extProc egv1a1.ExtProc,
 	extProcIdx int,
-	resources *Resources) (*ir.ExtProc, error) {
+	resources *Resources,
+	index *policyIndex) (*ir.ExtProc, error) {
 	var (
 		backendRef *gwapiv1.BackendObjectReference
 		ds         *ir.DestinationSetting
@@ -402,7 +409,8 @@ func (t *Translator) buildExtProc(
 		policyNamespacedName,
 		egv1a1.KindEnvoyExtensionPolicy,
 		ir.GRPC,
-		resources); err != nil {
+		resources,
+		index); err != nil {
 		return nil, err
 	}
 
@@ -421,6 +429,140 @@ func (t *Translator) buildExtProc(
 	return extProcIR, err
 }
 
+func (t *Translator) buildWasms(policy *egv1a1.EnvoyExtensionPolicy, resources *Resources) ([]ir.Wasm, error) {
+	var wasmIRList []ir.Wasm
+
+	if policy == nil {
+		return nil, nil
+	}
+
+	for idx, wasm := range policy.Spec.WASM {
+		name := irConfigNameForEEP(policy, idx)
+		wasmIR, err := t.buildWasm(name, wasm, policy, resources)
+		if err != nil {
+			return nil, err
+		}
+		wasmIRList = append(wasmIRList, *wasmIR)
+	}
+	return wasmIRList, nil
+}
+
+func (t *Translator) buildWasm(name string, config egv1a1.Wasm, policy *egv1a1.EnvoyExtensionPolicy,
+	resources *Resources) (*ir.Wasm, error) {
+	var code *ir.HTTPWasmCode
+
+	switch config.Code.Type {
+	case egv1a1.HTTPWasmCodeSourceType:
+		if config.Code.HTTP == nil {
+			return nil, fmt.Errorf("missing http for wasm %s with code source type %s", config.Name, config.Code.Type)
+		}
+		if err := validateWasmCodeURL(config.Code.HTTP.URL); err != nil {
+			return nil, fmt.Errorf("invalid url for wasm %s: %w", config.Name, err)
+		}
+		// Envoy requires a checksum to verify the wasm code fetched from a remote source.
+		if config.Code.SHA256 == nil {
+			return nil, fmt.Errorf("missing sha256 for wasm %s with code source type %s", config.Name, config.Code.Type)
+		}
+		if !isSHA256(*config.Code.SHA256) {
+			return nil, fmt.Errorf("invalid sha256 %q for wasm %s", *config.Code.SHA256, config.Name)
+		}
+		code = &ir.HTTPWasmCode{
+			URL:    config.Code.HTTP.URL,
+			SHA256: *config.Code.SHA256,
+		}
+	case egv1a1.ImageWasmCodeSourceType:
+		var err error
+		if code, err = t.buildImageWasmCode(config, policy, resources); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported code source type %s for wasm %s", config.Code.Type, config.Name)
+	}
+
+	if config.Config != nil && !json.Valid(config.Config.Raw) {
+		return nil, fmt.Errorf("invalid config for wasm %s: not a valid JSON", config.Name)
+	}
+
+	return &ir.Wasm{
+		Name:     name,
+		WasmName: config.Name,
+		Config:   config.Config,
+		FailOpen: ptr.Deref(config.FailOpen, false),
+		Code:     code,
+	}, nil
+}
+
+// buildImageWasmCode pulls the wasm code of the OCI image with the pull secret.
+// The URL it is served at by Envoy Gateway is set by processWasmServers, as it
+// is specific to the proxies of each xds IR.
+func (t *Translator) buildImageWasmCode(config egv1a1.Wasm, policy *egv1a1.EnvoyExtensionPolicy,
+	resources *Resources) (*ir.HTTPWasmCode, error) {
+	image := config.Code.Image
+	if image == nil {
+		return nil, fmt.Errorf("missing image for wasm %s with code source type %s", config.Name, config.Code.Type)
+	}
+	if t.WasmCache == nil {
+		return nil, fmt.Errorf("code source type %s is not enabled for wasm %s", config.Code.Type, config.Name)
+	}
+
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupName,
+		kind:      egv1a1.KindEnvoyExtensionPolicy,
+		namespace: policy.Namespace,
+	}
+	secret, err := t.validateSecretRef(false, from, image.PullSecretRef, resources)
+	if err != nil {
+		return nil, err
+	}
+	pullSecret, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok || len(pullSecret) == 0 {
+		return nil, fmt.Errorf("missing %s in pull secret %s/%s for wasm %s",
+			corev1.DockerConfigJsonKey, secret.Namespace, secret.Name, config.Name)
+	}
+
+	opts := wasm.GetOptions{PullSecret: pullSecret}
+	if config.Code.SHA256 != nil {
+		if !isSHA256(*config.Code.SHA256) {
+			return nil, fmt.Errorf("invalid sha256 %q for wasm %s", *config.Code.SHA256, config.Name)
+		}
+		opts.SHA256 = *config.Code.SHA256
+	}
+
+	module, err := t.WasmCache.Get(image.URL, opts)
+	if errors.Is(err, wasm.ErrPullPending) {
+		return nil, fmt.Errorf("image %s for wasm %s is being pulled: %w", image.URL, config.Name, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull image %s for wasm %s: %w", image.URL, config.Name, err)
+	}
+
+	return &ir.HTTPWasmCode{
+		SHA256:      module.SHA256,
+		OriginalURL: image.URL,
+	}, nil
+}
+
+// validateWasmCodeURL validates the URL the wasm code is fetched from by Envoy.
+func validateWasmCodeURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q, must be http or https", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return errors.New("missing host")
+	}
+	return nil
+}
+
+// isSHA256 returns true if the checksum is a hex encoded SHA256 checksum.
+func isSHA256(checksum string) bool {
+	b, err := hex.DecodeString(checksum)
+	return err == nil && len(b) == sha256.Size
+}
+
 func irConfigNameForEEP(policy *egv1a1.EnvoyExtensionPolicy, idx int) string {
 	return fmt.Sprintf(
 		"%s/%s/%d",
@@ -428,3 +570,57 @@ func irConfigNameForEEP(policy *egv1a1.EnvoyExtensionPolicy, idx int) string {
 		utils.NamespacedName(policy).String(),
 		idx)
 }
+
+// processWasmServers enables the Wasm server on the proxy infrastructure of the
+// xds IRs using the wasm modules of OCI images, which are served by Envoy Gateway,
+// and sets the URLs the modules are served at to the proxies of each xds IR.
+func (t *Translator) processWasmServers(xdsIR XdsIRMap, infraIR InfraIRMap) {
+	for key, x := range xdsIR {
+		if t.WasmCache != nil {
+			setWasmServerURLs(x, func(checksum string) string { return t.WasmCache.URL(checksum, key) })
+		}
+		infra, ok := infraIR[key]
+		if !ok || infra.Proxy == nil {
+			continue
+		}
+		infra.Proxy.WasmServer = usesWasmServer(x)
+	}
+}
+
+// setWasmServerURLs sets the URLs of the wasm modules of OCI images of the xds IR.
+// The wasms are copied, as they are shared by the routes of all the xds IRs
+// targeted by a policy.
+func setWasmServerURLs(x *ir.Xds, urlFor func(checksum string) string) {
+	for _, listener := range x.HTTP {
+		for _, route := range listener.Routes {
+			var wasms []ir.Wasm
+			for i, w := range route.Wasms {
+				if w.Code == nil || w.Code.OriginalURL == "" {
+					continue
+				}
+				if wasms == nil {
+					wasms = slices.Clone(route.Wasms)
+				}
+				code := *w.Code
+				code.URL = urlFor(code.SHA256)
+				wasms[i].Code = &code
+			}
+			if wasms != nil {
+				route.Wasms = wasms
+			}
+		}
+	}
+}
+
+func usesWasmServer(x *ir.Xds) bool {
+	for _, listener := range x.HTTP {
+		for _, route := range listener.Routes {
+			for _, w := range route.Wasms {
+				if w.Code != nil && w.Code.OriginalURL != "" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
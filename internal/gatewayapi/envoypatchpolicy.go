@@ -21,7 +21,7 @@ import (
 
 func (t *Translator) ProcessEnvoyPatchPolicies(envoyPatchPolicies []*egv1a1.EnvoyPatchPolicy, xdsIR XdsIRMap) {
 	// Sort based on priority
-	sort.Slice(envoyPatchPolicies, func(i, j int) bool {
+	sort.SliceStable(envoyPatchPolicies, func(i, j int) bool {
 		return envoyPatchPolicies[i].Spec.Priority < envoyPatchPolicies[j].Spec.Priority
 	})
 
@@ -0,0 +1,113 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatchv5 "github.com/evanphx/json-patch/v5"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/api/v1alpha1/validation"
+	"github.com/envoyproxy/gateway/internal/status"
+)
+
+var _ EnvoyProxiesTranslator = (*Translator)(nil)
+
+type EnvoyProxiesTranslator interface {
+	ProcessEnvoyProxies(gateways []*GatewayContext, resources *Resources) []*GatewayContext
+}
+
+// ProcessEnvoyProxies resolves the EnvoyProxy of the Gateways and returns the accepted
+// ones. The EnvoyProxy referenced by the parametersRef of the infrastructure of a Gateway
+// is merged on top of the EnvoyProxy of the GatewayClass. The Gateways whose parametersRef
+// can't be resolved are rejected, and left out of the translation.
+// The parametersRef of the Gateways are ignored when the Gateways are merged, since they
+// share the proxy infrastructure of the GatewayClass.
+func (t *Translator) ProcessEnvoyProxies(gateways []*GatewayContext, resources *Resources) []*GatewayContext {
+	var accepted []*GatewayContext
+	for _, gateway := range gateways {
+		gateway.envoyProxy = resources.EnvoyProxy
+		if t.MergeGateways || gateway.Spec.Infrastructure == nil || gateway.Spec.Infrastructure.ParametersRef == nil {
+			accepted = append(accepted, gateway)
+			continue
+		}
+
+		ep, err := gatewayEnvoyProxy(gateway, resources)
+		if err != nil {
+			status.UpdateGatewayStatusInvalidParametersCondition(gateway.Gateway,
+				fmt.Sprintf("%s: %v", status.MsgGatewayInvalidParams, err))
+			// The listeners of the rejected Gateway aren't processed.
+			gateway.Status.Listeners = nil
+			continue
+		}
+		gateway.envoyProxy = ep
+		accepted = append(accepted, gateway)
+	}
+
+	return accepted
+}
+
+// gatewayEnvoyProxy returns the EnvoyProxy referenced by the parametersRef of the Gateway,
+// merged on top of the EnvoyProxy of the GatewayClass.
+func gatewayEnvoyProxy(gateway *GatewayContext, resources *Resources) (*egv1a1.EnvoyProxy, error) {
+	ref := gateway.Spec.Infrastructure.ParametersRef
+	if string(ref.Group) != egv1a1.GroupVersion.Group || string(ref.Kind) != egv1a1.KindEnvoyProxy {
+		return nil, fmt.Errorf("parametersRef must reference an %s of group %s", egv1a1.KindEnvoyProxy, egv1a1.GroupVersion.Group)
+	}
+
+	// The EnvoyProxy must be in the same namespace as the Gateway.
+	ep := resources.GetEnvoyProxy(gateway.Namespace, ref.Name)
+	if ep == nil {
+		return nil, fmt.Errorf("envoyproxy %s/%s not found", gateway.Namespace, ref.Name)
+	}
+	if ep.Spec.MergeGateways != nil {
+		return nil, fmt.Errorf("envoyproxy %s/%s sets mergeGateways, which can only be set by the envoyproxy of the gatewayclass",
+			ep.Namespace, ep.Name)
+	}
+
+	merged, err := mergeEnvoyProxy(resources.EnvoyProxy, ep)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge envoyproxy %s/%s: %w", ep.Namespace, ep.Name, err)
+	}
+	if err := validation.ValidateEnvoyProxy(merged); err != nil {
+		return nil, fmt.Errorf("invalid envoyproxy %s/%s: %w", ep.Namespace, ep.Name, err)
+	}
+
+	return merged, nil
+}
+
+// mergeEnvoyProxy returns a copy of the EnvoyProxy of the Gateway, whose spec is merged
+// on top of the spec of the EnvoyProxy of the GatewayClass as a JSON merge patch, i.e.
+// the fields set by the Gateway override the fields of the GatewayClass, the objects are
+// merged recursively, and the lists are replaced.
+func mergeEnvoyProxy(classEP, gatewayEP *egv1a1.EnvoyProxy) (*egv1a1.EnvoyProxy, error) {
+	merged := gatewayEP.DeepCopy()
+	if classEP == nil {
+		return merged, nil
+	}
+
+	base, err := json.Marshal(classEP.Spec)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := json.Marshal(gatewayEP.Spec)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := jsonpatchv5.MergePatch(base, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	merged.Spec = egv1a1.EnvoyProxySpec{}
+	if err := json.Unmarshal(spec, &merged.Spec); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
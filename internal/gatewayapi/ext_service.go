@@ -26,7 +26,8 @@ func (t *Translator) processExtServiceDestination(
 	policyNamespacedName types.NamespacedName,
 	policyKind string,
 	protocol ir.AppProtocol,
-	resources *Resources) (*ir.DestinationSetting, error) {
+	resources *Resources,
+	index *policyIndex) (*ir.DestinationSetting, error) {
 	var (
 		endpoints   []*ir.DestinationEndpoint
 		addrType    *ir.DestinationAddressType
@@ -81,7 +82,8 @@ func (t *Translator) processExtServiceDestination(
 			Namespace: ptr.To(gwapiv1.Namespace(policyNamespacedName.Namespace)),
 			Name:      gwapiv1.ObjectName(policyNamespacedName.Name),
 		},
-		resources)
+		resources,
+		index)
 
 	return &ir.DestinationSetting{
 		Weight:      ptr.To(uint32(1)),
@@ -0,0 +1,265 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/status"
+	"github.com/envoyproxy/gateway/internal/utils"
+)
+
+// ProcessExtensionServerPolicies resolves the targets of the policies introduced by the
+// extension, stores the policies in the IR of their targets, so that they are delivered to
+// the extension along with the generated xDS resources, and computes their statuses.
+func (t *Translator) ProcessExtensionServerPolicies(policies []unstructured.Unstructured,
+	xdsIR XdsIRMap, index *policyIndex) []unstructured.Unstructured {
+	var res []unstructured.Unstructured
+
+	// Sort based on timestamp
+	sort.SliceStable(policies, func(i, j int) bool {
+		tsi, tsj := policies[i].GetCreationTimestamp(), policies[j].GetCreationTimestamp()
+		return tsi.Before(&tsj)
+	})
+
+	for _, policy := range policies {
+		policy := policy.DeepCopy()
+		// The status is computed below, and isn't delivered to the extension
+		delete(policy.Object, "status")
+
+		targetRef, err := extractTargetRef(policy)
+		if err != nil {
+			// The target of the policy is unknown, so no ancestor can be set in its status
+			continue
+		}
+
+		var (
+			policyStatus gwv1a2.PolicyStatus
+			ancestorRefs []gwv1a2.ParentReference
+			resolveErr   *status.PolicyResolveError
+		)
+		switch targetRef.Kind {
+		case KindGateway:
+			var gateway *GatewayContext
+			gateway, resolveErr = resolveExtServerPolicyGatewayTargetRef(policy, targetRef, index.gateways)
+			if gateway == nil {
+				continue
+			}
+			ancestorRefs = []gwv1a2.ParentReference{
+				getAncestorRefForPolicy(utils.NamespacedName(gateway), targetRef.SectionName),
+			}
+			if resolveErr == nil {
+				t.translateExtServerPolicyForGateway(policy, gateway, targetRef, xdsIR)
+			}
+		case KindHTTPRoute, KindGRPCRoute:
+			var route RouteContext
+			route, resolveErr = resolveExtServerPolicyRouteTargetRef(policy, targetRef, index.routes)
+			if route == nil {
+				continue
+			}
+			for _, p := range GetParentReferences(route) {
+				if p.Kind == nil || *p.Kind == KindGateway {
+					namespace := route.GetNamespace()
+					if p.Namespace != nil {
+						namespace = string(*p.Namespace)
+					}
+					gwNN := types.NamespacedName{
+						Namespace: namespace,
+						Name:      string(p.Name),
+					}
+					ancestorRefs = append(ancestorRefs, getAncestorRefForPolicy(gwNN, p.SectionName))
+				}
+			}
+			if resolveErr == nil {
+				t.translateExtServerPolicyForRoute(policy, route, index)
+			}
+		default:
+			continue
+		}
+
+		// Set conditions for resolve error
+		if resolveErr != nil {
+			status.SetResolveErrorForPolicyAncestors(&policyStatus,
+				ancestorRefs,
+				t.GatewayControllerName,
+				policy.GetGeneration(),
+				resolveErr,
+			)
+		}
+
+		// Set Accepted condition if it is unset
+		status.SetAcceptedForPolicyAncestors(&policyStatus, ancestorRefs, t.GatewayControllerName)
+
+		// The policy stored in the IR doesn't carry its status
+		policy = policy.DeepCopy()
+		if err := setExtServerPolicyStatus(policy, &policyStatus); err != nil {
+			continue
+		}
+		res = append(res, *policy)
+	}
+
+	return res
+}
+
+// extractTargetRef returns the spec.targetRef of a policy introduced by the extension.
+func extractTargetRef(policy *unstructured.Unstructured) (*egv1a1.PolicyTargetReferenceWithSectionName, error) {
+	targetRef, found, err := unstructured.NestedMap(policy.Object, "spec", "targetRef")
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.New("no targetRef found in the spec of the policy")
+	}
+
+	ref := &egv1a1.PolicyTargetReferenceWithSectionName{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(targetRef, ref); err != nil {
+		return nil, err
+	}
+	if ref.Group != gwv1a2.GroupName {
+		return nil, fmt.Errorf("unsupported group %s of the targetRef", ref.Group)
+	}
+	return ref, nil
+}
+
+func resolveExtServerPolicyGatewayTargetRef(policy *unstructured.Unstructured, targetRef *egv1a1.PolicyTargetReferenceWithSectionName,
+	gateways map[types.NamespacedName]*policyGatewayTargetContext) (*GatewayContext, *status.PolicyResolveError) {
+	// If empty, default to namespace of policy
+	targetNs := string(ptr.Deref(targetRef.Namespace, gwv1a2.Namespace(policy.GetNamespace())))
+
+	// Check if the gateway exists
+	key := types.NamespacedName{
+		Name:      string(targetRef.Name),
+		Namespace: targetNs,
+	}
+	gateway, ok := gateways[key]
+
+	// Gateway not found
+	if !ok {
+		return nil, nil
+	}
+
+	// Ensure Policy and target are in the same namespace
+	if policy.GetNamespace() != targetNs {
+		message := fmt.Sprintf("Namespace:%s TargetRef.Namespace:%s, %s can only target a resource in the same namespace.",
+			policy.GetNamespace(), targetNs, policy.GetKind())
+
+		return gateway.GatewayContext, &status.PolicyResolveError{
+			Reason:  gwv1a2.PolicyReasonInvalid,
+			Message: message,
+		}
+	}
+
+	// If sectionName is set, make sure its valid
+	if targetRef.SectionName != nil {
+		found := false
+		for _, l := range gateway.listeners {
+			if l.Name == *targetRef.SectionName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			message := fmt.Sprintf("No section name %s found for %s", *targetRef.SectionName, key.String())
+
+			return gateway.GatewayContext, &status.PolicyResolveError{
+				Reason:  gwv1a2.PolicyReasonInvalid,
+				Message: message,
+			}
+		}
+	}
+
+	return gateway.GatewayContext, nil
+}
+
+func resolveExtServerPolicyRouteTargetRef(policy *unstructured.Unstructured, targetRef *egv1a1.PolicyTargetReferenceWithSectionName,
+	routes map[policyTargetRouteKey]*policyRouteTargetContext) (RouteContext, *status.PolicyResolveError) {
+	// If empty, default to namespace of policy
+	targetNs := string(ptr.Deref(targetRef.Namespace, gwv1a2.Namespace(policy.GetNamespace())))
+
+	// Check if the route exists
+	key := policyTargetRouteKey{
+		Kind:      string(targetRef.Kind),
+		Name:      string(targetRef.Name),
+		Namespace: targetNs,
+	}
+	route, ok := routes[key]
+
+	// Route not found
+	if !ok {
+		return nil, nil
+	}
+
+	// Ensure Policy and target are in the same namespace
+	if policy.GetNamespace() != targetNs {
+		message := fmt.Sprintf("Namespace:%s TargetRef.Namespace:%s, %s can only target a resource in the same namespace.",
+			policy.GetNamespace(), targetNs, policy.GetKind())
+
+		return route.RouteContext, &status.PolicyResolveError{
+			Reason:  gwv1a2.PolicyReasonInvalid,
+			Message: message,
+		}
+	}
+
+	return route.RouteContext, nil
+}
+
+func (t *Translator) translateExtServerPolicyForGateway(policy *unstructured.Unstructured, gateway *GatewayContext,
+	targetRef *egv1a1.PolicyTargetReferenceWithSectionName, xdsIR XdsIRMap) {
+	// Find IR
+	irKey := t.getIRKey(gateway.Gateway)
+	// It must exist since we've already finished processing the gateways
+	gwXdsIR := xdsIR[irKey]
+
+	for _, l := range gateway.listeners {
+		if targetRef.SectionName != nil && l.Name != *targetRef.SectionName {
+			continue
+		}
+
+		irListenerName := irHTTPListenerName(l)
+		for _, http := range gwXdsIR.HTTP {
+			if http.Name == irListenerName {
+				http.ExtensionPolicies = append(http.ExtensionPolicies, &ir.UnstructuredRef{Object: policy})
+				break
+			}
+		}
+	}
+}
+
+func (t *Translator) translateExtServerPolicyForRoute(policy *unstructured.Unstructured, route RouteContext, index *policyIndex) {
+	// Apply IR to all relevant routes
+	for _, r := range index.irHTTPRoutes(route) {
+		r.ExtensionPolicies = append(r.ExtensionPolicies, &ir.UnstructuredRef{Object: policy})
+	}
+}
+
+func setExtServerPolicyStatus(policy *unstructured.Unstructured, policyStatus *gwv1a2.PolicyStatus) error {
+	s, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policyStatus)
+	if err != nil {
+		return err
+	}
+	policy.Object["status"] = s
+	return nil
+}
+
+// ExtServerPolicyStatusAsPolicyStatus returns the status of a policy introduced by the
+// extension, as computed by the translator.
+func ExtServerPolicyStatusAsPolicyStatus(policy *unstructured.Unstructured) gwv1a2.PolicyStatus {
+	var policyStatus gwv1a2.PolicyStatus
+	if s, ok := policy.Object["status"].(map[string]any); ok {
+		_ = runtime.DefaultUnstructuredConverter.FromUnstructured(s, &policyStatus)
+	}
+	return policyStatus
+}
@@ -0,0 +1,610 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/utils"
+)
+
+// TranslationCache holds the results of the last translation of the translation
+// units of a GatewayClass, along with the resources each of them depends on, so
+// that only the units affected by a change of the resources are translated again.
+type TranslationCache struct {
+	// translator holds the settings of the last translation.
+	translator *Translator
+	// shared holds the resources shared by all the units in the last translation,
+	// which aren't tracked per unit.
+	shared *Resources
+	units  map[string]*cachedTranslationUnit
+}
+
+type cachedTranslationUnit struct {
+	// resources holds a copy of the resources of the unit, sorted by namespace and
+	// name, taken before it was translated.
+	resources    *Resources
+	dependencies *Dependencies
+	result       *TranslateResult
+}
+
+// NewTranslationCache returns an empty TranslationCache.
+func NewTranslationCache() *TranslationCache {
+	return &TranslationCache{
+		units: map[string]*cachedTranslationUnit{},
+	}
+}
+
+// translationUnit holds the resources of a group of Gateways which are translated
+// together, independently of the other Gateways of the GatewayClass: the Gateways,
+// the routes attached to them and the policies targeting them or their routes.
+// Gateways sharing a route, or a backend targeted by a BackendTLSPolicy, belong to
+// the same unit. The routes and policies which aren't attached to any Gateway
+// belong to the unit without Gateways.
+type translationUnit struct {
+	// key identifies the unit by the namespaced names of its Gateways.
+	key       string
+	resources *Resources
+}
+
+// TranslateIncrementally returns the same result as Translate, but only translates
+// the translation units whose resources, or the shared resources they looked up
+// during their last translation, changed since the translation cached, and reuses
+// the cached results of the other units. The units rejected by the ShardFilter
+// are left out of the results. It also returns the merged result of the
+// translated units, which holds the IRs and statuses which may have changed.
+func (t *Translator) TranslateIncrementally(resources *Resources, cache *TranslationCache) (result, translated *TranslateResult) {
+	settings := *t
+	settings.WasmCache = nil
+	settings.ShardFilter = nil
+	shared := sharedResources(resources)
+	if !reflect.DeepEqual(cache.translator, &settings) || !reflect.DeepEqual(cache.shared, shared) {
+		cache.units = map[string]*cachedTranslationUnit{}
+	}
+	cache.translator = &settings
+	cache.shared = shared
+
+	idx := newResourceIndex(resources)
+	// Take the positions of the resources before the translation sorts the policies
+	positions := newResourcePositions(resources)
+	units := map[string]*cachedTranslationUnit{}
+	var results, translatedResults []*TranslateResult
+	for _, unit := range t.splitResources(resources) {
+		// The units owned by the other replicas are neither translated nor cached
+		if t.ShardFilter != nil && !t.ShardFilter(unit.key) {
+			continue
+		}
+
+		sorted := sortedResources(unit.resources)
+		if cached, ok := cache.units[unit.key]; ok && reflect.DeepEqual(cached.resources, sorted) && !cached.dependencies.changed(idx) {
+			units[unit.key] = cached
+			results = append(results, cached.result)
+			continue
+		}
+
+		// Copy the resources of the unit before the translation modifies them
+		cached := &cachedTranslationUnit{
+			resources:    sorted.DeepCopy(),
+			dependencies: newDependencies(),
+		}
+		unitResources := *resources
+		unitResources.Gateways = unit.resources.Gateways
+		unitResources.HTTPRoutes = unit.resources.HTTPRoutes
+		unitResources.GRPCRoutes = unit.resources.GRPCRoutes
+		unitResources.TLSRoutes = unit.resources.TLSRoutes
+		unitResources.TCPRoutes = unit.resources.TCPRoutes
+		unitResources.UDPRoutes = unit.resources.UDPRoutes
+		unitResources.EnvoyPatchPolicies = unit.resources.EnvoyPatchPolicies
+		unitResources.ClientTrafficPolicies = unit.resources.ClientTrafficPolicies
+		unitResources.BackendTrafficPolicies = unit.resources.BackendTrafficPolicies
+		unitResources.SecurityPolicies = unit.resources.SecurityPolicies
+		unitResources.BackendTLSPolicies = unit.resources.BackendTLSPolicies
+		unitResources.EnvoyExtensionPolicies = unit.resources.EnvoyExtensionPolicies
+		unitResources.ExtensionServerPolicies = unit.resources.ExtensionServerPolicies
+		unitResources.Dependencies = cached.dependencies
+		cached.result = t.Translate(&unitResources)
+
+		// The translation of the units fetching remote resources, i.e. the OIDC
+		// provider configurations and the Wasm code, isn't cached since the remote
+		// resources may change at any time.
+		if !fetchesRemoteResources(unit.resources) {
+			units[unit.key] = cached
+		}
+		results = append(results, cached.result)
+		translatedResults = append(translatedResults, cached.result)
+	}
+	cache.units = units
+
+	return mergeTranslateResults(results, positions), mergeTranslateResults(translatedResults, positions)
+}
+
+// splitResources splits the Gateways of the GatewayClass, and the routes and
+// policies attached to them, into translation units sorted by key. The resources
+// of the units keep the order of the resources.
+func (t *Translator) splitResources(resources *Resources) []*translationUnit {
+	// All the Gateways share the same IR when they are merged
+	if t.MergeGateways {
+		return []*translationUnit{{key: string(t.GatewayClassName), resources: resources}}
+	}
+
+	parents := map[types.NamespacedName]types.NamespacedName{}
+	for _, gateway := range resources.Gateways {
+		if gateway.Spec.GatewayClassName == t.GatewayClassName {
+			key := utils.NamespacedName(gateway)
+			parents[key] = key
+		}
+	}
+	// find returns the Gateway representing the unit of the Gateway.
+	var find func(gw types.NamespacedName) types.NamespacedName
+	find = func(gw types.NamespacedName) types.NamespacedName {
+		if parents[gw] != gw {
+			parents[gw] = find(parents[gw])
+		}
+		return parents[gw]
+	}
+	union := func(gateways []types.NamespacedName) {
+		for _, gw := range gateways[min(len(gateways), 1):] {
+			parents[find(gw)] = find(gateways[0])
+		}
+	}
+
+	// Group the Gateways sharing a route
+	routeGateways := map[routeKey][]types.NamespacedName{}
+	// backendGateways holds the Gateways using each backend, which are grouped if
+	// the backend is targeted by a BackendTLSPolicy, since the status of the policy
+	// lists them all.
+	backendGateways := map[types.NamespacedName][]types.NamespacedName{}
+	addRoute := func(kind string, namespace, name string, parentRefs []gwapiv1.ParentReference, backendRefs []gwapiv1.BackendObjectReference) {
+		var gateways []types.NamespacedName
+		for _, parentRef := range parentRefs {
+			gw := types.NamespacedName{Namespace: NamespaceDerefOr(parentRef.Namespace, namespace), Name: string(parentRef.Name)}
+			if _, ok := parents[gw]; ok && KindDerefOr(parentRef.Kind, KindGateway) == KindGateway {
+				gateways = append(gateways, gw)
+			}
+		}
+		union(gateways)
+		routeGateways[routeKey{kind: kind, NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}] = gateways
+		for _, backendRef := range backendRefs {
+			backend := types.NamespacedName{Namespace: NamespaceDerefOr(backendRef.Namespace, namespace), Name: string(backendRef.Name)}
+			backendGateways[backend] = append(backendGateways[backend], gateways...)
+		}
+	}
+	for _, r := range resources.HTTPRoutes {
+		addRoute(KindHTTPRoute, r.Namespace, r.Name, r.Spec.ParentRefs, httpRouteBackendRefs(r))
+	}
+	for _, r := range resources.GRPCRoutes {
+		addRoute(KindGRPCRoute, r.Namespace, r.Name, r.Spec.ParentRefs, grpcRouteBackendRefs(r))
+	}
+	for _, r := range resources.TLSRoutes {
+		var backendRefs []gwapiv1.BackendObjectReference
+		for _, rule := range r.Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				backendRefs = append(backendRefs, backendRef.BackendObjectReference)
+			}
+		}
+		addRoute(KindTLSRoute, r.Namespace, r.Name, r.Spec.ParentRefs, backendRefs)
+	}
+	for _, r := range resources.TCPRoutes {
+		var backendRefs []gwapiv1.BackendObjectReference
+		for _, rule := range r.Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				backendRefs = append(backendRefs, backendRef.BackendObjectReference)
+			}
+		}
+		addRoute(KindTCPRoute, r.Namespace, r.Name, r.Spec.ParentRefs, backendRefs)
+	}
+	for _, r := range resources.UDPRoutes {
+		var backendRefs []gwapiv1.BackendObjectReference
+		for _, rule := range r.Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				backendRefs = append(backendRefs, backendRef.BackendObjectReference)
+			}
+		}
+		addRoute(KindUDPRoute, r.Namespace, r.Name, r.Spec.ParentRefs, backendRefs)
+	}
+
+	// targetGateway returns the Gateway named by the target of a policy, whatever
+	// its kind, like the ClientTrafficPolicies and EnvoyPatchPolicies resolve it.
+	targetGateway := func(policyNamespace string, targetRef egv1a1.PolicyTargetReference) []types.NamespacedName {
+		key := types.NamespacedName{Namespace: NamespaceDerefOrAlpha(targetRef.Namespace, policyNamespace), Name: string(targetRef.Name)}
+		if _, ok := parents[key]; ok {
+			return []types.NamespacedName{key}
+		}
+		return nil
+	}
+	// targetGateways returns the Gateways of the target of a policy, which is
+	// either a Gateway or a route.
+	targetGateways := func(policyNamespace string, targetRef egv1a1.PolicyTargetReference) []types.NamespacedName {
+		if string(targetRef.Kind) == KindGateway {
+			return targetGateway(policyNamespace, targetRef)
+		}
+		key := types.NamespacedName{Namespace: NamespaceDerefOrAlpha(targetRef.Namespace, policyNamespace), Name: string(targetRef.Name)}
+		return routeGateways[routeKey{kind: string(targetRef.Kind), NamespacedName: key}]
+	}
+
+	// The policies using a backend are grouped with their targets
+	for _, p := range resources.SecurityPolicies {
+		if p.Spec.ExtAuth == nil {
+			continue
+		}
+		if backendRef := extAuthBackendRef(p.Spec.ExtAuth); backendRef != nil {
+			backend := types.NamespacedName{Namespace: NamespaceDerefOr(backendRef.Namespace, p.Namespace), Name: string(backendRef.Name)}
+			backendGateways[backend] = append(backendGateways[backend], targetGateways(p.Namespace, p.Spec.TargetRef.PolicyTargetReference)...)
+		}
+	}
+	for _, p := range resources.EnvoyExtensionPolicies {
+		gateways := targetGateways(p.Namespace, p.Spec.TargetRef.PolicyTargetReference)
+		for _, extProc := range p.Spec.ExtProc {
+			backendRef := extProc.BackendRef.BackendObjectReference
+			backend := types.NamespacedName{Namespace: NamespaceDerefOr(backendRef.Namespace, p.Namespace), Name: string(backendRef.Name)}
+			backendGateways[backend] = append(backendGateways[backend], gateways...)
+		}
+	}
+	btlsGateways := make([][]types.NamespacedName, len(resources.BackendTLSPolicies))
+	for i, p := range resources.BackendTLSPolicies {
+		for _, targetRef := range p.Spec.TargetRefs {
+			backend := types.NamespacedName{Namespace: p.Namespace, Name: string(targetRef.Name)}
+			btlsGateways[i] = append(btlsGateways[i], backendGateways[backend]...)
+		}
+		union(btlsGateways[i])
+	}
+
+	// Assign the resources to the unit of their Gateways
+	units := map[types.NamespacedName]*Resources{}
+	unitOf := func(gateways []types.NamespacedName) *Resources {
+		// The resources without Gateways belong to the unit keyed by an empty
+		// namespaced name, which never matches a Gateway.
+		var key types.NamespacedName
+		if len(gateways) > 0 {
+			key = find(gateways[0])
+		}
+		if units[key] == nil {
+			units[key] = &Resources{}
+		}
+		return units[key]
+	}
+	for _, gw := range resources.Gateways {
+		var gateways []types.NamespacedName
+		if _, ok := parents[utils.NamespacedName(gw)]; ok {
+			gateways = append(gateways, utils.NamespacedName(gw))
+		}
+		u := unitOf(gateways)
+		u.Gateways = append(u.Gateways, gw)
+	}
+	for _, r := range resources.HTTPRoutes {
+		u := unitOf(routeGateways[routeKey{kind: KindHTTPRoute, NamespacedName: utils.NamespacedName(r)}])
+		u.HTTPRoutes = append(u.HTTPRoutes, r)
+	}
+	for _, r := range resources.GRPCRoutes {
+		u := unitOf(routeGateways[routeKey{kind: KindGRPCRoute, NamespacedName: utils.NamespacedName(r)}])
+		u.GRPCRoutes = append(u.GRPCRoutes, r)
+	}
+	for _, r := range resources.TLSRoutes {
+		u := unitOf(routeGateways[routeKey{kind: KindTLSRoute, NamespacedName: utils.NamespacedName(r)}])
+		u.TLSRoutes = append(u.TLSRoutes, r)
+	}
+	for _, r := range resources.TCPRoutes {
+		u := unitOf(routeGateways[routeKey{kind: KindTCPRoute, NamespacedName: utils.NamespacedName(r)}])
+		u.TCPRoutes = append(u.TCPRoutes, r)
+	}
+	for _, r := range resources.UDPRoutes {
+		u := unitOf(routeGateways[routeKey{kind: KindUDPRoute, NamespacedName: utils.NamespacedName(r)}])
+		u.UDPRoutes = append(u.UDPRoutes, r)
+	}
+	for _, p := range resources.EnvoyPatchPolicies {
+		u := unitOf(targetGateway(p.Namespace, p.Spec.TargetRef))
+		u.EnvoyPatchPolicies = append(u.EnvoyPatchPolicies, p)
+	}
+	for _, p := range resources.ClientTrafficPolicies {
+		u := unitOf(targetGateway(p.Namespace, p.Spec.TargetRef.PolicyTargetReference))
+		u.ClientTrafficPolicies = append(u.ClientTrafficPolicies, p)
+	}
+	for _, p := range resources.BackendTrafficPolicies {
+		u := unitOf(targetGateways(p.Namespace, p.Spec.TargetRef.PolicyTargetReference))
+		u.BackendTrafficPolicies = append(u.BackendTrafficPolicies, p)
+	}
+	for _, p := range resources.SecurityPolicies {
+		u := unitOf(targetGateways(p.Namespace, p.Spec.TargetRef.PolicyTargetReference))
+		u.SecurityPolicies = append(u.SecurityPolicies, p)
+	}
+	for i, p := range resources.BackendTLSPolicies {
+		u := unitOf(btlsGateways[i])
+		u.BackendTLSPolicies = append(u.BackendTLSPolicies, p)
+	}
+	for _, p := range resources.EnvoyExtensionPolicies {
+		u := unitOf(targetGateways(p.Namespace, p.Spec.TargetRef.PolicyTargetReference))
+		u.EnvoyExtensionPolicies = append(u.EnvoyExtensionPolicies, p)
+	}
+	for _, p := range resources.ExtensionServerPolicies {
+		var gateways []types.NamespacedName
+		if targetRef, err := extractTargetRef(&p); err == nil {
+			gateways = targetGateways(p.GetNamespace(), targetRef.PolicyTargetReference)
+		}
+		u := unitOf(gateways)
+		u.ExtensionServerPolicies = append(u.ExtensionServerPolicies, p)
+	}
+
+	res := make([]*translationUnit, 0, len(units))
+	for _, u := range units {
+		var gateways []string
+		for _, gw := range u.Gateways {
+			if _, ok := parents[utils.NamespacedName(gw)]; ok {
+				gateways = append(gateways, utils.NamespacedName(gw).String())
+			}
+		}
+		sort.Strings(gateways)
+		res = append(res, &translationUnit{key: strings.Join(gateways, ","), resources: u})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].key < res[j].key
+	})
+	return res
+}
+
+// routeKey identifies a route by kind, namespace and name.
+type routeKey struct {
+	types.NamespacedName
+	kind string
+}
+
+func httpRouteBackendRefs(route *gwapiv1.HTTPRoute) []gwapiv1.BackendObjectReference {
+	var backendRefs []gwapiv1.BackendObjectReference
+	addMirrors := func(filters []gwapiv1.HTTPRouteFilter) {
+		for _, filter := range filters {
+			if filter.RequestMirror != nil {
+				backendRefs = append(backendRefs, filter.RequestMirror.BackendRef)
+			}
+		}
+	}
+	for _, rule := range route.Spec.Rules {
+		addMirrors(rule.Filters)
+		for _, backendRef := range rule.BackendRefs {
+			backendRefs = append(backendRefs, backendRef.BackendObjectReference)
+			addMirrors(backendRef.Filters)
+		}
+	}
+	return backendRefs
+}
+
+func grpcRouteBackendRefs(route *gwapiv1a2.GRPCRoute) []gwapiv1.BackendObjectReference {
+	var backendRefs []gwapiv1.BackendObjectReference
+	addMirrors := func(filters []gwapiv1.GRPCRouteFilter) {
+		for _, filter := range filters {
+			if filter.RequestMirror != nil {
+				backendRefs = append(backendRefs, filter.RequestMirror.BackendRef)
+			}
+		}
+	}
+	for _, rule := range route.Spec.Rules {
+		addMirrors(rule.Filters)
+		for _, backendRef := range rule.BackendRefs {
+			backendRefs = append(backendRefs, backendRef.BackendObjectReference)
+			addMirrors(backendRef.Filters)
+		}
+	}
+	return backendRefs
+}
+
+func extAuthBackendRef(extAuth *egv1a1.ExtAuth) *gwapiv1.BackendObjectReference {
+	switch {
+	case extAuth.GRPC != nil:
+		return &extAuth.GRPC.BackendRef
+	case extAuth.HTTP != nil:
+		return &extAuth.HTTP.BackendRef
+	}
+	return nil
+}
+
+// sharedResources returns the resources shared by all the translation units
+// whose lookups aren't tracked per unit, sorted by namespace and name.
+func sharedResources(resources *Resources) *Resources {
+	shared := &Resources{
+		GatewayClass:        resources.GatewayClass,
+		EnvoyProxy:          resources.EnvoyProxy,
+		ReferenceGrants:     append([]*gwapiv1b1.ReferenceGrant(nil), resources.ReferenceGrants...),
+		ExtensionRefFilters: append([]unstructured.Unstructured(nil), resources.ExtensionRefFilters...),
+	}
+	// The CA certificates of the BackendTLSPolicies are looked up in all the ConfigMaps
+	if len(resources.BackendTLSPolicies) > 0 {
+		shared.ConfigMaps = append(shared.ConfigMaps, resources.ConfigMaps...)
+		sortByNamespacedName(shared.ConfigMaps)
+	}
+	sortByNamespacedName(shared.ReferenceGrants)
+	sortUnstructuredByNamespacedName(shared.ExtensionRefFilters)
+	return shared
+}
+
+// sortedResources returns the resources of a translation unit sorted by namespace
+// and name, so that they are compared regardless of the order of the resources.
+func sortedResources(resources *Resources) *Resources {
+	sorted := &Resources{
+		Gateways:                append([]*gwapiv1.Gateway(nil), resources.Gateways...),
+		HTTPRoutes:              append([]*gwapiv1.HTTPRoute(nil), resources.HTTPRoutes...),
+		GRPCRoutes:              append([]*gwapiv1a2.GRPCRoute(nil), resources.GRPCRoutes...),
+		TLSRoutes:               append([]*gwapiv1a2.TLSRoute(nil), resources.TLSRoutes...),
+		TCPRoutes:               append([]*gwapiv1a2.TCPRoute(nil), resources.TCPRoutes...),
+		UDPRoutes:               append([]*gwapiv1a2.UDPRoute(nil), resources.UDPRoutes...),
+		EnvoyPatchPolicies:      append([]*egv1a1.EnvoyPatchPolicy(nil), resources.EnvoyPatchPolicies...),
+		ClientTrafficPolicies:   append([]*egv1a1.ClientTrafficPolicy(nil), resources.ClientTrafficPolicies...),
+		BackendTrafficPolicies:  append([]*egv1a1.BackendTrafficPolicy(nil), resources.BackendTrafficPolicies...),
+		SecurityPolicies:        append([]*egv1a1.SecurityPolicy(nil), resources.SecurityPolicies...),
+		BackendTLSPolicies:      append([]*gwapiv1a3.BackendTLSPolicy(nil), resources.BackendTLSPolicies...),
+		EnvoyExtensionPolicies:  append([]*egv1a1.EnvoyExtensionPolicy(nil), resources.EnvoyExtensionPolicies...),
+		ExtensionServerPolicies: append([]unstructured.Unstructured(nil), resources.ExtensionServerPolicies...),
+	}
+	sortByNamespacedName(sorted.Gateways)
+	sortByNamespacedName(sorted.HTTPRoutes)
+	sortByNamespacedName(sorted.GRPCRoutes)
+	sortByNamespacedName(sorted.TLSRoutes)
+	sortByNamespacedName(sorted.TCPRoutes)
+	sortByNamespacedName(sorted.UDPRoutes)
+	sortByNamespacedName(sorted.EnvoyPatchPolicies)
+	sortByNamespacedName(sorted.ClientTrafficPolicies)
+	sortByNamespacedName(sorted.BackendTrafficPolicies)
+	sortByNamespacedName(sorted.SecurityPolicies)
+	sortByNamespacedName(sorted.BackendTLSPolicies)
+	sortByNamespacedName(sorted.EnvoyExtensionPolicies)
+	sortUnstructuredByNamespacedName(sorted.ExtensionServerPolicies)
+	return sorted
+}
+
+func sortByNamespacedName[T interface {
+	GetNamespace() string
+	GetName() string
+}](objs []T) {
+	sort.SliceStable(objs, func(i, j int) bool {
+		if objs[i].GetNamespace() != objs[j].GetNamespace() {
+			return objs[i].GetNamespace() < objs[j].GetNamespace()
+		}
+		return objs[i].GetName() < objs[j].GetName()
+	})
+}
+
+func sortUnstructuredByNamespacedName(objs []unstructured.Unstructured) {
+	sort.SliceStable(objs, func(i, j int) bool {
+		if objs[i].GetNamespace() != objs[j].GetNamespace() {
+			return objs[i].GetNamespace() < objs[j].GetNamespace()
+		}
+		return objs[i].GetName() < objs[j].GetName()
+	})
+}
+
+// fetchesRemoteResources returns whether the translation of the resources fetches
+// remote resources.
+func fetchesRemoteResources(resources *Resources) bool {
+	for _, p := range resources.SecurityPolicies {
+		if p.Spec.OIDC != nil {
+			return true
+		}
+	}
+	for _, p := range resources.EnvoyExtensionPolicies {
+		if len(p.Spec.WASM) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeTranslateResults merges the results of the translation units, in the
+// order Translate returns the resources in.
+func mergeTranslateResults(results []*TranslateResult, positions resourcePositions) *TranslateResult {
+	merged := &TranslateResult{
+		XdsIR:   make(XdsIRMap),
+		InfraIR: make(InfraIRMap),
+	}
+	for _, result := range results {
+		merged.Gateways = append(merged.Gateways, result.Gateways...)
+		merged.HTTPRoutes = append(merged.HTTPRoutes, result.HTTPRoutes...)
+		merged.GRPCRoutes = append(merged.GRPCRoutes, result.GRPCRoutes...)
+		merged.TLSRoutes = append(merged.TLSRoutes, result.TLSRoutes...)
+		merged.TCPRoutes = append(merged.TCPRoutes, result.TCPRoutes...)
+		merged.UDPRoutes = append(merged.UDPRoutes, result.UDPRoutes...)
+		merged.ClientTrafficPolicies = append(merged.ClientTrafficPolicies, result.ClientTrafficPolicies...)
+		merged.BackendTrafficPolicies = append(merged.BackendTrafficPolicies, result.BackendTrafficPolicies...)
+		merged.SecurityPolicies = append(merged.SecurityPolicies, result.SecurityPolicies...)
+		merged.BackendTLSPolicies = append(merged.BackendTLSPolicies, result.BackendTLSPolicies...)
+		merged.EnvoyExtensionPolicies = append(merged.EnvoyExtensionPolicies, result.EnvoyExtensionPolicies...)
+		merged.ExtensionServerPolicies = append(merged.ExtensionServerPolicies, result.ExtensionServerPolicies...)
+		for key, xdsIR := range result.XdsIR {
+			merged.XdsIR[key] = xdsIR
+		}
+		for key, infraIR := range result.InfraIR {
+			merged.InfraIR[key] = infraIR
+		}
+	}
+
+	sortByPosition(merged.Gateways, positions, KindGateway)
+	sortByPosition(merged.HTTPRoutes, positions, KindHTTPRoute)
+	sortByPosition(merged.GRPCRoutes, positions, KindGRPCRoute)
+	sortByPosition(merged.TLSRoutes, positions, KindTLSRoute)
+	sortByPosition(merged.TCPRoutes, positions, KindTCPRoute)
+	sortByPosition(merged.UDPRoutes, positions, KindUDPRoute)
+	sortByPosition(merged.BackendTLSPolicies, positions, KindBackendTLSPolicy)
+	// The policies are sorted by creation timestamp, and the ones processed first
+	// come first, see the Process*Policies functions.
+	sortPolicies(merged.ClientTrafficPolicies, positions, egv1a1.KindClientTrafficPolicy,
+		func(policy *egv1a1.ClientTrafficPolicy) bool { return hasSectionName(policy) })
+	sortPolicies(merged.BackendTrafficPolicies, positions, egv1a1.KindBackendTrafficPolicy,
+		func(policy *egv1a1.BackendTrafficPolicy) bool { return policy.Spec.TargetRef.Kind != KindGateway })
+	sortPolicies(merged.SecurityPolicies, positions, egv1a1.KindSecurityPolicy,
+		func(policy *egv1a1.SecurityPolicy) bool { return policy.Spec.TargetRef.Kind != KindGateway })
+	sortPolicies(merged.EnvoyExtensionPolicies, positions, egv1a1.KindEnvoyExtensionPolicy,
+		func(policy *egv1a1.EnvoyExtensionPolicy) bool { return policy.Spec.TargetRef.Kind != KindGateway })
+	sort.SliceStable(merged.ExtensionServerPolicies, func(i, j int) bool {
+		a, b := &merged.ExtensionServerPolicies[i], &merged.ExtensionServerPolicies[j]
+		if tsa, tsb := a.GetCreationTimestamp(), b.GetCreationTimestamp(); !tsa.Equal(&tsb) {
+			return tsa.Before(&tsb)
+		}
+		return positions.of(a.GetKind(), a) < positions.of(b.GetKind(), b)
+	})
+	return merged
+}
+
+// resourcePositions holds the positions of the resources in their lists.
+type resourcePositions map[routeKey]int
+
+func newResourcePositions(resources *Resources) resourcePositions {
+	positions := resourcePositions{}
+	addPositions(positions, KindGateway, resources.Gateways)
+	addPositions(positions, KindHTTPRoute, resources.HTTPRoutes)
+	addPositions(positions, KindGRPCRoute, resources.GRPCRoutes)
+	addPositions(positions, KindTLSRoute, resources.TLSRoutes)
+	addPositions(positions, KindTCPRoute, resources.TCPRoutes)
+	addPositions(positions, KindUDPRoute, resources.UDPRoutes)
+	addPositions(positions, KindBackendTLSPolicy, resources.BackendTLSPolicies)
+	addPositions(positions, egv1a1.KindClientTrafficPolicy, resources.ClientTrafficPolicies)
+	addPositions(positions, egv1a1.KindBackendTrafficPolicy, resources.BackendTrafficPolicies)
+	addPositions(positions, egv1a1.KindSecurityPolicy, resources.SecurityPolicies)
+	addPositions(positions, egv1a1.KindEnvoyExtensionPolicy, resources.EnvoyExtensionPolicies)
+	for i := range resources.ExtensionServerPolicies {
+		policy := &resources.ExtensionServerPolicies[i]
+		positions[routeKey{NamespacedName: utils.NamespacedName(policy), kind: policy.GetKind()}] = i
+	}
+	return positions
+}
+
+func addPositions[T client.Object](positions resourcePositions, kind string, objs []T) {
+	for i, obj := range objs {
+		positions[routeKey{NamespacedName: utils.NamespacedName(obj), kind: kind}] = i
+	}
+}
+
+func (p resourcePositions) of(kind string, obj client.Object) int {
+	return p[routeKey{NamespacedName: utils.NamespacedName(obj), kind: kind}]
+}
+
+// sortByPosition sorts the resources by their positions in the resources.
+func sortByPosition[T client.Object](objs []T, positions resourcePositions, kind string) {
+	sort.SliceStable(objs, func(i, j int) bool {
+		return positions.of(kind, objs[i]) < positions.of(kind, objs[j])
+	})
+}
+
+// sortPolicies sorts the policies processed first before the others, then by
+// creation timestamp, then by their positions in the resources.
+func sortPolicies[T client.Object](policies []T, positions resourcePositions, kind string, first func(T) bool) {
+	sort.SliceStable(policies, func(i, j int) bool {
+		a, b := policies[i], policies[j]
+		if first(a) != first(b) {
+			return first(a)
+		}
+		if tsa, tsb := a.GetCreationTimestamp(), b.GetCreationTimestamp(); !tsa.Equal(&tsb) {
+			return tsa.Before(&tsb)
+		}
+		return positions.of(kind, a) < positions.of(kind, b)
+	})
+}
@@ -0,0 +1,352 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+)
+
+const incrementalTestResources = `
+gateways:
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: Gateway
+  metadata:
+    namespace: default
+    name: gateway-1
+  spec:
+    gatewayClassName: envoy-gateway-class
+    listeners:
+    - name: http
+      protocol: HTTP
+      port: 80
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: Gateway
+  metadata:
+    namespace: default
+    name: gateway-2
+  spec:
+    gatewayClassName: envoy-gateway-class
+    listeners:
+    - name: http
+      protocol: HTTP
+      port: 80
+httpRoutes:
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: HTTPRoute
+  metadata:
+    namespace: default
+    name: httproute-1
+  spec:
+    parentRefs:
+    - name: gateway-1
+    rules:
+    - backendRefs:
+      - name: service-1
+        port: 8080
+- apiVersion: gateway.networking.k8s.io/v1
+  kind: HTTPRoute
+  metadata:
+    namespace: default
+    name: httproute-2
+  spec:
+    parentRefs:
+    - name: gateway-2
+    rules:
+    - backendRefs:
+      - name: service-2
+        port: 8080
+backendTrafficPolicies:
+- apiVersion: gateway.envoyproxy.io/v1alpha1
+  kind: BackendTrafficPolicy
+  metadata:
+    namespace: default
+    name: policy-for-route-2
+  spec:
+    targetRef:
+      group: gateway.networking.k8s.io
+      kind: HTTPRoute
+      name: httproute-2
+    timeout:
+      http:
+        connectionIdleTimeout: 10s
+services:
+- apiVersion: v1
+  kind: Service
+  metadata:
+    namespace: default
+    name: service-1
+  spec:
+    clusterIP: 1.1.1.1
+    ports:
+    - name: http
+      port: 8080
+      protocol: TCP
+- apiVersion: v1
+  kind: Service
+  metadata:
+    namespace: default
+    name: service-2
+  spec:
+    clusterIP: 2.2.2.2
+    ports:
+    - name: http
+      port: 8080
+      protocol: TCP
+endpointSlices:
+- apiVersion: discovery.k8s.io/v1
+  kind: EndpointSlice
+  metadata:
+    namespace: default
+    name: service-2-endpoints
+    labels:
+      kubernetes.io/service-name: service-2
+  addressType: IPv4
+  ports:
+  - name: http
+    port: 8080
+    protocol: TCP
+  endpoints:
+  - addresses:
+    - 7.7.7.7
+    conditions:
+      ready: true
+`
+
+func TestTranslateIncrementally(t *testing.T) {
+	translator := &Translator{
+		GatewayControllerName: egv1a1.GatewayControllerName,
+		GatewayClassName:      "envoy-gateway-class",
+	}
+	base := &Resources{}
+	mustUnmarshal(t, []byte(incrementalTestResources), base)
+
+	testCases := []struct {
+		name string
+		// modify changes the resources translated in the previous test case.
+		modify         func(r *Resources)
+		wantTranslated []string
+	}{
+		{
+			name:           "first translation",
+			modify:         func(r *Resources) {},
+			wantTranslated: []string{"default/gateway-1", "default/gateway-2"},
+		},
+		{
+			name:   "unchanged resources",
+			modify: func(r *Resources) {},
+		},
+		{
+			name: "reordered resources",
+			modify: func(r *Resources) {
+				r.Gateways[0], r.Gateways[1] = r.Gateways[1], r.Gateways[0]
+				r.HTTPRoutes[0], r.HTTPRoutes[1] = r.HTTPRoutes[1], r.HTTPRoutes[0]
+			},
+		},
+		{
+			name: "route of a gateway changed",
+			modify: func(r *Resources) {
+				r.HTTPRoutes[1].Spec.Hostnames = []gwapiv1.Hostname{"foo.example.com"}
+			},
+			wantTranslated: []string{"default/gateway-1"},
+		},
+		{
+			name: "policy of a route changed",
+			modify: func(r *Resources) {
+				r.BackendTrafficPolicies[0].Spec.Timeout.HTTP.ConnectionIdleTimeout = ptr.To(gwapiv1.Duration("20s"))
+			},
+			wantTranslated: []string{"default/gateway-2"},
+		},
+		{
+			name: "endpoints of a backend changed",
+			modify: func(r *Resources) {
+				r.EndpointSlices[0].Endpoints[0].Addresses = []string{"8.8.8.8"}
+			},
+			wantTranslated: []string{"default/gateway-2"},
+		},
+		{
+			name: "looked up resource created",
+			modify: func(r *Resources) {
+				slice := r.EndpointSlices[0].DeepCopy()
+				slice.Name = "service-1-endpoints"
+				slice.Labels["kubernetes.io/service-name"] = "service-1"
+				r.EndpointSlices = append(r.EndpointSlices, slice)
+			},
+			wantTranslated: []string{"default/gateway-1"},
+		},
+		{
+			name: "gateway references a missing envoyproxy",
+			modify: func(r *Resources) {
+				for _, gw := range r.Gateways {
+					if gw.Name == "gateway-1" {
+						gw.Spec.Infrastructure = &gwapiv1.GatewayInfrastructure{
+							ParametersRef: &gwapiv1.LocalParametersReference{
+								Group: gwapiv1.Group(egv1a1.GroupVersion.Group),
+								Kind:  gwapiv1.Kind(egv1a1.KindEnvoyProxy),
+								Name:  "custom",
+							},
+						}
+					}
+				}
+			},
+			// The rejected gateway isn't translated to an IR
+		},
+		{
+			name: "envoyproxy of a gateway created",
+			modify: func(r *Resources) {
+				r.EnvoyProxiesForGateways = append(r.EnvoyProxiesForGateways, &egv1a1.EnvoyProxy{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "custom"},
+				})
+			},
+			wantTranslated: []string{"default/gateway-1"},
+		},
+		{
+			name: "envoyproxy of a gateway changed",
+			modify: func(r *Resources) {
+				r.EnvoyProxiesForGateways[0].Spec.Concurrency = ptr.To[int32](4)
+			},
+			wantTranslated: []string{"default/gateway-1"},
+		},
+		{
+			name: "route shared by the gateways",
+			modify: func(r *Resources) {
+				route := r.HTTPRoutes[0].DeepCopy()
+				route.Name = "httproute-3"
+				route.Spec.ParentRefs = []gwapiv1.ParentReference{{Name: "gateway-1"}, {Name: "gateway-2"}}
+				r.HTTPRoutes = append(r.HTTPRoutes, route)
+			},
+			wantTranslated: []string{"default/gateway-1", "default/gateway-2"},
+		},
+		{
+			name: "route of the shared unit changed",
+			modify: func(r *Resources) {
+				r.HTTPRoutes[0].Spec.Hostnames = []gwapiv1.Hostname{"bar.example.com"}
+			},
+			wantTranslated: []string{"default/gateway-1", "default/gateway-2"},
+		},
+		{
+			name: "gateway deleted",
+			modify: func(r *Resources) {
+				r.Gateways = r.Gateways[1:]
+			},
+			wantTranslated: []string{"default/gateway-1"},
+		},
+	}
+
+	cache := NewTranslationCache()
+	resources := base
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resources = resources.DeepCopy()
+			tc.modify(resources)
+
+			got, translated := translator.TranslateIncrementally(resources.DeepCopy(), cache)
+			keys := maps.Keys(translated.XdsIR)
+			require.ElementsMatch(t, tc.wantTranslated, keys)
+
+			want := translator.Translate(resources.DeepCopy())
+			opts := []cmp.Option{
+				cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+				cmpopts.EquateEmpty(),
+			}
+			require.Empty(t, cmp.Diff(want, got, opts...))
+		})
+	}
+}
+
+func TestTranslateIncrementallyShardFilter(t *testing.T) {
+	owned := map[string]bool{"default/gateway-1": true}
+	translator := &Translator{
+		GatewayControllerName: egv1a1.GatewayControllerName,
+		GatewayClassName:      "envoy-gateway-class",
+		ShardFilter: func(unitKey string) bool {
+			return owned[unitKey]
+		},
+	}
+	resources := &Resources{}
+	mustUnmarshal(t, []byte(incrementalTestResources), resources)
+	cache := NewTranslationCache()
+
+	// Only the owned unit is translated
+	got, translated := translator.TranslateIncrementally(resources.DeepCopy(), cache)
+	require.ElementsMatch(t, []string{"default/gateway-1"}, maps.Keys(got.XdsIR))
+	require.ElementsMatch(t, []string{"default/gateway-1"}, maps.Keys(translated.XdsIR))
+	require.Len(t, got.Gateways, 1)
+	require.Len(t, got.HTTPRoutes, 1)
+	require.Empty(t, got.BackendTrafficPolicies)
+
+	// The cached unit is reused, and the unit taken over is translated
+	owned["default/gateway-2"] = true
+	got, translated = translator.TranslateIncrementally(resources.DeepCopy(), cache)
+	require.ElementsMatch(t, []string{"default/gateway-1", "default/gateway-2"}, maps.Keys(got.XdsIR))
+	require.ElementsMatch(t, []string{"default/gateway-2"}, maps.Keys(translated.XdsIR))
+
+	// The unit handed over is left out
+	delete(owned, "default/gateway-1")
+	got, translated = translator.TranslateIncrementally(resources.DeepCopy(), cache)
+	require.ElementsMatch(t, []string{"default/gateway-2"}, maps.Keys(got.XdsIR))
+	require.Empty(t, translated.XdsIR)
+}
+
+func TestSplitResources(t *testing.T) {
+	translator := &Translator{GatewayClassName: "envoy-gateway-class"}
+	resources := &Resources{}
+	mustUnmarshal(t, []byte(incrementalTestResources), resources)
+	orphan := resources.HTTPRoutes[0].DeepCopy()
+	orphan.Name = "orphan"
+	orphan.Spec.ParentRefs = []gwapiv1.ParentReference{{Name: "not-found"}}
+	resources.HTTPRoutes = append(resources.HTTPRoutes, orphan)
+
+	units := translator.splitResources(resources)
+	require.Len(t, units, 3)
+
+	// The unit of the resources without Gateways comes first
+	require.Equal(t, "", units[0].key)
+	require.Empty(t, units[0].resources.Gateways)
+	require.Equal(t, []*gwapiv1.HTTPRoute{orphan}, units[0].resources.HTTPRoutes)
+
+	require.Equal(t, "default/gateway-1", units[1].key)
+	require.Equal(t, resources.Gateways[:1], units[1].resources.Gateways)
+	require.Equal(t, resources.HTTPRoutes[:1], units[1].resources.HTTPRoutes)
+	require.Empty(t, units[1].resources.BackendTrafficPolicies)
+
+	require.Equal(t, "default/gateway-2", units[2].key)
+	require.Equal(t, resources.Gateways[1:], units[2].resources.Gateways)
+	require.Equal(t, resources.HTTPRoutes[1:2], units[2].resources.HTTPRoutes)
+	require.Equal(t, resources.BackendTrafficPolicies, units[2].resources.BackendTrafficPolicies)
+
+	// The Gateways using a backend targeted by a BackendTLSPolicy share its status
+	resources.HTTPRoutes[1].Spec.Rules[0].BackendRefs[0].Name = "service-1"
+	resources.BackendTLSPolicies = []*gwapiv1a3.BackendTLSPolicy{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "backendtlspolicy"},
+		Spec: gwapiv1a3.BackendTLSPolicySpec{
+			TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{{
+				LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{Kind: KindService, Name: "service-1"},
+			}},
+		},
+	}}
+	units = translator.splitResources(resources)
+	require.Len(t, units, 2)
+	require.Equal(t, "default/gateway-1,default/gateway-2", units[1].key)
+	require.Equal(t, resources.BackendTLSPolicies, units[1].resources.BackendTLSPolicies)
+
+	// All the Gateways belong to the same unit when they are merged
+	translator.MergeGateways = true
+	units = translator.splitResources(resources)
+	require.Len(t, units, 1)
+	require.Equal(t, "envoy-gateway-class", units[0].key)
+}
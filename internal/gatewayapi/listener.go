@@ -39,8 +39,8 @@ func (t *Translator) ProcessListeners(gateways []*GatewayContext, xdsIR XdsIRMap
 	for _, gateway := range gateways {
 		irKey := t.getIRKey(gateway.Gateway)
 
-		if resources.EnvoyProxy != nil {
-			infraIR[irKey].Proxy.Config = resources.EnvoyProxy
+		if gateway.envoyProxy != nil {
+			infraIR[irKey].Proxy.Config = gateway.envoyProxy
 		}
 
 		xdsIR[irKey].AccessLog = processAccessLog(infraIR[irKey].Proxy.Config)
@@ -0,0 +1,169 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils"
+)
+
+// policyIndex indexes the targets of the policies, and the IR translated from the
+// routes, so that the policies are resolved and applied by lookups rather than by
+// walking all the gateways, routes and IR, since users might have thousands of them.
+// It is built once per translation and shared by all the policy kinds.
+type policyIndex struct {
+	// gateways are the Gateways the policies can target.
+	gateways map[types.NamespacedName]*policyGatewayTargetContext
+	// routes are the xRoutes the policies can target.
+	routes map[policyTargetRouteKey]*policyRouteTargetContext
+
+	// httpRoutes, tcpListeners and udpListeners are the IR translated from the
+	// xRoutes, keyed by the prefix of their IR names, see irRoutePrefix.
+	httpRoutes   map[string][]*ir.HTTPRoute
+	tcpListeners map[string][]*ir.TCPListener
+	udpListeners map[string][]*ir.UDPListener
+
+	// backendTLSPolicies are the BackendTLSPolicies by the backend they target,
+	// in the order they are listed in the resources.
+	backendTLSPolicies map[backendTLSTargetKey][]*gwapiv1a3.BackendTLSPolicy
+}
+
+// backendTLSTargetKey identifies the backend targeted by a BackendTLSPolicy.
+type backendTLSTargetKey struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// newPolicyIndex returns the index of the gateways and BackendTLSPolicies.
+// The routes are indexed once translated to IR, by addRoutes.
+func newPolicyIndex(gateways []*GatewayContext, backendTLSPolicies []*gwapiv1a3.BackendTLSPolicy) *policyIndex {
+	index := &policyIndex{
+		gateways:           make(map[types.NamespacedName]*policyGatewayTargetContext, len(gateways)),
+		routes:             map[policyTargetRouteKey]*policyRouteTargetContext{},
+		httpRoutes:         map[string][]*ir.HTTPRoute{},
+		tcpListeners:       map[string][]*ir.TCPListener{},
+		udpListeners:       map[string][]*ir.UDPListener{},
+		backendTLSPolicies: map[backendTLSTargetKey][]*gwapiv1a3.BackendTLSPolicy{},
+	}
+
+	for _, gw := range gateways {
+		index.gateways[utils.NamespacedName(gw)] = &policyGatewayTargetContext{
+			GatewayContext: gw,
+			attached:       sets.New[string](),
+		}
+	}
+
+	for _, policy := range backendTLSPolicies {
+		keys := sets.New[backendTLSTargetKey]()
+		for _, targetRef := range policy.Spec.TargetRefs {
+			key := backendTLSTargetKey{
+				Group:     string(targetRef.Group),
+				Kind:      string(targetRef.Kind),
+				Namespace: policy.Namespace,
+				Name:      string(targetRef.Name),
+			}
+			// A policy targeting several sections of a backend is indexed once.
+			if keys.Has(key) {
+				continue
+			}
+			keys.Insert(key)
+			index.backendTLSPolicies[key] = append(index.backendTLSPolicies[key], policy)
+		}
+	}
+
+	return index
+}
+
+// addRoutes indexes the routes, and the IR translated from them.
+func (i *policyIndex) addRoutes(routes []RouteContext, xdsIR XdsIRMap) {
+	for _, route := range routes {
+		key := policyTargetRouteKey{
+			Kind:      string(GetRouteType(route)),
+			Name:      route.GetName(),
+			Namespace: route.GetNamespace(),
+		}
+		i.routes[key] = &policyRouteTargetContext{
+			RouteContext: route,
+			attached:     sets.New[string](),
+		}
+	}
+
+	for _, x := range xdsIR {
+		for _, http := range x.HTTP {
+			for _, r := range http.Routes {
+				prefix := irRoutePrefixOf(r.Name)
+				i.httpRoutes[prefix] = append(i.httpRoutes[prefix], r)
+			}
+		}
+		for _, tcp := range x.TCP {
+			if tcp.Destination != nil {
+				prefix := irRoutePrefixOf(tcp.Destination.Name)
+				i.tcpListeners[prefix] = append(i.tcpListeners[prefix], tcp)
+			}
+		}
+		for _, udp := range x.UDP {
+			if udp.Destination != nil {
+				prefix := irRoutePrefixOf(udp.Destination.Name)
+				i.udpListeners[prefix] = append(i.udpListeners[prefix], udp)
+			}
+		}
+	}
+}
+
+// irHTTPRoutes returns the IR HTTP routes translated from the route.
+func (i *policyIndex) irHTTPRoutes(route RouteContext) []*ir.HTTPRoute {
+	return i.httpRoutes[irRoutePrefix(route)]
+}
+
+// irTCPListeners returns the IR TCP listeners translated from the route.
+func (i *policyIndex) irTCPListeners(route RouteContext) []*ir.TCPListener {
+	return i.tcpListeners[irRoutePrefix(route)]
+}
+
+// irUDPListeners returns the IR UDP listeners translated from the route.
+func (i *policyIndex) irUDPListeners(route RouteContext) []*ir.UDPListener {
+	return i.udpListeners[irRoutePrefix(route)]
+}
+
+// backendTLSPolicy returns the first BackendTLSPolicy targeting the backend, or nil.
+func (i *policyIndex) backendTLSPolicy(backendRef gwapiv1.BackendObjectReference, backendNamespace string) *gwapiv1a3.BackendTLSPolicy {
+	target := GetTargetBackendReference(backendRef, backendNamespace)
+	key := backendTLSTargetKey{
+		Group:     string(target.Group),
+		Kind:      string(target.Kind),
+		Namespace: string(*target.Namespace),
+		Name:      string(target.Name),
+	}
+	for _, policy := range i.backendTLSPolicies[key] {
+		if backendTLSTargetMatched(*policy, target) {
+			return policy
+		}
+	}
+	return nil
+}
+
+// irRoutePrefixOf returns the prefix of the IR name of a route, as returned by
+// irRoutePrefix for the xRoute it is translated from.
+func irRoutePrefixOf(name string) string {
+	end := 0
+	for n := 0; n < 3; n++ {
+		idx := strings.Index(name[end:], "/")
+		if idx < 0 {
+			return name
+		}
+		end += idx + 1
+	}
+	return name[:end]
+}
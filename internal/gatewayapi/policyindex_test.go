@@ -0,0 +1,212 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+func TestIRRoutePrefixOf(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "httproute/default/foo/rule/0/match/0/www_example_com",
+			expected: "httproute/default/foo/",
+		},
+		{
+			name:     "tcproute/default/foo/rule/-1",
+			expected: "tcproute/default/foo/",
+		},
+		{
+			name:     "httproute/default/foo/",
+			expected: "httproute/default/foo/",
+		},
+		{
+			name:     "default/foo",
+			expected: "default/foo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, irRoutePrefixOf(tc.name))
+		})
+	}
+}
+
+func TestPolicyIndex(t *testing.T) {
+	newHTTPRoute := func(name string) *HTTPRouteContext {
+		return &HTTPRouteContext{
+			HTTPRoute: &gwapiv1.HTTPRoute{
+				TypeMeta:   metav1.TypeMeta{Kind: KindHTTPRoute},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			},
+		}
+	}
+	foo, foobar := newHTTPRoute("foo"), newHTTPRoute("foobar")
+	tcpRoute := &TCPRouteContext{
+		TCPRoute: &gwapiv1a2.TCPRoute{
+			TypeMeta:   metav1.TypeMeta{Kind: KindTCPRoute},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+		},
+	}
+
+	fooRoutes := []*ir.HTTPRoute{
+		{Name: irRouteName(foo, 0, 0)},
+		{Name: irRouteName(foo, 1, -1)},
+	}
+	foobarRoute := &ir.HTTPRoute{Name: irRouteName(foobar, 0, 0)}
+	tcpListener := &ir.TCPListener{
+		Destination: &ir.RouteDestination{Name: irRouteDestinationName(tcpRoute, -1)},
+	}
+	xdsIR := XdsIRMap{
+		"default/gateway-1": {
+			HTTP: []*ir.HTTPListener{{Routes: []*ir.HTTPRoute{fooRoutes[0], foobarRoute}}},
+			TCP:  []*ir.TCPListener{tcpListener},
+		},
+		"default/gateway-2": {
+			HTTP: []*ir.HTTPListener{{Routes: []*ir.HTTPRoute{fooRoutes[1]}}},
+		},
+	}
+
+	index := newPolicyIndex(nil, nil)
+	index.addRoutes([]RouteContext{foo, foobar, tcpRoute}, xdsIR)
+
+	require.ElementsMatch(t, fooRoutes, index.irHTTPRoutes(foo))
+	require.Equal(t, []*ir.HTTPRoute{foobarRoute}, index.irHTTPRoutes(foobar))
+	require.Empty(t, index.irHTTPRoutes(tcpRoute))
+	require.Equal(t, []*ir.TCPListener{tcpListener}, index.irTCPListeners(tcpRoute))
+	require.Empty(t, index.irTCPListeners(foo))
+	require.Empty(t, index.irUDPListeners(tcpRoute))
+
+	route := index.routes[policyTargetRouteKey{Kind: KindHTTPRoute, Namespace: "default", Name: "foo"}]
+	require.NotNil(t, route)
+	require.Equal(t, foo, route.RouteContext)
+	require.Empty(t, route.attached)
+}
+
+func TestPolicyIndexBackendTLSPolicy(t *testing.T) {
+	newPolicy := func(name, target string, sectionName *gwapiv1.SectionName) *gwapiv1a3.BackendTLSPolicy {
+		return &gwapiv1a3.BackendTLSPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: gwapiv1a3.BackendTLSPolicySpec{
+				TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{{
+					LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+						Kind: KindService,
+						Name: gwapiv1.ObjectName(target),
+					},
+					SectionName: sectionName,
+				}},
+			},
+		}
+	}
+	port := newPolicy("port", "foo", ptr.To(gwapiv1.SectionName("8443")))
+	service := newPolicy("service", "foo", nil)
+	other := newPolicy("other", "bar", nil)
+
+	index := newPolicyIndex(nil, []*gwapiv1a3.BackendTLSPolicy{port, service, other})
+
+	testCases := []struct {
+		name       string
+		backendRef gwapiv1.BackendObjectReference
+		namespace  string
+		expected   *gwapiv1a3.BackendTLSPolicy
+	}{
+		{
+			name:       "section name matched",
+			backendRef: gwapiv1.BackendObjectReference{Name: "foo", Port: ptr.To(gwapiv1.PortNumber(8443))},
+			namespace:  "default",
+			expected:   port,
+		},
+		{
+			name:       "section name not matched",
+			backendRef: gwapiv1.BackendObjectReference{Name: "foo", Port: ptr.To(gwapiv1.PortNumber(8080))},
+			namespace:  "default",
+			expected:   service,
+		},
+		{
+			name:       "other service",
+			backendRef: gwapiv1.BackendObjectReference{Name: "bar", Port: ptr.To(gwapiv1.PortNumber(8080))},
+			namespace:  "default",
+			expected:   other,
+		},
+		{
+			name:       "other namespace",
+			backendRef: gwapiv1.BackendObjectReference{Name: "foo", Port: ptr.To(gwapiv1.PortNumber(8443))},
+			namespace:  "other",
+		},
+		{
+			name: "other kind",
+			backendRef: gwapiv1.BackendObjectReference{
+				Kind: ptr.To(gwapiv1.Kind(KindServiceImport)),
+				Name: "foo",
+				Port: ptr.To(gwapiv1.PortNumber(8443)),
+			},
+			namespace: "default",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, index.backendTLSPolicy(tc.backendRef, tc.namespace))
+		})
+	}
+}
+
+func TestTranslateConcurrently(t *testing.T) {
+	input, err := os.ReadFile(filepath.Join("testdata", "backendtrafficpolicy-with-same-prefix-httproutes.in.yaml"))
+	require.NoError(t, err)
+
+	translator := &Translator{
+		GatewayControllerName: egv1a1.GatewayControllerName,
+		GatewayClassName:      "envoy-gateway-class",
+		Namespace:             "envoy-gateway-system",
+	}
+
+	// The policy index is local to each translation, so that the same
+	// translator can translate several resource sets at once.
+	results := make([]*TranslateResult, 4)
+	var wg sync.WaitGroup
+	for i := range results {
+		resources := &Resources{}
+		mustUnmarshal(t, input, resources)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = translator.Translate(resources)
+		}()
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		for _, policy := range result.BackendTrafficPolicies {
+			for i := range policy.Status.Ancestors {
+				for j := range policy.Status.Ancestors[i].Conditions {
+					policy.Status.Ancestors[i].Conditions[j].LastTransitionTime = metav1.Time{}
+				}
+			}
+		}
+	}
+	for _, result := range results[1:] {
+		require.Equal(t, results[0].XdsIR, result.XdsIR)
+		require.Equal(t, results[0].BackendTrafficPolicies, result.BackendTrafficPolicies)
+	}
+}
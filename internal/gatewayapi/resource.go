@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	mcsapi "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
 
@@ -31,99 +32,129 @@ type InfraIRMap map[string]*ir.Infra
 type Resources struct {
 	// This field is only used for marshalling/unmarshalling purposes and is not used by
 	// the translator
-	GatewayClass           *gwapiv1.GatewayClass          `json:"gatewayClass,omitempty" yaml:"gatewayClass,omitempty"`
-	Gateways               []*gwapiv1.Gateway             `json:"gateways,omitempty" yaml:"gateways,omitempty"`
-	HTTPRoutes             []*gwapiv1.HTTPRoute           `json:"httpRoutes,omitempty" yaml:"httpRoutes,omitempty"`
-	GRPCRoutes             []*gwapiv1a2.GRPCRoute         `json:"grpcRoutes,omitempty" yaml:"grpcRoutes,omitempty"`
-	TLSRoutes              []*gwapiv1a2.TLSRoute          `json:"tlsRoutes,omitempty" yaml:"tlsRoutes,omitempty"`
-	TCPRoutes              []*gwapiv1a2.TCPRoute          `json:"tcpRoutes,omitempty" yaml:"tcpRoutes,omitempty"`
-	UDPRoutes              []*gwapiv1a2.UDPRoute          `json:"udpRoutes,omitempty" yaml:"udpRoutes,omitempty"`
-	ReferenceGrants        []*gwapiv1b1.ReferenceGrant    `json:"referenceGrants,omitempty" yaml:"referenceGrants,omitempty"`
-	Namespaces             []*v1.Namespace                `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
-	Services               []*v1.Service                  `json:"services,omitempty" yaml:"services,omitempty"`
-	ServiceImports         []*mcsapi.ServiceImport        `json:"serviceImports,omitempty" yaml:"serviceImports,omitempty"`
-	EndpointSlices         []*discoveryv1.EndpointSlice   `json:"endpointSlices,omitempty" yaml:"endpointSlices,omitempty"`
-	Secrets                []*v1.Secret                   `json:"secrets,omitempty" yaml:"secrets,omitempty"`
-	ConfigMaps             []*v1.ConfigMap                `json:"configMaps,omitempty" yaml:"configMaps,omitempty"`
-	EnvoyProxy             *egv1a1.EnvoyProxy             `json:"envoyProxy,omitempty" yaml:"envoyProxy,omitempty"`
-	ExtensionRefFilters    []unstructured.Unstructured    `json:"extensionRefFilters,omitempty" yaml:"extensionRefFilters,omitempty"`
-	EnvoyPatchPolicies     []*egv1a1.EnvoyPatchPolicy     `json:"envoyPatchPolicies,omitempty" yaml:"envoyPatchPolicies,omitempty"`
-	ClientTrafficPolicies  []*egv1a1.ClientTrafficPolicy  `json:"clientTrafficPolicies,omitempty" yaml:"clientTrafficPolicies,omitempty"`
-	BackendTrafficPolicies []*egv1a1.BackendTrafficPolicy `json:"backendTrafficPolicies,omitempty" yaml:"backendTrafficPolicies,omitempty"`
-	SecurityPolicies       []*egv1a1.SecurityPolicy       `json:"securityPolicies,omitempty" yaml:"securityPolicies,omitempty"`
-	BackendTLSPolicies     []*gwapiv1a2.BackendTLSPolicy  `json:"backendTLSPolicies,omitempty" yaml:"backendTLSPolicies,omitempty"`
-	EnvoyExtensionPolicies []*egv1a1.EnvoyExtensionPolicy `json:"envoyExtensionPolicies,omitempty" yaml:"envoyExtensionPolicies,omitempty"`
+	GatewayClass            *gwapiv1.GatewayClass          `json:"gatewayClass,omitempty" yaml:"gatewayClass,omitempty"`
+	Gateways                []*gwapiv1.Gateway             `json:"gateways,omitempty" yaml:"gateways,omitempty"`
+	HTTPRoutes              []*gwapiv1.HTTPRoute           `json:"httpRoutes,omitempty" yaml:"httpRoutes,omitempty"`
+	GRPCRoutes              []*gwapiv1a2.GRPCRoute         `json:"grpcRoutes,omitempty" yaml:"grpcRoutes,omitempty"`
+	TLSRoutes               []*gwapiv1a2.TLSRoute          `json:"tlsRoutes,omitempty" yaml:"tlsRoutes,omitempty"`
+	TCPRoutes               []*gwapiv1a2.TCPRoute          `json:"tcpRoutes,omitempty" yaml:"tcpRoutes,omitempty"`
+	UDPRoutes               []*gwapiv1a2.UDPRoute          `json:"udpRoutes,omitempty" yaml:"udpRoutes,omitempty"`
+	ReferenceGrants         []*gwapiv1b1.ReferenceGrant    `json:"referenceGrants,omitempty" yaml:"referenceGrants,omitempty"`
+	Namespaces              []*v1.Namespace                `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	Services                []*v1.Service                  `json:"services,omitempty" yaml:"services,omitempty"`
+	ServiceImports          []*mcsapi.ServiceImport        `json:"serviceImports,omitempty" yaml:"serviceImports,omitempty"`
+	EndpointSlices          []*discoveryv1.EndpointSlice   `json:"endpointSlices,omitempty" yaml:"endpointSlices,omitempty"`
+	Secrets                 []*v1.Secret                   `json:"secrets,omitempty" yaml:"secrets,omitempty"`
+	ConfigMaps              []*v1.ConfigMap                `json:"configMaps,omitempty" yaml:"configMaps,omitempty"`
+	EnvoyProxy              *egv1a1.EnvoyProxy             `json:"envoyProxy,omitempty" yaml:"envoyProxy,omitempty"`
+	EnvoyProxiesForGateways []*egv1a1.EnvoyProxy           `json:"envoyProxiesForGateways,omitempty" yaml:"envoyProxiesForGateways,omitempty"`
+	ExtensionRefFilters     []unstructured.Unstructured    `json:"extensionRefFilters,omitempty" yaml:"extensionRefFilters,omitempty"`
+	EnvoyPatchPolicies      []*egv1a1.EnvoyPatchPolicy     `json:"envoyPatchPolicies,omitempty" yaml:"envoyPatchPolicies,omitempty"`
+	ClientTrafficPolicies   []*egv1a1.ClientTrafficPolicy  `json:"clientTrafficPolicies,omitempty" yaml:"clientTrafficPolicies,omitempty"`
+	BackendTrafficPolicies  []*egv1a1.BackendTrafficPolicy `json:"backendTrafficPolicies,omitempty" yaml:"backendTrafficPolicies,omitempty"`
+	SecurityPolicies        []*egv1a1.SecurityPolicy       `json:"securityPolicies,omitempty" yaml:"securityPolicies,omitempty"`
+	BackendTLSPolicies      []*gwapiv1a3.BackendTLSPolicy  `json:"backendTLSPolicies,omitempty" yaml:"backendTLSPolicies,omitempty"`
+	EnvoyExtensionPolicies  []*egv1a1.EnvoyExtensionPolicy `json:"envoyExtensionPolicies,omitempty" yaml:"envoyExtensionPolicies,omitempty"`
+	ExtensionServerPolicies []unstructured.Unstructured    `json:"extensionServerPolicies,omitempty" yaml:"extensionServerPolicies,omitempty"`
+
+	// Dependencies records the resources looked up with the getters below while
+	// the resources of a translation unit are translated. It is only set by
+	// TranslateIncrementally.
+	Dependencies *Dependencies `json:"-" yaml:"-"`
 }
 
 func NewResources() *Resources {
 	return &Resources{
-		Gateways:               []*gwapiv1.Gateway{},
-		HTTPRoutes:             []*gwapiv1.HTTPRoute{},
-		GRPCRoutes:             []*gwapiv1a2.GRPCRoute{},
-		TLSRoutes:              []*gwapiv1a2.TLSRoute{},
-		Services:               []*v1.Service{},
-		EndpointSlices:         []*discoveryv1.EndpointSlice{},
-		Secrets:                []*v1.Secret{},
-		ConfigMaps:             []*v1.ConfigMap{},
-		ReferenceGrants:        []*gwapiv1b1.ReferenceGrant{},
-		Namespaces:             []*v1.Namespace{},
-		ExtensionRefFilters:    []unstructured.Unstructured{},
-		EnvoyPatchPolicies:     []*egv1a1.EnvoyPatchPolicy{},
-		ClientTrafficPolicies:  []*egv1a1.ClientTrafficPolicy{},
-		BackendTrafficPolicies: []*egv1a1.BackendTrafficPolicy{},
-		SecurityPolicies:       []*egv1a1.SecurityPolicy{},
-		BackendTLSPolicies:     []*gwapiv1a2.BackendTLSPolicy{},
-		EnvoyExtensionPolicies: []*egv1a1.EnvoyExtensionPolicy{},
+		Gateways:                []*gwapiv1.Gateway{},
+		HTTPRoutes:              []*gwapiv1.HTTPRoute{},
+		GRPCRoutes:              []*gwapiv1a2.GRPCRoute{},
+		TLSRoutes:               []*gwapiv1a2.TLSRoute{},
+		Services:                []*v1.Service{},
+		EndpointSlices:          []*discoveryv1.EndpointSlice{},
+		Secrets:                 []*v1.Secret{},
+		ConfigMaps:              []*v1.ConfigMap{},
+		ReferenceGrants:         []*gwapiv1b1.ReferenceGrant{},
+		Namespaces:              []*v1.Namespace{},
+		ExtensionRefFilters:     []unstructured.Unstructured{},
+		EnvoyPatchPolicies:      []*egv1a1.EnvoyPatchPolicy{},
+		ClientTrafficPolicies:   []*egv1a1.ClientTrafficPolicy{},
+		BackendTrafficPolicies:  []*egv1a1.BackendTrafficPolicy{},
+		SecurityPolicies:        []*egv1a1.SecurityPolicy{},
+		BackendTLSPolicies:      []*gwapiv1a3.BackendTLSPolicy{},
+		EnvoyExtensionPolicies:  []*egv1a1.EnvoyExtensionPolicy{},
+		ExtensionServerPolicies: []unstructured.Unstructured{},
 	}
 }
 
 func (r *Resources) GetNamespace(name string) *v1.Namespace {
 	for _, ns := range r.Namespaces {
 		if ns.Name == name {
+			r.Dependencies.recordNamespace(name, ns)
 			return ns
 		}
 	}
 
+	r.Dependencies.recordNamespace(name, nil)
 	return nil
 }
 
 func (r *Resources) GetService(namespace, name string) *v1.Service {
 	for _, svc := range r.Services {
 		if svc.Namespace == namespace && svc.Name == name {
+			r.Dependencies.recordService(namespace, name, svc)
 			return svc
 		}
 	}
 
+	r.Dependencies.recordService(namespace, name, nil)
 	return nil
 }
 
 func (r *Resources) GetServiceImport(namespace, name string) *mcsapi.ServiceImport {
 	for _, svcImp := range r.ServiceImports {
 		if svcImp.Namespace == namespace && svcImp.Name == name {
+			r.Dependencies.recordServiceImport(namespace, name, svcImp)
 			return svcImp
 		}
 	}
 
+	r.Dependencies.recordServiceImport(namespace, name, nil)
 	return nil
 }
 
 func (r *Resources) GetSecret(namespace, name string) *v1.Secret {
 	for _, secret := range r.Secrets {
 		if secret.Namespace == namespace && secret.Name == name {
+			r.Dependencies.recordSecret(namespace, name, secret)
 			return secret
 		}
 	}
 
+	r.Dependencies.recordSecret(namespace, name, nil)
 	return nil
 }
 
 func (r *Resources) GetConfigMap(namespace, name string) *v1.ConfigMap {
 	for _, configMap := range r.ConfigMaps {
 		if configMap.Namespace == namespace && configMap.Name == name {
+			r.Dependencies.recordConfigMap(namespace, name, configMap)
 			return configMap
 		}
 	}
 
+	r.Dependencies.recordConfigMap(namespace, name, nil)
+	return nil
+}
+
+func (r *Resources) GetEnvoyProxy(namespace, name string) *egv1a1.EnvoyProxy {
+	for _, ep := range r.EnvoyProxiesForGateways {
+		if ep.Namespace == namespace && ep.Name == name {
+			r.Dependencies.recordEnvoyProxy(namespace, name, ep)
+			return ep
+		}
+	}
+
+	r.Dependencies.recordEnvoyProxy(namespace, name, nil)
 	return nil
 }
 
@@ -142,6 +173,7 @@ func (r *Resources) GetEndpointSlicesForBackend(svcNamespace, svcName string, ba
 			endpointSlices = append(endpointSlices, endpointSlice)
 		}
 	}
+	r.Dependencies.recordEndpointSlices(svcNamespace, svcName, backendKind, endpointSlices)
 	return endpointSlices
 }
 
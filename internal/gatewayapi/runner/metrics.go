@@ -0,0 +1,28 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package runner
+
+import "github.com/envoyproxy/gateway/internal/metrics"
+
+var (
+	gatewayAPITranslationDurationSeconds = metrics.NewHistogram("gatewayapi_translation_duration_seconds", "How long in seconds a translation of Gateway API resources into IRs takes.", []float64{0.001, 0.01, 0.1, 1, 5, 10})
+
+	gatewayAPITranslationTotal = metrics.NewCounter("gatewayapi_translation_total", "Total number of translations of Gateway API resources into IRs.")
+
+	gatewayAPITranslationErrorsTotal = metrics.NewCounter("gatewayapi_translation_errors_total", "Total number of IRs failing validation after a translation of Gateway API resources.")
+
+	xdsIRListeners = metrics.NewGauge("xds_ir_listeners", "Current number of listeners in an xDS IR.")
+
+	xdsIRRoutes = metrics.NewGauge("xds_ir_routes", "Current number of HTTP routes in an xDS IR.")
+
+	infraIRListeners = metrics.NewGauge("infra_ir_listeners", "Current number of proxy listeners in an infra IR.")
+
+	gatewayClassLabel = metrics.NewLabel("gateway_class")
+
+	irKeyLabel = metrics.NewLabel("ir_key")
+
+	irTypeLabel = metrics.NewLabel("ir_type")
+)
@@ -8,7 +8,12 @@ package runner
 import (
 	"context"
 	"reflect"
+	"sort"
+	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -18,24 +23,48 @@ import (
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	extension "github.com/envoyproxy/gateway/internal/extension/types"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/sharding"
+	"github.com/envoyproxy/gateway/internal/supervisor"
+	"github.com/envoyproxy/gateway/internal/tracing"
 	"github.com/envoyproxy/gateway/internal/utils"
+	"github.com/envoyproxy/gateway/internal/wasm"
 )
 
 type Config struct {
 	config.Server
 	ProviderResources *message.ProviderResources
 	XdsIR             *message.XdsIR
-	InfraIR           *message.InfraIR
-	ExtensionManager  extension.Manager
+	// XdsIRKeys receives the keys of the xds IRs published by each translation,
+	// if set.
+	XdsIRKeys        *message.XdsIRKeys
+	InfraIR          *message.InfraIR
+	ExtensionManager extension.Manager
+	WasmCache        wasm.Cache
+	// Shard is set when the Gateways are sharded across the replicas of Envoy
+	// Gateway, in which case the runner only translates the Gateways it owns.
+	Shard *sharding.Shard
 }
 
 type Runner struct {
 	Config
+	// mu serializes the translations triggered by the provider resources and
+	// the changes of the shard.
+	mu sync.Mutex
+	// translationCaches holds the translation cache of each GatewayClass.
+	translationCaches map[string]*gatewayapi.TranslationCache
+	// resources holds a copy of the last provider resources, which are
+	// translated again when the members of the shard change, or when the wasm
+	// modules being pulled are pulled. It's only kept when the Gateways are
+	// sharded or wasm modules are being pulled.
+	resources *gatewayapi.ControllerResources
 }
 
 func New(cfg *Config) *Runner {
-	return &Runner{Config: *cfg}
+	r := &Runner{Config: *cfg}
+	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
+	return r
 }
 
 func (r *Runner) Name() string {
@@ -44,181 +73,406 @@ func (r *Runner) Name() string {
 
 // Start starts the gateway-api translator runner
 func (r *Runner) Start(ctx context.Context) (err error) {
-	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
-	go r.subscribeAndTranslate(ctx)
+	supervisor.Go(ctx, func() { r.subscribeAndTranslate(ctx) })
+	if r.Shard != nil {
+		supervisor.Go(ctx, func() { r.translateOnChange(ctx, "shard-change", r.Shard.Changed()) })
+	}
+	if r.WasmCache != nil {
+		supervisor.Go(ctx, func() { r.translateOnChange(ctx, "wasm-pulled", r.WasmCache.Pulled()) })
+	}
 	r.Logger.Info("started")
 	return
 }
 
 func (r *Runner) subscribeAndTranslate(ctx context.Context) {
-	message.HandleSubscription(message.Metadata{Runner: string(v1alpha1.LogComponentGatewayAPIRunner), Message: "provider-resources"}, r.ProviderResources.GatewayAPIResources.Subscribe(ctx),
+	meta := message.Metadata{Runner: string(v1alpha1.LogComponentGatewayAPIRunner), Message: "provider-resources"}
+	subscription := r.ProviderResources.GatewayAPIResources.Subscribe(ctx)
+	// Coalesce the bursts of updates of the provider resources, so that they're
+	// translated once.
+	if coalescing := r.EnvoyGateway.UpdateCoalescing; coalescing != nil {
+		subscription = message.CoalesceSubscription(meta, subscription, coalescing.GetDebounce(), coalescing.GetMaxDelay())
+	}
+	message.HandleSubscription(meta, subscription,
 		func(update message.Update[string, *gatewayapi.ControllerResources], errChan chan error) {
 			r.Logger.Info("received an update")
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			// Continue the trace of the provider update
+			traceCtx := r.ProviderResources.GatewayAPIResourcesTraces.Extract(ctx, update.Key)
 			val := update.Value
 			// There is only 1 key which is the controller name
 			// so when a delete is triggered, delete all IR keys
 			if update.Delete || val == nil {
 				r.deleteAllIRKeys()
 				r.deleteAllStatusKeys()
+				r.publishXdsIRKeys()
+				r.translationCaches = nil
+				r.resources = nil
 				return
 			}
 
-			// IR keys for watchable
-			var curIRKeys, newIRKeys []string
-
-			// Get current IR keys
-			for key := range r.InfraIR.LoadAll() {
-				curIRKeys = append(curIRKeys, key)
+			// The translation modifies the resources, so they are copied to be
+			// translated again when the shard changes or wasm modules are pulled.
+			r.resources = nil
+			if r.Shard != nil || (r.WasmCache != nil && usesWasmImages(val)) {
+				r.resources = val.DeepCopy()
 			}
+			pending := r.translateAndPublish(traceCtx, val, errChan)
+			r.releaseResources(pending)
+		},
+	)
+	r.Logger.Info("shutting down")
+}
 
-			// Get all status keys from watchable and save them in this StatusesToDelete structure.
-			// Iterating through the controller resources, any valid keys will be removed from statusesToDelete.
-			// Remaining keys will be deleted from watchable before we exit this function.
-			statusesToDelete := r.getAllStatuses()
-
-			for _, resources := range *val {
-				// Translate and publish IRs.
-				t := &gatewayapi.Translator{
-					GatewayControllerName:   r.Server.EnvoyGateway.Gateway.ControllerName,
-					GatewayClassName:        v1.ObjectName(resources.GatewayClass.Name),
-					GlobalRateLimitEnabled:  r.EnvoyGateway.RateLimit != nil,
-					EnvoyPatchPolicyEnabled: r.EnvoyGateway.ExtensionAPIs != nil && r.EnvoyGateway.ExtensionAPIs.EnableEnvoyPatchPolicy,
-					Namespace:               r.Namespace,
-					MergeGateways:           gatewayapi.IsMergeGatewaysEnabled(resources),
-				}
-
-				// If an extension is loaded, pass its supported groups/kinds to the translator
-				if r.EnvoyGateway.ExtensionManager != nil {
-					var extGKs []schema.GroupKind
-					for _, gvk := range r.EnvoyGateway.ExtensionManager.Resources {
-						extGKs = append(extGKs, schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind})
-					}
-					t.ExtensionGroupKinds = extGKs
-				}
-				// Translate to IR
-				result := t.Translate(resources)
-
-				// Publish the IRs.
-				// Also validate the ir before sending it.
-				for key, val := range result.InfraIR {
-					r.Logger.WithValues("infra-ir", key).Info(val.YAMLString())
-					if err := val.Validate(); err != nil {
-						r.Logger.Error(err, "unable to validate infra ir, skipped sending it")
-						errChan <- err
-					} else {
-						r.InfraIR.Store(key, val)
-						newIRKeys = append(newIRKeys, key)
-					}
-				}
+// translateOnChange translates the last provider resources again on each value
+// received from changed, e.g. when the members of the shard change, to take over
+// the Gateways now owned by the replica and hand over the others, or when wasm
+// modules are pulled, to use them.
+func (r *Runner) translateOnChange(ctx context.Context, reason string, changed <-chan struct{}) {
+	errChan := make(chan error, 10)
+	go func() {
+		for err := range errChan {
+			r.Logger.Error(err, "observed an error", "message", reason)
+		}
+	}()
+	defer close(errChan)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-changed:
+		}
+
+		r.mu.Lock()
+		if r.resources != nil {
+			r.Logger.Info("translating the resources again", "reason", reason)
+			pending := r.translateAndPublish(ctx, r.resources.DeepCopy(), errChan)
+			r.releaseResources(pending)
+		}
+		r.mu.Unlock()
+	}
+}
 
-				for key, val := range result.XdsIR {
-					r.Logger.WithValues("xds-ir", key).Info(val.YAMLString())
-					if err := val.Validate(); err != nil {
-						r.Logger.Error(err, "unable to validate xds ir, skipped sending it")
-						errChan <- err
-					} else {
-						r.XdsIR.Store(key, val)
-					}
-				}
+// releaseResources drops the copy of the last provider resources once it's no
+// longer needed, i.e. when the Gateways aren't sharded and no wasm module is
+// being pulled, either for a policy pending on it or to refresh it.
+func (r *Runner) releaseResources(wasmPending bool) {
+	if r.Shard != nil || wasmPending || (r.WasmCache != nil && r.WasmCache.Pending()) {
+		return
+	}
+	r.resources = nil
+}
 
-				// Update Status
-				for _, gateway := range result.Gateways {
-					gateway := gateway
-					key := utils.NamespacedName(gateway)
-					r.ProviderResources.GatewayStatuses.Store(key, &gateway.Status)
-					delete(statusesToDelete.GatewayStatusKeys, key)
-				}
-				for _, httpRoute := range result.HTTPRoutes {
-					httpRoute := httpRoute
-					key := utils.NamespacedName(httpRoute)
-					r.ProviderResources.HTTPRouteStatuses.Store(key, &httpRoute.Status)
-					delete(statusesToDelete.HTTPRouteStatusKeys, key)
-				}
-				for _, grpcRoute := range result.GRPCRoutes {
-					grpcRoute := grpcRoute
-					key := utils.NamespacedName(grpcRoute)
-					r.ProviderResources.GRPCRouteStatuses.Store(key, &grpcRoute.Status)
-					delete(statusesToDelete.GRPCRouteStatusKeys, key)
-				}
-				for _, tlsRoute := range result.TLSRoutes {
-					tlsRoute := tlsRoute
-					key := utils.NamespacedName(tlsRoute)
-					r.ProviderResources.TLSRouteStatuses.Store(key, &tlsRoute.Status)
-					delete(statusesToDelete.TLSRouteStatusKeys, key)
-				}
-				for _, tcpRoute := range result.TCPRoutes {
-					tcpRoute := tcpRoute
-					key := utils.NamespacedName(tcpRoute)
-					r.ProviderResources.TCPRouteStatuses.Store(key, &tcpRoute.Status)
-					delete(statusesToDelete.TCPRouteStatusKeys, key)
-				}
-				for _, udpRoute := range result.UDPRoutes {
-					udpRoute := udpRoute
-					key := utils.NamespacedName(udpRoute)
-					r.ProviderResources.UDPRouteStatuses.Store(key, &udpRoute.Status)
-					delete(statusesToDelete.UDPRouteStatusKeys, key)
+// usesWasmImages returns whether an EnvoyExtensionPolicy of the resources uses
+// the wasm module of an OCI image, which may have to be pulled.
+func usesWasmImages(val *gatewayapi.ControllerResources) bool {
+	for _, resources := range *val {
+		for _, policy := range resources.EnvoyExtensionPolicies {
+			for _, w := range policy.Spec.WASM {
+				if w.Code.Type == v1alpha1.ImageWasmCodeSourceType {
+					return true
 				}
+			}
+		}
+	}
+	return false
+}
 
-				// Skip updating status for policies with empty status
-				// They may have been skipped in this translation because
-				// their target is not found (not relevant)
-
-				for _, backendTLSPolicy := range result.BackendTLSPolicies {
-					backendTLSPolicy := backendTLSPolicy
-					key := utils.NamespacedName(backendTLSPolicy)
-					if !(reflect.ValueOf(backendTLSPolicy.Status).IsZero()) {
-						r.ProviderResources.BackendTLSPolicyStatuses.Store(key, &backendTLSPolicy.Status)
-					}
-					delete(statusesToDelete.BackendTLSPolicyStatusKeys, key)
+// wasmPullsPending returns whether an EnvoyExtensionPolicy is pending on the
+// pull of its wasm modules.
+func wasmPullsPending(policies []*v1alpha1.EnvoyExtensionPolicy) bool {
+	for _, policy := range policies {
+		for _, ancestor := range policy.Status.Ancestors {
+			for _, cond := range ancestor.Conditions {
+				if cond.Reason == string(v1alpha1.PolicyReasonPending) {
+					return true
 				}
+			}
+		}
+	}
+	return false
+}
 
-				for _, clientTrafficPolicy := range result.ClientTrafficPolicies {
-					clientTrafficPolicy := clientTrafficPolicy
-					key := utils.NamespacedName(clientTrafficPolicy)
-					if !(reflect.ValueOf(clientTrafficPolicy.Status).IsZero()) {
-						r.ProviderResources.ClientTrafficPolicyStatuses.Store(key, &clientTrafficPolicy.Status)
-					}
-					delete(statusesToDelete.ClientTrafficPolicyStatusKeys, key)
-				}
-				for _, backendTrafficPolicy := range result.BackendTrafficPolicies {
-					backendTrafficPolicy := backendTrafficPolicy
-					key := utils.NamespacedName(backendTrafficPolicy)
-					if !(reflect.ValueOf(backendTrafficPolicy.Status).IsZero()) {
-						r.ProviderResources.BackendTrafficPolicyStatuses.Store(key, &backendTrafficPolicy.Status)
-					}
-					delete(statusesToDelete.BackendTrafficPolicyStatusKeys, key)
-				}
-				for _, securityPolicy := range result.SecurityPolicies {
-					securityPolicy := securityPolicy
-					key := utils.NamespacedName(securityPolicy)
-					if !(reflect.ValueOf(securityPolicy.Status).IsZero()) {
-						r.ProviderResources.SecurityPolicyStatuses.Store(key, &securityPolicy.Status)
-					}
-					delete(statusesToDelete.SecurityPolicyStatusKeys, key)
-				}
-				for _, envoyExtensionPolicy := range result.EnvoyExtensionPolicies {
-					envoyExtensionPolicy := envoyExtensionPolicy
-					key := utils.NamespacedName(envoyExtensionPolicy)
-					if !(reflect.ValueOf(envoyExtensionPolicy.Status).IsZero()) {
-						r.ProviderResources.EnvoyExtensionPolicyStatuses.Store(key, &envoyExtensionPolicy.Status)
-					}
-					delete(statusesToDelete.EnvoyExtensionPolicyStatusKeys, key)
+// translateAndPublish translates the provider resources, and publishes the IRs
+// and statuses which changed since the last translation. The translation of each
+// GatewayClass is traced as a child span of ctx. It returns whether a policy is
+// pending on the pull of its wasm modules.
+func (r *Runner) translateAndPublish(ctx context.Context, val *gatewayapi.ControllerResources, errChan chan error) (wasmPending bool) {
+	// IR keys for watchable
+	var curIRKeys, newIRKeys []string
+	// IR keys of the Gateways handed over to the other replicas
+	var handedOverIRKeys []string
+
+	// Get current IR keys
+	for key := range r.InfraIR.LoadAll() {
+		curIRKeys = append(curIRKeys, key)
+	}
+	curIRKeySet := sets.New(curIRKeys...)
+
+	// Get all status keys from watchable and save them in this StatusesToDelete structure.
+	// Iterating through the controller resources, any valid keys will be removed from statusesToDelete.
+	// Remaining keys will be deleted from watchable before we exit this function.
+	statusesToDelete := r.getAllStatuses()
+
+	translationCaches := make(map[string]*gatewayapi.TranslationCache, len(*val))
+	for _, resources := range *val {
+		// Translate and publish IRs.
+		t := &gatewayapi.Translator{
+			GatewayControllerName:   r.Server.EnvoyGateway.Gateway.ControllerName,
+			GatewayClassName:        v1.ObjectName(resources.GatewayClass.Name),
+			GlobalRateLimitEnabled:  r.EnvoyGateway.RateLimit != nil,
+			EnvoyPatchPolicyEnabled: r.EnvoyGateway.ExtensionAPIs != nil && r.EnvoyGateway.ExtensionAPIs.EnableEnvoyPatchPolicy,
+			Namespace:               r.Namespace,
+			MergeGateways:           gatewayapi.IsMergeGatewaysEnabled(resources),
+			WasmCache:               r.WasmCache,
+		}
+
+		// If an extension is loaded, pass its supported groups/kinds to the translator
+		if r.EnvoyGateway.ExtensionManager != nil {
+			var extGKs []schema.GroupKind
+			for _, gvk := range r.EnvoyGateway.ExtensionManager.Resources {
+				extGKs = append(extGKs, schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind})
+			}
+			t.ExtensionGroupKinds = extGKs
+		}
+		if r.Shard != nil {
+			t.ShardFilter = r.shardFilter(resources.GatewayClass.Name)
+		}
+		// Translate to IR, only translating again the Gateways affected by the
+		// changes since the last translation of the GatewayClass.
+		cache, ok := r.translationCaches[resources.GatewayClass.Name]
+		if !ok {
+			cache = gatewayapi.NewTranslationCache()
+		}
+		translationCaches[resources.GatewayClass.Name] = cache
+		gatewayClassLabelValue := gatewayClassLabel.Value(resources.GatewayClass.Name)
+		translateCtx, span := tracing.Tracer().Start(ctx, "gatewayapi.Translator.Translate",
+			trace.WithAttributes(attribute.String("gateway_class", resources.GatewayClass.Name)))
+		startTranslationTime := time.Now()
+		result, translated := t.TranslateIncrementally(resources, cache)
+		gatewayAPITranslationTotal.With(gatewayClassLabelValue).Increment()
+		gatewayAPITranslationDurationSeconds.With(gatewayClassLabelValue).Record(time.Since(startTranslationTime).Seconds())
+		span.SetAttributes(attribute.Int("ir_keys", len(result.XdsIR)), attribute.Int("translated_ir_keys", len(translated.XdsIR)))
+		span.End()
+		r.Logger.Info("translated the resources", "gateway-class", resources.GatewayClass.Name,
+			"ir-keys", len(result.XdsIR), "translated-ir-keys", len(translated.XdsIR))
+
+		// The IRs and statuses of the Gateways which weren't translated again are
+		// unchanged, and are kept as published.
+		for key := range result.InfraIR {
+			if _, ok := translated.InfraIR[key]; !ok && curIRKeySet.Has(key) {
+				newIRKeys = append(newIRKeys, key)
+			}
+		}
+		statusesToDelete.keep(result)
+		if wasmPullsPending(result.EnvoyExtensionPolicies) {
+			wasmPending = true
+		}
+
+		// The Gateways handed over to the other replicas are served by them, but
+		// their infra IR is kept so that their infrastructure isn't deleted while
+		// the owning replica takes it over.
+		if r.Shard != nil {
+			for _, key := range gatewayIRKeys(t, resources) {
+				if _, ok := result.InfraIR[key]; !ok && curIRKeySet.Has(key) {
+					newIRKeys = append(newIRKeys, key)
+					handedOverIRKeys = append(handedOverIRKeys, key)
 				}
 			}
-
-			// Delete IR keys
-			// There is a 1:1 mapping between infra and xds IR keys
-			delKeys := getIRKeysToDelete(curIRKeys, newIRKeys)
-			for _, key := range delKeys {
-				r.InfraIR.Delete(key)
-				r.XdsIR.Delete(key)
+		}
+
+		// Publish the IRs.
+		// Also validate the ir before sending it.
+		for key, val := range translated.InfraIR {
+			r.Logger.WithValues("infra-ir", key).Info(val.YAMLString())
+			if err := val.Validate(); err != nil {
+				r.Logger.Error(err, "unable to validate infra ir, skipped sending it")
+				gatewayAPITranslationErrorsTotal.With(gatewayClassLabelValue, irTypeLabel.Value("infra")).Increment()
+				errChan <- err
+			} else {
+				r.InfraIR.Store(key, val)
+				recordInfraIRSize(key, val)
+				newIRKeys = append(newIRKeys, key)
+			}
+		}
+
+		for key, val := range translated.XdsIR {
+			r.Logger.WithValues("xds-ir", key).Info(val.YAMLString())
+			if err := val.Validate(); err != nil {
+				r.Logger.Error(err, "unable to validate xds ir, skipped sending it")
+				gatewayAPITranslationErrorsTotal.With(gatewayClassLabelValue, irTypeLabel.Value("xds")).Increment()
+				errChan <- err
+			} else {
+				r.XdsIR.Traces.Inject(translateCtx, key)
+				r.XdsIR.Store(key, val)
+				recordXdsIRSize(key, val)
+			}
+		}
+
+		// Update Status
+		for _, gateway := range translated.Gateways {
+			gateway := gateway
+			key := utils.NamespacedName(gateway)
+			r.ProviderResources.GatewayStatuses.Store(key, &gateway.Status)
+			delete(statusesToDelete.GatewayStatusKeys, key)
+		}
+		for _, httpRoute := range translated.HTTPRoutes {
+			httpRoute := httpRoute
+			key := utils.NamespacedName(httpRoute)
+			r.ProviderResources.HTTPRouteStatuses.Store(key, &httpRoute.Status)
+			delete(statusesToDelete.HTTPRouteStatusKeys, key)
+		}
+		for _, grpcRoute := range translated.GRPCRoutes {
+			grpcRoute := grpcRoute
+			key := utils.NamespacedName(grpcRoute)
+			r.ProviderResources.GRPCRouteStatuses.Store(key, &grpcRoute.Status)
+			delete(statusesToDelete.GRPCRouteStatusKeys, key)
+		}
+		for _, tlsRoute := range translated.TLSRoutes {
+			tlsRoute := tlsRoute
+			key := utils.NamespacedName(tlsRoute)
+			r.ProviderResources.TLSRouteStatuses.Store(key, &tlsRoute.Status)
+			delete(statusesToDelete.TLSRouteStatusKeys, key)
+		}
+		for _, tcpRoute := range translated.TCPRoutes {
+			tcpRoute := tcpRoute
+			key := utils.NamespacedName(tcpRoute)
+			r.ProviderResources.TCPRouteStatuses.Store(key, &tcpRoute.Status)
+			delete(statusesToDelete.TCPRouteStatusKeys, key)
+		}
+		for _, udpRoute := range translated.UDPRoutes {
+			udpRoute := udpRoute
+			key := utils.NamespacedName(udpRoute)
+			r.ProviderResources.UDPRouteStatuses.Store(key, &udpRoute.Status)
+			delete(statusesToDelete.UDPRouteStatusKeys, key)
+		}
+
+		// Skip updating status for policies with empty status
+		// They may have been skipped in this translation because
+		// their target is not found (not relevant)
+
+		for _, backendTLSPolicy := range translated.BackendTLSPolicies {
+			backendTLSPolicy := backendTLSPolicy
+			key := utils.NamespacedName(backendTLSPolicy)
+			if !(reflect.ValueOf(backendTLSPolicy.Status).IsZero()) {
+				r.ProviderResources.BackendTLSPolicyStatuses.Store(key, &backendTLSPolicy.Status)
+			}
+			delete(statusesToDelete.BackendTLSPolicyStatusKeys, key)
+		}
+
+		for _, clientTrafficPolicy := range translated.ClientTrafficPolicies {
+			clientTrafficPolicy := clientTrafficPolicy
+			key := utils.NamespacedName(clientTrafficPolicy)
+			if !(reflect.ValueOf(clientTrafficPolicy.Status).IsZero()) {
+				r.ProviderResources.ClientTrafficPolicyStatuses.Store(key, &clientTrafficPolicy.Status)
+			}
+			delete(statusesToDelete.ClientTrafficPolicyStatusKeys, key)
+		}
+		for _, backendTrafficPolicy := range translated.BackendTrafficPolicies {
+			backendTrafficPolicy := backendTrafficPolicy
+			key := utils.NamespacedName(backendTrafficPolicy)
+			if !(reflect.ValueOf(backendTrafficPolicy.Status).IsZero()) {
+				r.ProviderResources.BackendTrafficPolicyStatuses.Store(key, &backendTrafficPolicy.Status)
 			}
+			delete(statusesToDelete.BackendTrafficPolicyStatusKeys, key)
+		}
+		for _, securityPolicy := range translated.SecurityPolicies {
+			securityPolicy := securityPolicy
+			key := utils.NamespacedName(securityPolicy)
+			if !(reflect.ValueOf(securityPolicy.Status).IsZero()) {
+				r.ProviderResources.SecurityPolicyStatuses.Store(key, &securityPolicy.Status)
+			}
+			delete(statusesToDelete.SecurityPolicyStatusKeys, key)
+		}
+		for _, envoyExtensionPolicy := range translated.EnvoyExtensionPolicies {
+			envoyExtensionPolicy := envoyExtensionPolicy
+			key := utils.NamespacedName(envoyExtensionPolicy)
+			if !(reflect.ValueOf(envoyExtensionPolicy.Status).IsZero()) {
+				r.ProviderResources.EnvoyExtensionPolicyStatuses.Store(key, &envoyExtensionPolicy.Status)
+			}
+			delete(statusesToDelete.EnvoyExtensionPolicyStatusKeys, key)
+		}
+		for _, extServerPolicy := range translated.ExtensionServerPolicies {
+			extServerPolicy := extServerPolicy
+			key := message.NamespacedNameAndGVK{
+				NamespacedName:   utils.NamespacedName(&extServerPolicy),
+				GroupVersionKind: extServerPolicy.GroupVersionKind(),
+			}
+			policyStatus := gatewayapi.ExtServerPolicyStatusAsPolicyStatus(&extServerPolicy)
+			if !(reflect.ValueOf(policyStatus).IsZero()) {
+				r.ProviderResources.ExtensionPolicyStatuses.Store(key, &policyStatus)
+			}
+			delete(statusesToDelete.ExtensionPolicyStatusKeys, key)
+		}
+	}
 
-			// Delete status keys
-			r.deleteStatusKeys(statusesToDelete)
-		},
-	)
-	r.Logger.Info("shutting down")
+	r.translationCaches = translationCaches
+
+	// Delete IR keys
+	// There is a 1:1 mapping between infra and xds IR keys
+	delKeys := getIRKeysToDelete(curIRKeys, newIRKeys)
+	for _, key := range delKeys {
+		r.InfraIR.Delete(key)
+		r.XdsIR.Delete(key)
+		resetIRSizes(key)
+	}
+	for _, key := range handedOverIRKeys {
+		r.XdsIR.Delete(key)
+		resetIRSizes(key)
+	}
+
+	// Delete status keys
+	r.deleteStatusKeys(statusesToDelete)
+
+	r.publishXdsIRKeys()
+	return wasmPending
+}
+
+// publishXdsIRKeys publishes the keys of the published xds IRs, once all the
+// provider resources are translated.
+func (r *Runner) publishXdsIRKeys() {
+	if r.XdsIRKeys == nil {
+		return
+	}
+	keys := make(message.IRKeys, 0, r.XdsIR.Len())
+	for key := range r.XdsIR.LoadAll() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	r.XdsIRKeys.Store(r.Server.EnvoyGateway.Gateway.ControllerName, keys)
+}
+
+// shardFilter returns the ShardFilter of the translator of the GatewayClass,
+// which owns either all the Gateways of the GatewayClass or the translation
+// units of the Gateways, depending on the sharding key.
+func (r *Runner) shardFilter(gatewayClass string) func(string) bool {
+	if s := r.EnvoyGateway.GetSharding(); s != nil && s.GetShardBy() == v1alpha1.ShardingKeyTypeGatewayClass {
+		owned := r.Shard.Owns(sharding.Key(gatewayClass))
+		return func(string) bool {
+			return owned
+		}
+	}
+	return func(unitKey string) bool {
+		return r.Shard.Owns(sharding.Key(gatewayClass, unitKey))
+	}
+}
+
+// gatewayIRKeys returns the IR keys of the Gateways of the GatewayClass.
+func gatewayIRKeys(t *gatewayapi.Translator, resources *gatewayapi.Resources) []string {
+	var keys []string
+	for _, gateway := range resources.Gateways {
+		if gateway.Spec.GatewayClassName != t.GatewayClassName {
+			continue
+		}
+		// All the Gateways share the IR of the GatewayClass when merged
+		if t.MergeGateways {
+			return []string{string(t.GatewayClassName)}
+		}
+		keys = append(keys, utils.NamespacedName(gateway).String())
+	}
+	return keys
 }
 
 // deleteAllIRKeys deletes all XdsIR and InfraIR
@@ -226,7 +480,34 @@ func (r *Runner) deleteAllIRKeys() {
 	for key := range r.InfraIR.LoadAll() {
 		r.InfraIR.Delete(key)
 		r.XdsIR.Delete(key)
+		resetIRSizes(key)
+	}
+}
+
+// recordXdsIRSize records the number of listeners and routes of the xDS IR.
+func recordXdsIRSize(key string, xdsIR *ir.Xds) {
+	routes := 0
+	for _, http := range xdsIR.HTTP {
+		routes += len(http.Routes)
+	}
+	xdsIRListeners.With(irKeyLabel.Value(key)).Record(float64(len(xdsIR.HTTP) + len(xdsIR.TCP) + len(xdsIR.UDP)))
+	xdsIRRoutes.With(irKeyLabel.Value(key)).Record(float64(routes))
+}
+
+// recordInfraIRSize records the number of proxy listeners of the infra IR.
+func recordInfraIRSize(key string, infraIR *ir.Infra) {
+	listeners := 0
+	if infraIR.Proxy != nil {
+		listeners = len(infraIR.Proxy.Listeners)
 	}
+	infraIRListeners.With(irKeyLabel.Value(key)).Record(float64(listeners))
+}
+
+// resetIRSizes resets the sizes recorded for the IRs of a deleted key.
+func resetIRSizes(key string) {
+	xdsIRListeners.With(irKeyLabel.Value(key)).Record(0)
+	xdsIRRoutes.With(irKeyLabel.Value(key)).Record(0)
+	infraIRListeners.With(irKeyLabel.Value(key)).Record(0)
 }
 
 type StatusesToDelete struct {
@@ -242,6 +523,7 @@ type StatusesToDelete struct {
 	BackendTrafficPolicyStatusKeys map[types.NamespacedName]bool
 	SecurityPolicyStatusKeys       map[types.NamespacedName]bool
 	EnvoyExtensionPolicyStatusKeys map[types.NamespacedName]bool
+	ExtensionPolicyStatusKeys      map[message.NamespacedNameAndGVK]bool
 }
 
 func (r *Runner) getAllStatuses() *StatusesToDelete {
@@ -259,6 +541,7 @@ func (r *Runner) getAllStatuses() *StatusesToDelete {
 		SecurityPolicyStatusKeys:       make(map[types.NamespacedName]bool),
 		BackendTLSPolicyStatusKeys:     make(map[types.NamespacedName]bool),
 		EnvoyExtensionPolicyStatusKeys: make(map[types.NamespacedName]bool),
+		ExtensionPolicyStatusKeys:      make(map[message.NamespacedNameAndGVK]bool),
 	}
 
 	// Get current status keys
@@ -296,9 +579,57 @@ func (r *Runner) getAllStatuses() *StatusesToDelete {
 	for key := range r.ProviderResources.EnvoyExtensionPolicyStatuses.LoadAll() {
 		ds.EnvoyExtensionPolicyStatusKeys[key] = true
 	}
+	for key := range r.ProviderResources.ExtensionPolicyStatuses.LoadAll() {
+		ds.ExtensionPolicyStatusKeys[key] = true
+	}
 	return ds
 }
 
+// keep removes the keys of the statuses of the translation result from the keys
+// to delete.
+func (ds *StatusesToDelete) keep(result *gatewayapi.TranslateResult) {
+	for _, gateway := range result.Gateways {
+		delete(ds.GatewayStatusKeys, utils.NamespacedName(gateway))
+	}
+	for _, httpRoute := range result.HTTPRoutes {
+		delete(ds.HTTPRouteStatusKeys, utils.NamespacedName(httpRoute))
+	}
+	for _, grpcRoute := range result.GRPCRoutes {
+		delete(ds.GRPCRouteStatusKeys, utils.NamespacedName(grpcRoute))
+	}
+	for _, tlsRoute := range result.TLSRoutes {
+		delete(ds.TLSRouteStatusKeys, utils.NamespacedName(tlsRoute))
+	}
+	for _, tcpRoute := range result.TCPRoutes {
+		delete(ds.TCPRouteStatusKeys, utils.NamespacedName(tcpRoute))
+	}
+	for _, udpRoute := range result.UDPRoutes {
+		delete(ds.UDPRouteStatusKeys, utils.NamespacedName(udpRoute))
+	}
+	for _, backendTLSPolicy := range result.BackendTLSPolicies {
+		delete(ds.BackendTLSPolicyStatusKeys, utils.NamespacedName(backendTLSPolicy))
+	}
+	for _, clientTrafficPolicy := range result.ClientTrafficPolicies {
+		delete(ds.ClientTrafficPolicyStatusKeys, utils.NamespacedName(clientTrafficPolicy))
+	}
+	for _, backendTrafficPolicy := range result.BackendTrafficPolicies {
+		delete(ds.BackendTrafficPolicyStatusKeys, utils.NamespacedName(backendTrafficPolicy))
+	}
+	for _, securityPolicy := range result.SecurityPolicies {
+		delete(ds.SecurityPolicyStatusKeys, utils.NamespacedName(securityPolicy))
+	}
+	for _, envoyExtensionPolicy := range result.EnvoyExtensionPolicies {
+		delete(ds.EnvoyExtensionPolicyStatusKeys, utils.NamespacedName(envoyExtensionPolicy))
+	}
+	for i := range result.ExtensionServerPolicies {
+		extServerPolicy := &result.ExtensionServerPolicies[i]
+		delete(ds.ExtensionPolicyStatusKeys, message.NamespacedNameAndGVK{
+			NamespacedName:   utils.NamespacedName(extServerPolicy),
+			GroupVersionKind: extServerPolicy.GroupVersionKind(),
+		})
+	}
+}
+
 func (r *Runner) deleteStatusKeys(ds *StatusesToDelete) {
 	for key := range ds.GatewayStatusKeys {
 		r.ProviderResources.GatewayStatuses.Delete(key)
@@ -345,6 +676,10 @@ func (r *Runner) deleteStatusKeys(ds *StatusesToDelete) {
 		r.ProviderResources.EnvoyExtensionPolicyStatuses.Delete(key)
 		delete(ds.EnvoyExtensionPolicyStatusKeys, key)
 	}
+	for key := range ds.ExtensionPolicyStatusKeys {
+		r.ProviderResources.ExtensionPolicyStatuses.Delete(key)
+		delete(ds.ExtensionPolicyStatusKeys, key)
+	}
 }
 
 // deleteAllStatusKeys deletes all status keys stored by the subscriber.
@@ -385,6 +720,9 @@ func (r *Runner) deleteAllStatusKeys() {
 	for key := range r.ProviderResources.EnvoyExtensionPolicyStatuses.LoadAll() {
 		r.ProviderResources.EnvoyExtensionPolicyStatuses.Delete(key)
 	}
+	for key := range r.ProviderResources.ExtensionPolicyStatuses.LoadAll() {
+		r.ProviderResources.ExtensionPolicyStatuses.Delete(key)
+	}
 }
 
 // getIRKeysToDelete returns the list of IR keys to delete
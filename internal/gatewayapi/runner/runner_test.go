@@ -7,21 +7,30 @@ package runner
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/extension/testutils"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/sharding"
+	"github.com/envoyproxy/gateway/internal/wasm"
 )
 
 func TestRunner(t *testing.T) {
@@ -162,7 +171,7 @@ func TestDeleteStatusKeys(t *testing.T) {
 
 	r.ProviderResources.GatewayStatuses.Store(keys[0], &gwapiv1.GatewayStatus{})
 	r.ProviderResources.HTTPRouteStatuses.Store(keys[1], &gwapiv1.HTTPRouteStatus{})
-	r.ProviderResources.GRPCRouteStatuses.Store(keys[2], &gwapiv1a2.GRPCRouteStatus{})
+	r.ProviderResources.GRPCRouteStatuses.Store(keys[2], &gwapiv1.GRPCRouteStatus{})
 	r.ProviderResources.TLSRouteStatuses.Store(keys[3], &gwapiv1a2.TLSRouteStatus{})
 	r.ProviderResources.TCPRouteStatuses.Store(keys[4], &gwapiv1a2.TCPRouteStatus{})
 	r.ProviderResources.UDPRouteStatuses.Store(keys[5], &gwapiv1a2.UDPRouteStatus{})
@@ -248,7 +257,7 @@ func TestDeleteAllStatusKeys(t *testing.T) {
 
 	r.ProviderResources.GatewayStatuses.Store(keys[0], &gwapiv1.GatewayStatus{})
 	r.ProviderResources.HTTPRouteStatuses.Store(keys[1], &gwapiv1.HTTPRouteStatus{})
-	r.ProviderResources.GRPCRouteStatuses.Store(keys[2], &gwapiv1a2.GRPCRouteStatus{})
+	r.ProviderResources.GRPCRouteStatuses.Store(keys[2], &gwapiv1.GRPCRouteStatus{})
 	r.ProviderResources.TLSRouteStatuses.Store(keys[3], &gwapiv1a2.TLSRouteStatus{})
 	r.ProviderResources.TCPRouteStatuses.Store(keys[4], &gwapiv1a2.TCPRouteStatus{})
 	r.ProviderResources.UDPRouteStatuses.Store(keys[5], &gwapiv1a2.UDPRouteStatus{})
@@ -279,3 +288,301 @@ func TestDeleteAllStatusKeys(t *testing.T) {
 	require.Equal(t, 0, r.ProviderResources.TCPRouteStatuses.Len())
 	require.Equal(t, 0, r.ProviderResources.UDPRouteStatuses.Len())
 }
+
+func TestRunnerIncrementalTranslation(t *testing.T) {
+	pResources := new(message.ProviderResources)
+	xdsIR := new(message.XdsIR)
+	xdsIRKeys := new(message.XdsIRKeys)
+	infraIR := new(message.InfraIR)
+	cfg, err := config.New()
+	require.NoError(t, err)
+	r := New(&Config{
+		Server:            *cfg,
+		ProviderResources: pResources,
+		XdsIR:             xdsIR,
+		XdsIRKeys:         xdsIRKeys,
+		InfraIR:           infraIR,
+		ExtensionManager:  testutils.NewManager(egv1a1.ExtensionManager{}),
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, r.Start(ctx))
+
+	resources := gatewayapi.NewResources()
+	resources.GatewayClass = &gwapiv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "eg"}}
+	for _, name := range []string{"gateway-1", "gateway-2"} {
+		resources.Gateways = append(resources.Gateways, &gwapiv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: gwapiv1.GatewaySpec{
+				GatewayClassName: "eg",
+				Listeners:        []gwapiv1.Listener{{Name: "http", Protocol: gwapiv1.HTTPProtocolType, Port: 80}},
+			},
+		})
+		resources.HTTPRoutes = append(resources.HTTPRoutes, &gwapiv1.HTTPRoute{
+			TypeMeta:   metav1.TypeMeta{Kind: gatewayapi.KindHTTPRoute},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: gwapiv1.HTTPRouteSpec{
+				CommonRouteSpec: gwapiv1.CommonRouteSpec{ParentRefs: []gwapiv1.ParentReference{{Name: gwapiv1.ObjectName(name)}}},
+				Rules:           []gwapiv1.HTTPRouteRule{{}},
+			},
+		})
+	}
+	pResources.GatewayAPIResources.Store("eg", &gatewayapi.ControllerResources{resources.DeepCopy()})
+	require.Eventually(t, func() bool {
+		return xdsIR.Len() == 2 && pResources.HTTPRouteStatuses.Len() == 2
+	}, time.Second, 20*time.Millisecond)
+
+	// Only the xDS IR of the changed Gateway is published again
+	updates := xdsIR.Subscribe(ctx)
+	<-updates
+	resources.Gateways[0].Spec.Listeners[0].Port = 8080
+	pResources.GatewayAPIResources.Store("eg", &gatewayapi.ControllerResources{resources.DeepCopy()})
+	select {
+	case snapshot := <-updates:
+		require.Len(t, snapshot.Updates, 1)
+		require.Equal(t, "default/gateway-1", snapshot.Updates[0].Key)
+	case <-time.After(time.Second):
+		t.Fatal("the xds ir wasn't published")
+	}
+
+	// The IRs and statuses of the Gateway which wasn't translated again are kept
+	require.Equal(t, 2, xdsIR.Len())
+	require.Equal(t, 2, infraIR.Len())
+	require.Equal(t, 2, pResources.GatewayStatuses.Len())
+	require.Equal(t, 2, pResources.HTTPRouteStatuses.Len())
+	keys, _ := xdsIRKeys.Load(cfg.EnvoyGateway.Gateway.ControllerName)
+	require.Equal(t, message.IRKeys{"default/gateway-1", "default/gateway-2"}, keys)
+}
+
+func TestRunnerSharding(t *testing.T) {
+	pResources := new(message.ProviderResources)
+	xdsIR := new(message.XdsIR)
+	infraIR := new(message.InfraIR)
+	cfg, err := config.New()
+	require.NoError(t, err)
+	self := sharding.Member{Name: "eg-0"}
+	shard := sharding.NewShard(self)
+	r := New(&Config{
+		Server:            *cfg,
+		ProviderResources: pResources,
+		XdsIR:             xdsIR,
+		InfraIR:           infraIR,
+		ExtensionManager:  testutils.NewManager(egv1a1.ExtensionManager{}),
+		Shard:             shard,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, r.Start(ctx))
+
+	resources := gatewayapi.NewResources()
+	resources.GatewayClass = &gwapiv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "eg"}}
+	for _, name := range []string{"gateway-1", "gateway-2"} {
+		resources.Gateways = append(resources.Gateways, &gwapiv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: gwapiv1.GatewaySpec{
+				GatewayClassName: "eg",
+				Listeners:        []gwapiv1.Listener{{Name: "http", Protocol: gwapiv1.HTTPProtocolType, Port: 80}},
+			},
+		})
+	}
+
+	// Nothing is translated until the replica joins the sharding
+	pResources.GatewayAPIResources.Store("eg", &gatewayapi.ControllerResources{resources.DeepCopy()})
+	require.Never(t, func() bool {
+		return xdsIR.Len() > 0
+	}, 200*time.Millisecond, 20*time.Millisecond)
+
+	// The replica owns all the Gateways while it's the only member
+	shard.SetMembers([]sharding.Member{self})
+	require.Eventually(t, func() bool {
+		return xdsIR.Len() == 2 && infraIR.Len() == 2 && pResources.GatewayStatuses.Len() == 2
+	}, time.Second, 20*time.Millisecond)
+
+	// Find a member which takes over gateway-2 only
+	var other sharding.Member
+	for i := 1; other.Name == ""; i++ {
+		candidate := sharding.Member{Name: fmt.Sprintf("eg-%d", i)}
+		members := []sharding.Member{self, candidate}
+		owner1, _ := sharding.Owner(sharding.Key("eg", "default/gateway-1"), members)
+		owner2, _ := sharding.Owner(sharding.Key("eg", "default/gateway-2"), members)
+		if owner1 == self && owner2 == candidate {
+			other = candidate
+		}
+	}
+
+	// The xDS IR and status of the Gateway handed over are deleted, but its infra
+	// IR is kept
+	shard.SetMembers([]sharding.Member{self, other})
+	require.Eventually(t, func() bool {
+		return xdsIR.Len() == 1 && pResources.GatewayStatuses.Len() == 1
+	}, time.Second, 20*time.Millisecond)
+	require.NotNil(t, xdsIR.LoadAll()["default/gateway-1"])
+	require.Equal(t, 2, infraIR.Len())
+
+	// The infra IR is deleted with the Gateway
+	resources.Gateways = resources.Gateways[:1]
+	pResources.GatewayAPIResources.Store("eg", &gatewayapi.ControllerResources{resources.DeepCopy()})
+	require.Eventually(t, func() bool {
+		return infraIR.Len() == 1
+	}, time.Second, 20*time.Millisecond)
+}
+
+func TestRunnerUpdateCoalescing(t *testing.T) {
+	pResources := new(message.ProviderResources)
+	xdsIR := new(message.XdsIR)
+	infraIR := new(message.InfraIR)
+	cfg, err := config.New()
+	require.NoError(t, err)
+	cfg.EnvoyGateway.UpdateCoalescing = &egv1a1.EnvoyGatewayUpdateCoalescing{Debounce: "200ms"}
+	r := New(&Config{
+		Server:            *cfg,
+		ProviderResources: pResources,
+		XdsIR:             xdsIR,
+		InfraIR:           infraIR,
+		ExtensionManager:  testutils.NewManager(egv1a1.ExtensionManager{}),
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, r.Start(ctx))
+	updates := xdsIR.Subscribe(ctx)
+	<-updates
+
+	resources := gatewayapi.NewResources()
+	resources.GatewayClass = &gwapiv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "eg"}}
+	resources.Gateways = append(resources.Gateways, &gwapiv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gateway-1"},
+		Spec: gwapiv1.GatewaySpec{
+			GatewayClassName: "eg",
+			Listeners:        []gwapiv1.Listener{{Name: "http", Protocol: gwapiv1.HTTPProtocolType}},
+		},
+	})
+
+	// The burst of updates is translated once
+	for port := 8080; port < 8085; port++ {
+		resources.Gateways[0].Spec.Listeners[0].Port = gwapiv1.PortNumber(port)
+		pResources.GatewayAPIResources.Store("eg", &gatewayapi.ControllerResources{resources.DeepCopy()})
+	}
+	select {
+	case snapshot := <-updates:
+		require.Len(t, snapshot.Updates, 1)
+		require.Equal(t, uint32(8084), snapshot.Updates[0].Value.HTTP[0].Port)
+	case <-time.After(2 * time.Second):
+		t.Fatal("the xds ir wasn't published")
+	}
+	select {
+	case <-updates:
+		t.Fatal("the updates weren't coalesced")
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+// fakeWasmCache serves the wasm code of every image once ready.
+type fakeWasmCache struct {
+	ready  atomic.Bool
+	pulled chan struct{}
+}
+
+func (c *fakeWasmCache) Get(string, wasm.GetOptions) (*wasm.Module, error) {
+	if !c.ready.Load() {
+		return nil, wasm.ErrPullPending
+	}
+	return &wasm.Module{SHA256: strings.Repeat("0", 64)}, nil
+}
+
+func (c *fakeWasmCache) URL(checksum, scope string) string {
+	return "https://envoy-gateway:18002/" + scope + "/" + checksum + ".wasm"
+}
+
+func (c *fakeWasmCache) Pulled() <-chan struct{} {
+	return c.pulled
+}
+
+func (c *fakeWasmCache) Pending() bool {
+	return !c.ready.Load()
+}
+
+func TestRunnerWasmPulled(t *testing.T) {
+	pResources := new(message.ProviderResources)
+	xdsIR := new(message.XdsIR)
+	infraIR := new(message.InfraIR)
+	cfg, err := config.New()
+	require.NoError(t, err)
+	wasmCache := &fakeWasmCache{pulled: make(chan struct{})}
+	r := New(&Config{
+		Server:            *cfg,
+		ProviderResources: pResources,
+		XdsIR:             xdsIR,
+		InfraIR:           infraIR,
+		ExtensionManager:  testutils.NewManager(egv1a1.ExtensionManager{}),
+		WasmCache:         wasmCache,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, r.Start(ctx))
+
+	resources := gatewayapi.NewResources()
+	resources.GatewayClass = &gwapiv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "eg"}}
+	resources.Gateways = append(resources.Gateways, &gwapiv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gateway-1"},
+		Spec: gwapiv1.GatewaySpec{
+			GatewayClassName: "eg",
+			Listeners:        []gwapiv1.Listener{{Name: "http", Protocol: gwapiv1.HTTPProtocolType, Port: 80}},
+		},
+	})
+	resources.Secrets = append(resources.Secrets, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "registry-secret"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+	})
+	resources.EnvoyExtensionPolicies = append(resources.EnvoyExtensionPolicies, &egv1a1.EnvoyExtensionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "policy-1"},
+		Spec: egv1a1.EnvoyExtensionPolicySpec{
+			TargetRef: egv1a1.PolicyTargetReferenceWithSectionName{
+				PolicyTargetReference: egv1a1.PolicyTargetReference{
+					Group: gwapiv1.GroupName,
+					Kind:  gatewayapi.KindGateway,
+					Name:  "gateway-1",
+				},
+			},
+			WASM: []egv1a1.Wasm{{
+				Name: "plugin",
+				Code: egv1a1.WasmCodeSource{
+					Type: egv1a1.ImageWasmCodeSourceType,
+					Image: &egv1a1.ImageWasmCodeSource{
+						URL:           "oci://registry.example.com/org/plugin:v1",
+						PullSecretRef: gwapiv1b1.SecretObjectReference{Name: "registry-secret"},
+					},
+				},
+			}},
+		},
+	})
+	key := types.NamespacedName{Namespace: "default", Name: "policy-1"}
+	accepted := func(reason gwapiv1a2.PolicyConditionReason) func() bool {
+		return func() bool {
+			policyStatus, ok := pResources.EnvoyExtensionPolicyStatuses.Load(key)
+			if !ok || len(policyStatus.Ancestors) == 0 {
+				return false
+			}
+			conditions := policyStatus.Ancestors[0].Conditions
+			return len(conditions) == 1 && conditions[0].Reason == string(reason)
+		}
+	}
+
+	keptResources := func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return r.resources != nil
+	}
+
+	// The policy is pending while the wasm module is being pulled
+	pResources.GatewayAPIResources.Store("eg", &gatewayapi.ControllerResources{resources.DeepCopy()})
+	require.Eventually(t, accepted(egv1a1.PolicyReasonPending), time.Second, 20*time.Millisecond)
+	require.True(t, keptResources())
+
+	// The resources are translated again once the module is pulled, and their
+	// copy is then dropped
+	wasmCache.ready.Store(true)
+	wasmCache.pulled <- struct{}{}
+	require.Eventually(t, accepted(gwapiv1a2.PolicyReasonAccepted), time.Second, 20*time.Millisecond)
+	require.Eventually(t, func() bool { return !keptResources() }, time.Second, 20*time.Millisecond)
+}
@@ -42,34 +42,15 @@ const (
 )
 
 func (t *Translator) ProcessSecurityPolicies(securityPolicies []*egv1a1.SecurityPolicy,
-	gateways []*GatewayContext,
-	routes []RouteContext,
 	resources *Resources,
-	xdsIR XdsIRMap) []*egv1a1.SecurityPolicy {
+	xdsIR XdsIRMap, index *policyIndex) []*egv1a1.SecurityPolicy {
 	var res []*egv1a1.SecurityPolicy
 
 	// Sort based on timestamp
-	sort.Slice(securityPolicies, func(i, j int) bool {
+	sort.SliceStable(securityPolicies, func(i, j int) bool {
 		return securityPolicies[i].CreationTimestamp.Before(&(securityPolicies[j].CreationTimestamp))
 	})
 
-	// First build a map out of the routes and gateways for faster lookup since users might have thousands of routes or more.
-	// For gateways this probably isn't quite as necessary.
-	routeMap := map[policyTargetRouteKey]*policyRouteTargetContext{}
-	for _, route := range routes {
-		key := policyTargetRouteKey{
-			Kind:      string(GetRouteType(route)),
-			Name:      route.GetName(),
-			Namespace: route.GetNamespace(),
-		}
-		routeMap[key] = &policyRouteTargetContext{RouteContext: route}
-	}
-	gatewayMap := map[types.NamespacedName]*policyGatewayTargetContext{}
-	for _, gw := range gateways {
-		key := utils.NamespacedName(gw)
-		gatewayMap[key] = &policyGatewayTargetContext{GatewayContext: gw}
-	}
-
 	// Map of Gateway to the routes attached to it
 	gatewayRouteMap := make(map[string]sets.Set[string])
 
@@ -89,7 +70,7 @@ func (t *Translator) ProcessSecurityPolicies(securityPolicies []*egv1a1.Security
 
 			res = append(res, policy)
 
-			targetedRoute, resolveErr = resolveSecurityPolicyRouteTargetRef(policy, routeMap)
+			targetedRoute, resolveErr = resolveSecurityPolicyRouteTargetRef(policy, index.routes)
 			// Skip if the route is not found
 			// It's not necessarily an error because the SecurityPolicy may be
 			// reconciled by multiple controllers. And the other controller may
@@ -134,7 +115,7 @@ func (t *Translator) ProcessSecurityPolicies(securityPolicies []*egv1a1.Security
 				continue
 			}
 
-			if err := t.translateSecurityPolicyForRoute(policy, targetedRoute, resources, xdsIR); err != nil {
+			if err := t.translateSecurityPolicyForRoute(policy, targetedRoute, resources, index); err != nil {
 				status.SetTranslationErrorForPolicyAncestors(&policy.Status,
 					parentGateways,
 					t.GatewayControllerName,
@@ -159,7 +140,7 @@ func (t *Translator) ProcessSecurityPolicies(securityPolicies []*egv1a1.Security
 
 			res = append(res, policy)
 
-			targetedGateway, resolveErr = resolveSecurityPolicyGatewayTargetRef(policy, gatewayMap)
+			targetedGateway, resolveErr = resolveSecurityPolicyGatewayTargetRef(policy, index.gateways)
 			// Skip if the gateway is not found
 			// It's not necessarily an error because the SecurityPolicy may be
 			// reconciled by multiple controllers. And the other controller may
@@ -186,7 +167,7 @@ func (t *Translator) ProcessSecurityPolicies(securityPolicies []*egv1a1.Security
 				continue
 			}
 
-			if err := t.translateSecurityPolicyForGateway(policy, targetedGateway, resources, xdsIR); err != nil {
+			if err := t.translateSecurityPolicyForGateway(policy, targetedGateway, resources, xdsIR, index); err != nil {
 				status.SetTranslationErrorForPolicyAncestors(&policy.Status,
 					parentGateways,
 					t.GatewayControllerName,
@@ -260,7 +241,7 @@ func resolveSecurityPolicyGatewayTargetRef(
 	}
 
 	// Check if another policy targeting the same Gateway exists
-	if gateway.attached {
+	if gateway.attached.Has(egv1a1.KindSecurityPolicy) {
 		message := "Unable to target Gateway, another SecurityPolicy has already attached to it"
 
 		return gateway.GatewayContext, &status.PolicyResolveError{
@@ -270,7 +251,7 @@ func resolveSecurityPolicyGatewayTargetRef(
 	}
 
 	// Set context and save
-	gateway.attached = true
+	gateway.attached.Insert(egv1a1.KindSecurityPolicy)
 	gateways[key] = gateway
 
 	return gateway.GatewayContext, nil
@@ -314,7 +295,7 @@ func resolveSecurityPolicyRouteTargetRef(
 	}
 
 	// Check if another policy targeting the same xRoute exists
-	if route.attached {
+	if route.attached.Has(egv1a1.KindSecurityPolicy) {
 		message := fmt.Sprintf("Unable to target %s, another SecurityPolicy has already attached to it",
 			string(policy.Spec.TargetRef.Kind))
 
@@ -325,7 +306,7 @@ func resolveSecurityPolicyRouteTargetRef(
 	}
 
 	// Set context and save
-	route.attached = true
+	route.attached.Insert(egv1a1.KindSecurityPolicy)
 	routes[key] = route
 
 	return route.RouteContext, nil
@@ -333,7 +314,7 @@ func resolveSecurityPolicyRouteTargetRef(
 
 func (t *Translator) translateSecurityPolicyForRoute(
 	policy *egv1a1.SecurityPolicy, route RouteContext,
-	resources *Resources, xdsIR XdsIRMap) error {
+	resources *Resources, index *policyIndex) error {
 	// Build IR
 	var (
 		cors      *ir.CORS
@@ -371,7 +352,8 @@ func (t *Translator) translateSecurityPolicyForRoute(
 	if policy.Spec.ExtAuth != nil {
 		if extAuth, err = t.buildExtAuth(
 			policy,
-			resources); err != nil {
+			resources,
+			index); err != nil {
 			errs = errors.Join(errs, err)
 		}
 	}
@@ -379,30 +361,20 @@ func (t *Translator) translateSecurityPolicyForRoute(
 	// Apply IR to all relevant routes
 	// Note: there are multiple features in a security policy, even if some of them
 	// are invalid, we still want to apply the valid ones.
-	prefix := irRoutePrefix(route)
-	for _, ir := range xdsIR {
-		for _, http := range ir.HTTP {
-			for _, r := range http.Routes {
-				// Apply if there is a match
-				// TODO zhaohuabing: extract a utils function to check if an HTTP
-				// route is associated with a Gateway API xRoute
-				if strings.HasPrefix(r.Name, prefix) {
-					// This security policy matches the current route. It should only be accepted if it doesn't match any other route
-					r.CORS = cors
-					r.JWT = jwt
-					r.OIDC = oidc
-					r.BasicAuth = basicAuth
-					r.ExtAuth = extAuth
-				}
-			}
-		}
+	for _, r := range index.irHTTPRoutes(route) {
+		// This security policy matches the current route. It should only be accepted if it doesn't match any other route
+		r.CORS = cors
+		r.JWT = jwt
+		r.OIDC = oidc
+		r.BasicAuth = basicAuth
+		r.ExtAuth = extAuth
 	}
 	return errs
 }
 
 func (t *Translator) translateSecurityPolicyForGateway(
 	policy *egv1a1.SecurityPolicy, gateway *GatewayContext,
-	resources *Resources, xdsIR XdsIRMap) error {
+	resources *Resources, xdsIR XdsIRMap, index *policyIndex) error {
 	// Build IR
 	var (
 		cors      *ir.CORS
@@ -440,7 +412,8 @@ func (t *Translator) translateSecurityPolicyForGateway(
 	if policy.Spec.ExtAuth != nil {
 		if extAuth, err = t.buildExtAuth(
 			policy,
-			resources); err != nil {
+			resources,
+			index); err != nil {
 			errs = errors.Join(errs, err)
 		}
 	}
@@ -767,7 +740,8 @@ func (t *Translator) buildBasicAuth(
 
 func (t *Translator) buildExtAuth(
 	policy *egv1a1.SecurityPolicy,
-	resources *Resources) (*ir.ExtAuth, error) {
+	resources *Resources,
+	index *policyIndex) (*ir.ExtAuth, error) {
 	var (
 		http       = policy.Spec.ExtAuth.HTTP
 		grpc       = policy.Spec.ExtAuth.GRPC
@@ -811,7 +785,8 @@ func (t *Translator) buildExtAuth(
 		pnn,
 		KindSecurityPolicy,
 		protocol,
-		resources); err != nil {
+		resources,
+		index); err != nil {
 		return nil, err
 	}
 	rd := ir.RouteDestination{
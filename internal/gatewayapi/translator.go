@@ -7,12 +7,14 @@ package gatewayapi
 
 import (
 	"golang.org/x/exp/maps"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
-	egv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/wasm"
 )
 
 const (
@@ -56,6 +58,7 @@ type TranslatorManager interface {
 	Translate(resources *Resources) *TranslateResult
 	GetRelevantGateways(gateways []*gwapiv1.Gateway) []*GatewayContext
 
+	EnvoyProxiesTranslator
 	RoutesTranslator
 	ListenersTranslator
 	AddressesTranslator
@@ -96,6 +99,15 @@ type Translator struct {
 
 	// Namespace is the namespace that Envoy Gateway runs in.
 	Namespace string
+
+	// WasmCache pulls the wasm code of the OCI images used by
+	// EnvoyExtensionPolicies, and serves it to Envoy.
+	WasmCache wasm.Cache
+
+	// ShardFilter returns whether the replica owns the translation unit
+	// identified by its key when the Gateways are sharded across the
+	// replicas. The units it doesn't own are skipped by TranslateIncrementally.
+	ShardFilter func(unitKey string) bool
 }
 
 type TranslateResult struct {
@@ -113,8 +125,9 @@ func newTranslateResult(gateways []*GatewayContext,
 	clientTrafficPolicies []*egv1a1.ClientTrafficPolicy,
 	backendTrafficPolicies []*egv1a1.BackendTrafficPolicy,
 	securityPolicies []*egv1a1.SecurityPolicy,
-	backendTLSPolicies []*egv1a2.BackendTLSPolicy,
+	backendTLSPolicies []*gwapiv1a3.BackendTLSPolicy,
 	envoyExtensionPolicies []*egv1a1.EnvoyExtensionPolicy,
+	extensionServerPolicies []unstructured.Unstructured,
 	xdsIR XdsIRMap, infraIR InfraIRMap) *TranslateResult {
 	translateResult := &TranslateResult{
 		XdsIR:   xdsIR,
@@ -145,13 +158,20 @@ func newTranslateResult(gateways []*GatewayContext,
 	translateResult.SecurityPolicies = append(translateResult.SecurityPolicies, securityPolicies...)
 	translateResult.BackendTLSPolicies = append(translateResult.BackendTLSPolicies, backendTLSPolicies...)
 	translateResult.EnvoyExtensionPolicies = append(translateResult.EnvoyExtensionPolicies, envoyExtensionPolicies...)
+	translateResult.ExtensionServerPolicies = append(translateResult.ExtensionServerPolicies, extensionServerPolicies...)
 
 	return translateResult
 }
 
 func (t *Translator) Translate(resources *Resources) *TranslateResult {
 	// Get Gateways belonging to our GatewayClass.
-	gateways := t.GetRelevantGateways(resources.Gateways)
+	relevantGateways := t.GetRelevantGateways(resources.Gateways)
+
+	// Resolve the EnvoyProxy of the Gateways, and translate the accepted ones.
+	gateways := t.ProcessEnvoyProxies(relevantGateways, resources)
+
+	// Index the Gateways and the BackendTLSPolicies for the policies to be resolved against.
+	index := newPolicyIndex(gateways, resources.BackendTLSPolicies)
 
 	// Build IR maps.
 	xdsIR, infraIR := t.InitIRs(gateways, resources)
@@ -163,27 +183,27 @@ func (t *Translator) Translate(resources *Resources) *TranslateResult {
 	t.ProcessEnvoyPatchPolicies(resources.EnvoyPatchPolicies, xdsIR)
 
 	// Process ClientTrafficPolicies
-	clientTrafficPolicies := t.ProcessClientTrafficPolicies(resources, gateways, xdsIR, infraIR)
+	clientTrafficPolicies := t.ProcessClientTrafficPolicies(resources, xdsIR, infraIR, index)
 
 	// Process all Addresses for all relevant Gateways.
 	t.ProcessAddresses(gateways, xdsIR, infraIR, resources)
 
 	// Process all relevant HTTPRoutes.
-	httpRoutes := t.ProcessHTTPRoutes(resources.HTTPRoutes, gateways, resources, xdsIR)
+	httpRoutes := t.ProcessHTTPRoutes(resources.HTTPRoutes, gateways, resources, xdsIR, index)
 
 	// Process all relevant GRPCRoutes.
-	grpcRoutes := t.ProcessGRPCRoutes(resources.GRPCRoutes, gateways, resources, xdsIR)
+	grpcRoutes := t.ProcessGRPCRoutes(resources.GRPCRoutes, gateways, resources, xdsIR, index)
 
 	// Process all relevant TLSRoutes.
-	tlsRoutes := t.ProcessTLSRoutes(resources.TLSRoutes, gateways, resources, xdsIR)
+	tlsRoutes := t.ProcessTLSRoutes(resources.TLSRoutes, gateways, resources, xdsIR, index)
 
 	// Process all relevant TCPRoutes.
-	tcpRoutes := t.ProcessTCPRoutes(resources.TCPRoutes, gateways, resources, xdsIR)
+	tcpRoutes := t.ProcessTCPRoutes(resources.TCPRoutes, gateways, resources, xdsIR, index)
 
 	// Process all relevant UDPRoutes.
-	udpRoutes := t.ProcessUDPRoutes(resources.UDPRoutes, gateways, resources, xdsIR)
+	udpRoutes := t.ProcessUDPRoutes(resources.UDPRoutes, gateways, resources, xdsIR, index)
 
-	// Process BackendTrafficPolicies
+	// Index the xRoutes, and the IR translated from them, for the policies to be resolved against.
 	routes := []RouteContext{}
 	for _, h := range httpRoutes {
 		routes = append(routes, h)
@@ -200,26 +220,34 @@ func (t *Translator) Translate(resources *Resources) *TranslateResult {
 	for _, u := range udpRoutes {
 		routes = append(routes, u)
 	}
+	index.addRoutes(routes, xdsIR)
 
 	// Process BackendTrafficPolicies
 	backendTrafficPolicies := t.ProcessBackendTrafficPolicies(
-		resources.BackendTrafficPolicies, gateways, routes, xdsIR)
+		resources.BackendTrafficPolicies, xdsIR, index)
 
 	// Process SecurityPolicies
 	securityPolicies := t.ProcessSecurityPolicies(
-		resources.SecurityPolicies, gateways, routes, resources, xdsIR)
+		resources.SecurityPolicies, resources, xdsIR, index)
 
 	// Process EnvoyExtensionPolicies
 	envoyExtensionPolicies := t.ProcessEnvoyExtensionPolicies(
-		resources.EnvoyExtensionPolicies, gateways, routes, resources, xdsIR)
+		resources.EnvoyExtensionPolicies, resources, xdsIR, index)
+
+	// Let the proxies fetching the wasm modules of OCI images reach the Wasm server.
+	t.processWasmServers(xdsIR, infraIR)
+
+	// Process ExtensionServerPolicies
+	extensionServerPolicies := t.ProcessExtensionServerPolicies(
+		resources.ExtensionServerPolicies, xdsIR, index)
 
 	// Sort xdsIR based on the Gateway API spec
 	sortXdsIRMap(xdsIR)
 
-	return newTranslateResult(gateways, httpRoutes, grpcRoutes, tlsRoutes,
+	return newTranslateResult(relevantGateways, httpRoutes, grpcRoutes, tlsRoutes,
 		tcpRoutes, udpRoutes, clientTrafficPolicies, backendTrafficPolicies,
 		securityPolicies, resources.BackendTLSPolicies, envoyExtensionPolicies,
-		xdsIR, infraIR)
+		extensionServerPolicies, xdsIR, infraIR)
 
 }
 
@@ -7,6 +7,7 @@ package gatewayapi
 
 import (
 	"bufio"
+	"crypto/sha256"
 	"flag"
 	"fmt"
 	"os"
@@ -32,12 +33,44 @@ import (
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/utils/field"
 	"github.com/envoyproxy/gateway/internal/utils/file"
+	"github.com/envoyproxy/gateway/internal/wasm"
 )
 
 var (
 	overrideTestData = flag.Bool("override-testdata", false, "if override the test output data.")
 )
 
+// fakeWasmCache serves the wasm code of every image, except the images
+// whose URL contains "not-found", and the ones whose URL contains "pending"
+// which are being pulled.
+type fakeWasmCache struct{}
+
+func (*fakeWasmCache) Get(image string, opts wasm.GetOptions) (*wasm.Module, error) {
+	if strings.Contains(image, "not-found") {
+		return nil, fmt.Errorf("failed to fetch the manifest of %s: unexpected status code 404", image)
+	}
+	if strings.Contains(image, "pending") {
+		return nil, wasm.ErrPullPending
+	}
+	checksum := fmt.Sprintf("%x", sha256.Sum256([]byte(image)))
+	if opts.SHA256 != "" && opts.SHA256 != checksum {
+		return nil, fmt.Errorf("checksum mismatch for image %s: expected %s, got %s", image, opts.SHA256, checksum)
+	}
+	return &wasm.Module{SHA256: checksum}, nil
+}
+
+func (*fakeWasmCache) URL(checksum, scope string) string {
+	return "https://envoy-gateway:18002/" + scope + "/" + checksum + ".wasm"
+}
+
+func (*fakeWasmCache) Pulled() <-chan struct{} {
+	return nil
+}
+
+func (*fakeWasmCache) Pending() bool {
+	return false
+}
+
 func mustUnmarshal(t *testing.T, val []byte, out interface{}) {
 	require.NoError(t, yaml.UnmarshalStrict(val, out, yaml.DisallowUnknownFields))
 }
@@ -79,6 +112,7 @@ func TestTranslate(t *testing.T) {
 				EnvoyPatchPolicyEnabled: envoyPatchPolicyEnabled,
 				Namespace:               "envoy-gateway-system",
 				MergeGateways:           IsMergeGatewaysEnabled(resources),
+				WasmCache:               &fakeWasmCache{},
 			}
 
 			// Add common test fixtures
@@ -227,8 +261,11 @@ func TestTranslate(t *testing.T) {
 				},
 			})
 
+			// The translation modifies the resources
+			pristine := resources.DeepCopy()
+
 			got := translator.Translate(resources)
-			require.NoError(t, field.SetValue(got, "LastTransitionTime", metav1.NewTime(time.Time{})))
+			resetLastTransitionTimes(t, got)
 			outputFilePath := strings.ReplaceAll(inputFile, ".in.yaml", ".out.yaml")
 			out, err := yaml.Marshal(got)
 			require.NoError(t, err)
@@ -249,10 +286,31 @@ func TestTranslate(t *testing.T) {
 			}
 
 			require.Empty(t, cmp.Diff(want, got, opts...))
+
+			// The incremental translation returns the same result, whether the translation
+			// units are translated or their results are reused.
+			cache := NewTranslationCache()
+			for i := 0; i < 2; i++ {
+				incremental, _ := translator.TranslateIncrementally(pristine.DeepCopy(), cache)
+				resetLastTransitionTimes(t, incremental)
+				require.Empty(t, cmp.Diff(want, incremental, opts...))
+			}
 		})
 	}
 }
 
+// resetLastTransitionTimes resets the transition times of the status conditions
+// of the translation result.
+func resetLastTransitionTimes(t *testing.T, result *TranslateResult) {
+	require.NoError(t, field.SetValue(result, "LastTransitionTime", metav1.NewTime(time.Time{})))
+	// The statuses of the unstructured policies introduced by an extension aren't typed
+	for i := range result.ExtensionServerPolicies {
+		policyStatus := ExtServerPolicyStatusAsPolicyStatus(&result.ExtensionServerPolicies[i])
+		require.NoError(t, field.SetValue(&policyStatus, "LastTransitionTime", metav1.NewTime(time.Time{})))
+		require.NoError(t, setExtServerPolicyStatus(&result.ExtensionServerPolicies[i], &policyStatus))
+	}
+}
+
 func TestTranslateWithExtensionKinds(t *testing.T) {
 	inputFiles, err := filepath.Glob(filepath.Join("testdata/extensions", "*.in.yaml"))
 	require.NoError(t, err)
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1alpha3"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
 )
@@ -176,6 +177,17 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 		*out = new(apiv1alpha1.EnvoyProxy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EnvoyProxiesForGateways != nil {
+		in, out := &in.EnvoyProxiesForGateways, &out.EnvoyProxiesForGateways
+		*out = make([]*apiv1alpha1.EnvoyProxy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apiv1alpha1.EnvoyProxy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.ExtensionRefFilters != nil {
 		in, out := &in.ExtensionRefFilters, &out.ExtensionRefFilters
 		*out = make([]unstructured.Unstructured, len(*in))
@@ -229,11 +241,11 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 	}
 	if in.BackendTLSPolicies != nil {
 		in, out := &in.BackendTLSPolicies, &out.BackendTLSPolicies
-		*out = make([]*v1alpha2.BackendTLSPolicy, len(*in))
+		*out = make([]*v1alpha3.BackendTLSPolicy, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(v1alpha2.BackendTLSPolicy)
+				*out = new(v1alpha3.BackendTLSPolicy)
 				(*in).DeepCopyInto(*out)
 			}
 		}
@@ -249,6 +261,17 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			}
 		}
 	}
+	if in.ExtensionServerPolicies != nil {
+		in, out := &in.ExtensionServerPolicies, &out.ExtensionServerPolicies
+		*out = make([]unstructured.Unstructured, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
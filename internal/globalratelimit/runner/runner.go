@@ -29,6 +29,7 @@ import (
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/ratelimit"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/supervisor"
 	"github.com/envoyproxy/gateway/internal/xds/translator"
 	"github.com/envoyproxy/gateway/internal/xds/types"
 )
@@ -61,12 +62,13 @@ func (r *Runner) Name() string {
 }
 
 func New(cfg *Config) *Runner {
-	return &Runner{Config: *cfg}
+	r := &Runner{Config: *cfg}
+	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
+	return r
 }
 
 // Start starts the infrastructure runner
 func (r *Runner) Start(ctx context.Context) (err error) {
-	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
 
 	// Set up the gRPC server and register the xDS handler.
 	// Create SnapshotCache before start subscribeAndTranslate,
@@ -81,10 +83,10 @@ func (r *Runner) Start(ctx context.Context) (err error) {
 	discoveryv3.RegisterAggregatedDiscoveryServiceServer(r.grpc, serverv3.NewServer(ctx, r.cache, cb))
 
 	// Start and listen xDS gRPC config Server.
-	go r.serveXdsConfigServer(ctx)
+	supervisor.Go(ctx, func() { r.serveXdsConfigServer(ctx) })
 
 	// Start message Subscription.
-	go r.subscribeAndTranslate(ctx)
+	supervisor.Go(ctx, func() { r.subscribeAndTranslate(ctx) })
 
 	r.Logger.Info("started")
 	return
@@ -0,0 +1,173 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package host
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/crypto"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+const defaultEnvoyBinary = "envoy"
+
+var (
+	// xDS certificate rotation is supported by using SDS path-based resource files.
+	sdsCAData = `{"resources":[{"@type":"type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret",` +
+		`"name":"xds_trusted_ca","validation_context":{"trusted_ca":{"filename":"%s"},` +
+		`"match_typed_subject_alt_names":[{"san_type":"DNS","matcher":{"exact":"envoy-gateway"}}]}}]}`
+	sdsCertData = `{"resources":[{"@type":"type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret",` +
+		`"name":"xds_certificate","tls_certificate":{"certificate_chain":{"filename":"%s"},` +
+		`"private_key":{"filename":"%s"}}}]}`
+)
+
+// Infra manages the creation and deletion of the Envoy processes running
+// on the host, based on Infra IR resources.
+type Infra struct {
+	// Paths are the locations of the files shared with the Envoy processes.
+	Paths *Paths
+
+	// EnvoyBinary is the path of the Envoy executable.
+	EnvoyBinary string
+
+	// EnvoyGateway is the configuration used to startup Envoy Gateway.
+	EnvoyGateway *v1alpha1.EnvoyGateway
+
+	logger logging.Logger
+
+	// ctx is the context the Envoy processes run under. It is only canceled
+	// by Close, so that the processes outlive the callers of the Infra.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	proxies map[string]*proxyProcess
+	// stopping are the processes of the deleted proxies which are still
+	// draining.
+	stopping map[string]*proxyProcess
+}
+
+// NewInfra returns a new Infra. The certificates used to secure the xDS
+// connection are generated, if they don't exist yet.
+func NewInfra(cfg *config.Server) (*Infra, error) {
+	custom := cfg.EnvoyGateway.Provider.Custom
+	if custom == nil {
+		return nil, errors.New("custom provider is unspecified")
+	}
+	host := custom.Infrastructure.Host
+
+	paths, err := GetPaths(host)
+	if err != nil {
+		return nil, err
+	}
+
+	binary := defaultEnvoyBinary
+	if host != nil && host.EnvoyBinary != nil && *host.EnvoyBinary != "" {
+		binary = *host.EnvoyBinary
+	} else if binary, err = exec.LookPath(defaultEnvoyBinary); err != nil {
+		return nil, fmt.Errorf("failed to find the envoy binary: %w", err)
+	}
+
+	if err := createCertificates(cfg, paths); err != nil {
+		return nil, err
+	}
+
+	if err := createSdsConfig(paths); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Infra{
+		Paths:        paths,
+		EnvoyBinary:  binary,
+		EnvoyGateway: cfg.EnvoyGateway,
+		logger:       cfg.Logger.WithName("host-infra"),
+		ctx:          ctx,
+		cancel:       cancel,
+		proxies:      map[string]*proxyProcess{},
+		stopping:     map[string]*proxyProcess{},
+	}, nil
+}
+
+// Close drains and stops the Envoy processes, and waits for them to exit.
+func (i *Infra) Close() {
+	i.cancel()
+
+	i.mu.Lock()
+	procs := make([]*proxyProcess, 0, len(i.proxies)+len(i.stopping))
+	for _, proc := range i.proxies {
+		procs = append(procs, proc)
+	}
+	for _, proc := range i.stopping {
+		procs = append(procs, proc)
+	}
+	i.mu.Unlock()
+
+	for _, proc := range procs {
+		<-proc.done
+	}
+}
+
+// createCertificates generates and stores the certificates used by Envoy
+// Gateway and Envoy to secure the xDS connection. Existing certificates are
+// kept, so that they can be rotated by replacing the files.
+func createCertificates(cfg *config.Server, paths *Paths) error {
+	if _, err := os.Stat(paths.TLSCaPath(EnvoyGatewayComponent)); err == nil {
+		return nil
+	}
+
+	certs, err := crypto.GenerateCerts(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to generate certificates: %w", err)
+	}
+
+	files := map[string][]byte{
+		paths.TLSCaPath(EnvoyComponent):          certs.CACertificate,
+		paths.TLSCertPath(EnvoyComponent):        certs.EnvoyCertificate,
+		paths.TLSKeyPath(EnvoyComponent):         certs.EnvoyPrivateKey,
+		paths.TLSCertPath(EnvoyGatewayComponent): certs.EnvoyGatewayCertificate,
+		paths.TLSKeyPath(EnvoyGatewayComponent):  certs.EnvoyGatewayPrivateKey,
+		// The CA is written last, as its presence marks the certificates as created.
+	}
+	for path, data := range files {
+		if err := writeFile(path, data); err != nil {
+			return err
+		}
+	}
+
+	return writeFile(paths.TLSCaPath(EnvoyGatewayComponent), certs.CACertificate)
+}
+
+// createSdsConfig stores the SDS files referenced by the Envoy bootstrap
+// configuration to load the xDS client certificate and trusted CA.
+func createSdsConfig(paths *Paths) error {
+	if err := writeFile(paths.SdsCAPath(), []byte(fmt.Sprintf(sdsCAData, paths.TLSCaPath(EnvoyComponent)))); err != nil {
+		return err
+	}
+
+	return writeFile(paths.SdsCertPath(), []byte(fmt.Sprintf(sdsCertData,
+		paths.TLSCertPath(EnvoyComponent), paths.TLSKeyPath(EnvoyComponent))))
+}
+
+// writeFile writes the data to the file, creating its parent directories.
+// Files are only readable by the current user, as they may contain private keys.
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package host
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// fakeEnvoy records its arguments, writes its base ID and, like Envoy, shuts
+// down its parent process once hot restarted.
+const fakeEnvoy = `#!/bin/sh
+dir=$(dirname "$0")
+echo "$@" >> "$dir/args"
+epoch=0
+while [ $# -gt 0 ]; do
+  case "$1" in
+  --base-id-path) echo 7 > "$2" ;;
+  --restart-epoch) epoch=$2 ;;
+  esac
+  shift
+done
+if [ "$epoch" -gt 0 ]; then kill "$(cat "$dir/pid.$((epoch - 1))")"; fi
+echo $$ > "$dir/pid.$epoch"
+exec sleep 60
+`
+
+func newTestInfra(t *testing.T) *Infra {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake envoy binary is a shell script")
+	}
+
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "envoy")
+	require.NoError(t, os.WriteFile(binary, []byte(fakeEnvoy), 0o700)) // #nosec G306
+
+	cfg, err := config.New()
+	require.NoError(t, err)
+	cfg.EnvoyGateway.Provider = &v1alpha1.EnvoyGatewayProvider{
+		Type: v1alpha1.ProviderTypeCustom,
+		Custom: &v1alpha1.EnvoyGatewayCustomProvider{
+			Resource: v1alpha1.EnvoyGatewayResourceProvider{
+				Type: v1alpha1.ResourceProviderTypeFile,
+				File: &v1alpha1.EnvoyGatewayFileResourceProvider{
+					Paths: []string{dir},
+				},
+			},
+			Infrastructure: v1alpha1.EnvoyGatewayInfrastructureProvider{
+				Type: v1alpha1.InfrastructureProviderTypeHost,
+				Host: &v1alpha1.EnvoyGatewayHostInfrastructureProvider{
+					ConfigHome:  ptr.To(filepath.Join(dir, "config")),
+					EnvoyBinary: ptr.To(binary),
+				},
+			},
+		},
+	}
+
+	infra, err := NewInfra(cfg)
+	require.NoError(t, err)
+	return infra
+}
+
+func newTestProxyInfra() *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.Name = "default/eg"
+	infra.Proxy.Config = &v1alpha1.EnvoyProxy{
+		Spec: v1alpha1.EnvoyProxySpec{
+			Shutdown: &v1alpha1.ShutdownConfig{
+				MinDrainDuration: &metav1.Duration{Duration: time.Millisecond},
+			},
+		},
+	}
+	return infra
+}
+
+func TestNewInfra(t *testing.T) {
+	infra := newTestInfra(t)
+
+	for _, component := range []string{EnvoyGatewayComponent, EnvoyComponent} {
+		require.FileExists(t, infra.Paths.TLSCaPath(component))
+		require.FileExists(t, infra.Paths.TLSCertPath(component))
+		require.FileExists(t, infra.Paths.TLSKeyPath(component))
+	}
+
+	sdsCA, err := os.ReadFile(infra.Paths.SdsCAPath())
+	require.NoError(t, err)
+	require.Contains(t, string(sdsCA), infra.Paths.TLSCaPath(EnvoyComponent))
+
+	sdsCert, err := os.ReadFile(infra.Paths.SdsCertPath())
+	require.NoError(t, err)
+	require.Contains(t, string(sdsCert), infra.Paths.TLSCertPath(EnvoyComponent))
+	require.Contains(t, string(sdsCert), infra.Paths.TLSKeyPath(EnvoyComponent))
+}
+
+func TestCreateOrUpdateProxyInfra(t *testing.T) {
+	infra := newTestInfra(t)
+	ctx := context.Background()
+	proxyInfra := newTestProxyInfra()
+	name := proxyInfra.Proxy.Name
+
+	require.NoError(t, infra.CreateOrUpdateProxyInfra(ctx, proxyInfra))
+	first := infra.proxies[name]
+	require.NotNil(t, first)
+	require.FileExists(t, infra.Paths.BootstrapPath(name))
+	require.Contains(t, first.args, infra.Paths.BootstrapPath(name))
+
+	bootstrap, err := os.ReadFile(infra.Paths.BootstrapPath(name))
+	require.NoError(t, err)
+	require.Contains(t, string(bootstrap), infra.Paths.SdsCertPath())
+
+	// An unchanged configuration keeps the running process.
+	require.NoError(t, infra.CreateOrUpdateProxyInfra(ctx, proxyInfra))
+	require.Same(t, first, infra.proxies[name])
+
+	// A changed configuration hot restarts the process on the same ports,
+	// once the running process wrote its base ID.
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(infra.Paths.BaseIDPath(name))
+		return first.running.Load() && err == nil
+	}, 30*time.Second, 100*time.Millisecond, "the envoy process did not start")
+	proxyInfra.Proxy.Config.Spec.Concurrency = ptr.To[int32](2)
+	require.NoError(t, infra.CreateOrUpdateProxyInfra(ctx, proxyInfra))
+	second := infra.proxies[name]
+	require.NotSame(t, first, second)
+	require.Equal(t, first.adminPort, second.adminPort)
+	require.Contains(t, second.args, "--concurrency")
+	require.Equal(t, 7, second.baseID)
+	require.Equal(t, int32(1), second.epoch.Load())
+	// The previous process exits once the new one took over.
+	require.Eventually(t, func() bool {
+		select {
+		case <-first.done:
+			return true
+		default:
+			return false
+		}
+	}, 30*time.Second, 100*time.Millisecond, "the previous envoy process is still running")
+
+	require.NoError(t, infra.DeleteProxyInfra(ctx, proxyInfra))
+	require.NotContains(t, infra.proxies, name)
+	require.NoDirExists(t, infra.Paths.ProxyDir(name))
+	select {
+	case <-second.done:
+	default:
+		t.Fatal("the envoy process is still running")
+	}
+
+	// Deleting an unknown proxy is a no-op.
+	require.NoError(t, infra.DeleteProxyInfra(ctx, proxyInfra))
+
+	args, err := os.ReadFile(filepath.Join(filepath.Dir(infra.EnvoyBinary), "args"))
+	require.NoError(t, err)
+	require.Contains(t, string(args), "--use-dynamic-base-id --base-id-path "+infra.Paths.BaseIDPath(name))
+	require.Contains(t, string(args), "--base-id 7 --restart-epoch 1")
+}
+
+func TestCreateOrUpdateProxyInfraCanceledContext(t *testing.T) {
+	infra := newTestInfra(t)
+	proxyInfra := newTestProxyInfra()
+
+	// The process outlives the context of the caller, until the infra is closed.
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, infra.CreateOrUpdateProxyInfra(ctx, proxyInfra))
+	cancel()
+	proc := infra.proxies[proxyInfra.Proxy.Name]
+	require.Eventually(t, proc.running.Load, 30*time.Second, 100*time.Millisecond, "the envoy process did not start")
+	require.Never(t, func() bool {
+		select {
+		case <-proc.done:
+			return true
+		default:
+			return false
+		}
+	}, 500*time.Millisecond, 100*time.Millisecond, "the envoy process was stopped")
+
+	infra.Close()
+	select {
+	case <-proc.done:
+	default:
+		t.Fatal("the envoy process is still running")
+	}
+}
+
+func TestRateLimitInfraUnsupported(t *testing.T) {
+	infra := &Infra{}
+	require.Error(t, infra.CreateOrUpdateRateLimitInfra(context.Background()))
+	require.Error(t, infra.DeleteRateLimitInfra(context.Background()))
+}
@@ -0,0 +1,120 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package host
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/utils"
+)
+
+const (
+	// EnvoyGatewayComponent is the name of the directory holding the
+	// certificates used by the Envoy Gateway xDS server.
+	EnvoyGatewayComponent = "envoy-gateway"
+	// EnvoyComponent is the name of the directory holding the certificates
+	// used by the managed Envoy processes.
+	EnvoyComponent = "envoy"
+
+	// TLSCertFilename is the name of the file containing a TLS certificate.
+	TLSCertFilename = "tls.crt"
+	// TLSKeyFilename is the name of the file containing a TLS private key.
+	TLSKeyFilename = "tls.key"
+	// TLSCaFilename is the name of the file containing the trusted CA certificate.
+	TLSCaFilename = "ca.crt"
+
+	// sdsCAFilename is the name of the SDS file for the xDS trusted CA.
+	sdsCAFilename = "xds-trusted-ca.json"
+	// sdsCertFilename is the name of the SDS file for the xDS client certificate.
+	sdsCertFilename = "xds-certificate.json"
+	// bootstrapFilename is the name of the Envoy bootstrap configuration file.
+	bootstrapFilename = "bootstrap.yaml"
+	// baseIDFilename is the name of the file Envoy writes its hot restart base ID to.
+	baseIDFilename = "base-id"
+)
+
+// Paths contains the locations of the files shared between Envoy Gateway
+// and the Envoy processes it runs on the host.
+type Paths struct {
+	// ConfigHome is the root directory of all the files.
+	ConfigHome string
+}
+
+// GetPaths returns the Paths for the Host infrastructure provider.
+// ConfigHome defaults to "$HOME/.config/envoy-gateway".
+func GetPaths(host *v1alpha1.EnvoyGatewayHostInfrastructureProvider) (*Paths, error) {
+	if host != nil && host.ConfigHome != nil && *host.ConfigHome != "" {
+		return &Paths{ConfigHome: *host.ConfigHome}, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine the config home: %w", err)
+	}
+
+	return &Paths{ConfigHome: filepath.Join(home, ".config", "envoy-gateway")}, nil
+}
+
+// CertDir returns the directory containing the certificates of the component.
+func (p *Paths) CertDir(component string) string {
+	return filepath.Join(p.ConfigHome, "certs", component)
+}
+
+// TLSCertPath returns the path of the TLS certificate of the component.
+func (p *Paths) TLSCertPath(component string) string {
+	return filepath.Join(p.CertDir(component), TLSCertFilename)
+}
+
+// TLSKeyPath returns the path of the TLS private key of the component.
+func (p *Paths) TLSKeyPath(component string) string {
+	return filepath.Join(p.CertDir(component), TLSKeyFilename)
+}
+
+// TLSCaPath returns the path of the CA certificate trusted by the component.
+func (p *Paths) TLSCaPath(component string) string {
+	return filepath.Join(p.CertDir(component), TLSCaFilename)
+}
+
+// SdsDir returns the directory containing the SDS files referenced by the
+// Envoy bootstrap configuration.
+func (p *Paths) SdsDir() string {
+	return filepath.Join(p.ConfigHome, "sds")
+}
+
+// SdsCAPath returns the path of the SDS file for the xDS trusted CA.
+func (p *Paths) SdsCAPath() string {
+	return filepath.Join(p.SdsDir(), sdsCAFilename)
+}
+
+// SdsCertPath returns the path of the SDS file for the xDS client certificate.
+func (p *Paths) SdsCertPath() string {
+	return filepath.Join(p.SdsDir(), sdsCertFilename)
+}
+
+// WasmCacheDir returns the directory the wasm modules pulled by Envoy Gateway
+// are cached in.
+func (p *Paths) WasmCacheDir() string {
+	return filepath.Join(p.ConfigHome, "wasm")
+}
+
+// ProxyDir returns the directory holding the files of the named proxy.
+func (p *Paths) ProxyDir(name string) string {
+	// Proxy names are in the form of "namespace/name".
+	return filepath.Join(p.ConfigHome, "proxies", utils.GetHashedName(name, 48))
+}
+
+// BootstrapPath returns the path of the bootstrap configuration of the named proxy.
+func (p *Paths) BootstrapPath(name string) string {
+	return filepath.Join(p.ProxyDir(name), bootstrapFilename)
+}
+
+// BaseIDPath returns the path of the hot restart base ID of the named proxy.
+func (p *Paths) BaseIDPath(name string) string {
+	return filepath.Join(p.ProxyDir(name), baseIDFilename)
+}
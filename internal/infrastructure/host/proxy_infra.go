@@ -0,0 +1,452 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package host
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/internal/xds/bootstrap"
+)
+
+const (
+	// localhost is the address used by Envoy to reach the xDS server, and
+	// by Envoy Gateway to reach the Envoy admin server.
+	localhost = "127.0.0.1"
+	// defaultMinDrainDuration is the minimum time given to the Envoy
+	// listeners to drain before the process is stopped.
+	defaultMinDrainDuration = 5 * time.Second
+	// terminateTimeout is the time given to Envoy to exit after being
+	// signaled, before it is killed.
+	terminateTimeout = 10 * time.Second
+	// defaultParentShutdown is the time after which the previous Envoy process
+	// exits once it is hot restarted, i.e. Envoy's default parent shutdown time.
+	defaultParentShutdown = 900 * time.Second
+	// minRestartBackoff and maxRestartBackoff bound the delay before an Envoy
+	// process that exited unexpectedly is restarted.
+	minRestartBackoff = time.Second
+	maxRestartBackoff = 30 * time.Second
+)
+
+// proxyProcess is an Envoy process supervised by Envoy Gateway.
+type proxyProcess struct {
+	args      []string
+	bootstrap string
+	adminPort int32
+	readyPort int32
+
+	minDrainDuration time.Duration
+	parentShutdown   time.Duration
+
+	// baseID and epoch are the hot restart base ID and epoch of the process.
+	// The process of epoch 0 allocates a base ID, which is shared by the
+	// processes hot restarted from it, each one with the next epoch.
+	baseID int
+	epoch  atomic.Int32
+	// running is true while the Envoy process is running.
+	running atomic.Bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	// handedOver is closed once the process is hot restarted, after which it
+	// drains its listeners, taken over by the new process, and exits.
+	handedOver     chan struct{}
+	handedOverOnce sync.Once
+}
+
+// CreateOrUpdateProxyInfra starts an Envoy process for the infra, or hot
+// restarts it if its configuration changed. The processes run until the
+// infra is deleted or the Infra is closed, regardless of ctx.
+func (i *Infra) CreateOrUpdateProxyInfra(_ context.Context, infra *ir.Infra) error {
+	if infra == nil {
+		return errors.New("infra ir is nil")
+	}
+
+	if infra.Proxy == nil {
+		return errors.New("infra proxy ir is nil")
+	}
+
+	proxyInfra := infra.GetProxyInfra()
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	current := i.proxies[proxyInfra.Name]
+
+	// Keep the ports of the running process, so that an unchanged
+	// configuration renders the same bootstrap.
+	var adminPort, readyPort int32
+	if current != nil {
+		adminPort, readyPort = current.adminPort, current.readyPort
+	} else {
+		var err error
+		if adminPort, err = freePort(); err != nil {
+			return err
+		}
+		if readyPort, err = freePort(); err != nil {
+			return err
+		}
+	}
+
+	proc, err := i.proxyProcess(proxyInfra, adminPort, readyPort)
+	if err != nil {
+		return err
+	}
+
+	if current != nil {
+		if slices.Equal(current.args, proc.args) && current.bootstrap == proc.bootstrap {
+			return nil
+		}
+		// The running process is hot restarted, so that the new process takes
+		// over its listeners without dropping the connections. A process which
+		// is not running is restarted, as there is nothing to hand over.
+		if baseID, err := readBaseID(i.Paths.BaseIDPath(proxyInfra.Name)); current.running.Load() && err == nil {
+			proc.baseID = baseID
+			proc.epoch.Store(current.epoch.Load() + 1)
+		}
+		i.logger.Info("restarting envoy with an updated configuration", "name", proxyInfra.Name,
+			"restartEpoch", proc.epoch.Load())
+	} else {
+		// The process of a proxy deleted in the meantime may still be draining.
+		current = i.stopping[proxyInfra.Name]
+	}
+
+	// The running process already loaded its bootstrap, so the file can be
+	// replaced before it exits.
+	if err := writeFile(i.Paths.BootstrapPath(proxyInfra.Name), []byte(proc.bootstrap)); err != nil {
+		return err
+	}
+
+	// The previous process is handed over or stopped by the supervisor of the
+	// new one, so that the infra isn't locked while it drains.
+	procCtx, cancel := context.WithCancel(i.ctx)
+	proc.cancel = cancel
+	proc.done = make(chan struct{})
+	proc.handedOver = make(chan struct{})
+	go i.supervise(procCtx, proxyInfra.Name, proc, current)
+
+	i.proxies[proxyInfra.Name] = proc
+	return nil
+}
+
+// DeleteProxyInfra drains and stops the Envoy process of the infra.
+func (i *Infra) DeleteProxyInfra(_ context.Context, infra *ir.Infra) error {
+	if infra == nil {
+		return errors.New("infra ir is nil")
+	}
+
+	name := infra.GetProxyInfra().Name
+
+	i.mu.Lock()
+	proc, ok := i.proxies[name]
+	if !ok {
+		i.mu.Unlock()
+		return nil
+	}
+	delete(i.proxies, name)
+	i.stopping[name] = proc
+	err := os.RemoveAll(i.Paths.ProxyDir(name))
+	i.mu.Unlock()
+
+	// Stop the process after releasing the lock, as draining takes a while.
+	proc.stop()
+
+	i.mu.Lock()
+	if i.stopping[name] == proc {
+		delete(i.stopping, name)
+	}
+	i.mu.Unlock()
+
+	if err != nil {
+		return fmt.Errorf("failed to remove files of proxy %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// proxyProcess renders the bootstrap configuration and the command line of
+// the Envoy process for the proxy infra.
+func (i *Infra) proxyProcess(infra *ir.ProxyInfra, adminPort, readyPort int32) (*proxyProcess, error) {
+	var proxyMetrics *v1alpha1.ProxyMetrics
+	if infra.Config != nil &&
+		infra.Config.Spec.Telemetry != nil {
+		proxyMetrics = infra.Config.Spec.Telemetry.Metrics
+	}
+
+	// Get the default Bootstrap
+	bootstrapConfigurations, err := bootstrap.GetRenderedBootstrapConfig(&bootstrap.RenderBootsrapConfigOptions{
+		ProxyMetrics:    proxyMetrics,
+		XdsServerHost:   ptr.To(localhost),
+		WasmServer:      infra.WasmServer,
+		AdminServerPort: ptr.To(adminPort),
+		ReadyServerPort: ptr.To(readyPort),
+		SdsConfig: &bootstrap.SdsConfigPath{
+			Certificate: i.Paths.SdsCertPath(),
+			TrustedCA:   i.Paths.SdsCAPath(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply Bootstrap from EnvoyProxy API if set by the user
+	// The config should have been validated already
+	if infra.Config != nil && infra.Config.Spec.Bootstrap != nil {
+		bootstrapConfigurations, err = bootstrap.ApplyBootstrapConfig(infra.Config.Spec.Bootstrap, bootstrapConfigurations)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logging := &v1alpha1.ProxyLogging{}
+	if infra.Config != nil {
+		logging = &infra.Config.Spec.Logging
+	}
+
+	args := []string{
+		"--config-path", i.Paths.BootstrapPath(infra.Name),
+		"--service-cluster", infra.Name,
+		"--service-node", infra.Name,
+		"--log-level", string(logging.DefaultEnvoyProxyLoggingLevel()),
+	}
+
+	if componentsLogLevel := logging.GetEnvoyProxyComponentLevel(); componentsLogLevel != "" {
+		args = append(args, "--component-log-level", componentsLogLevel)
+	}
+
+	minDrainDuration := defaultMinDrainDuration
+	parentShutdown := defaultParentShutdown
+	if infra.Config != nil {
+		if infra.Config.Spec.Concurrency != nil {
+			args = append(args, "--concurrency", strconv.Itoa(int(*infra.Config.Spec.Concurrency)))
+		}
+
+		if shutdown := infra.Config.Spec.Shutdown; shutdown != nil {
+			if shutdown.DrainTimeout != nil {
+				// The previous process exits once its listeners are drained
+				// when it is hot restarted.
+				parentShutdown = shutdown.DrainTimeout.Duration + terminateTimeout
+				args = append(args,
+					"--drain-time-s", fmt.Sprintf("%.0f", shutdown.DrainTimeout.Seconds()),
+					"--parent-shutdown-time-s", fmt.Sprintf("%.0f", parentShutdown.Seconds()))
+			}
+			if shutdown.MinDrainDuration != nil {
+				minDrainDuration = shutdown.MinDrainDuration.Duration
+			}
+		}
+
+		args = append(args, infra.Config.Spec.ExtraArgs...)
+	}
+
+	return &proxyProcess{
+		args:             args,
+		bootstrap:        bootstrapConfigurations,
+		adminPort:        adminPort,
+		readyPort:        readyPort,
+		minDrainDuration: minDrainDuration,
+		parentShutdown:   parentShutdown,
+	}, nil
+}
+
+// readBaseID reads the hot restart base ID written by Envoy.
+func readBaseID(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(data)))
+}
+
+// supervise hot restarts the previous process of the proxy, or stops it if the
+// process isn't hot restarted, then runs the Envoy process until ctx is done,
+// restarting it with an exponential backoff whenever it exits unexpectedly.
+func (i *Infra) supervise(ctx context.Context, name string, proc, prev *proxyProcess) {
+	defer close(proc.done)
+	logger := i.logger.WithValues("name", name)
+
+	// Both processes listen on the same ports, so the new process only starts
+	// once the previous one is stopped, unless it takes its listeners over.
+	hotRestart := prev != nil && proc.epoch.Load() > 0
+	if prev != nil && !hotRestart {
+		prev.stop()
+		if ctx.Err() != nil {
+			return
+		}
+	}
+
+	backoff := minRestartBackoff
+	for {
+		started := time.Now()
+		args := slices.Clone(proc.args)
+		if hotRestart {
+			args = append(args,
+				"--base-id", strconv.Itoa(proc.baseID),
+				"--restart-epoch", strconv.Itoa(int(proc.epoch.Load())))
+		} else {
+			args = append(args, "--use-dynamic-base-id", "--base-id-path", i.Paths.BaseIDPath(name))
+		}
+		cmd := exec.Command(i.EnvoyBinary, args...) // #nosec G204
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		if err := cmd.Start(); err != nil {
+			logger.Error(err, "failed to start envoy")
+		} else {
+			logger.Info("started envoy", "pid", cmd.Process.Pid, "adminPort", proc.adminPort,
+				"restartEpoch", proc.epoch.Load())
+			proc.running.Store(true)
+			if hotRestart {
+				prev.handOver()
+			}
+
+			exited := make(chan error, 1)
+			go func() {
+				exited <- cmd.Wait()
+			}()
+
+			select {
+			case <-ctx.Done():
+				proc.shutdown(logger, cmd, exited)
+				return
+			case <-proc.handedOver:
+				proc.waitHandedOver(ctx, logger, cmd, exited)
+				return
+			case err := <-exited:
+				proc.running.Store(false)
+				logger.Error(err, "envoy exited unexpectedly")
+			}
+		}
+
+		// The process is restarted with a new base ID, once the process it was
+		// hot restarted from, if any, is stopped.
+		if hotRestart {
+			prev.stop()
+			hotRestart = false
+			proc.epoch.Store(0)
+		}
+
+		// Reset the backoff if the process has been running for a while.
+		if time.Since(started) > maxRestartBackoff {
+			backoff = minRestartBackoff
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxRestartBackoff)
+	}
+}
+
+// stop stops the Envoy process and waits for it to exit. It is safe to call
+// it more than once, and concurrently.
+func (p *proxyProcess) stop() {
+	p.cancel()
+	<-p.done
+}
+
+// handOver signals the process that it was hot restarted. It is safe to call
+// it more than once.
+func (p *proxyProcess) handOver() {
+	p.handedOverOnce.Do(func() { close(p.handedOver) })
+}
+
+// shutdown gracefully drains the listeners of the Envoy process, then
+// terminates it, the same way the shutdown manager does in Kubernetes.
+func (p *proxyProcess) shutdown(logger logging.Logger, cmd *exec.Cmd, exited <-chan error) {
+	if err := p.drain(); err != nil {
+		logger.Error(err, "failed to drain envoy listeners")
+	} else {
+		select {
+		case <-exited:
+			return
+		case <-time.After(p.minDrainDuration):
+		}
+	}
+
+	terminate(logger, cmd, exited)
+}
+
+// waitHandedOver waits for the Envoy process to exit once it was hot restarted,
+// as the new process drains its listeners and shuts it down. It is terminated
+// if it does not exit in time, or once ctx is done.
+func (p *proxyProcess) waitHandedOver(ctx context.Context, logger logging.Logger, cmd *exec.Cmd, exited <-chan error) {
+	select {
+	case <-exited:
+		logger.Info("envoy exited after being hot restarted")
+		return
+	case <-ctx.Done():
+	case <-time.After(p.parentShutdown + terminateTimeout):
+		logger.Info("envoy did not exit after being hot restarted", "timeout", p.parentShutdown+terminateTimeout)
+	}
+
+	terminate(logger, cmd, exited)
+}
+
+// terminate signals the Envoy process to exit, and kills it if it does not
+// exit in time.
+func terminate(logger logging.Logger, cmd *exec.Cmd, exited <-chan error) {
+	if err := cmd.Process.Signal(syscall.SIGTERM); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		logger.Error(err, "failed to terminate envoy")
+	}
+
+	select {
+	case <-exited:
+	case <-time.After(terminateTimeout):
+		logger.Info("envoy did not exit in time, killing it", "timeout", terminateTimeout)
+		_ = cmd.Process.Kill()
+		<-exited
+	}
+	logger.Info("stopped envoy")
+}
+
+// drain fails the Envoy health check and starts draining its listeners
+// through the admin API.
+func (p *proxyProcess) drain() error {
+	client := &http.Client{Timeout: 5 * time.Second}
+	for _, path := range []string{"healthcheck/fail", "drain_listeners?graceful&skip_exit"} {
+		url := fmt.Sprintf("http://%s/%s", net.JoinHostPort(localhost, strconv.Itoa(int(p.adminPort))), path)
+		resp, err := client.Post(url, "application/json", bytes.NewReader(nil))
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("unexpected response status %s from %s", resp.Status, url)
+		}
+	}
+	return nil
+}
+
+// freePort returns a local port that is currently not in use.
+func freePort() (int32, error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(localhost, "0"))
+	if err != nil {
+		return 0, fmt.Errorf("failed to allocate a port: %w", err)
+	}
+	defer l.Close()
+
+	return int32(l.Addr().(*net.TCPAddr).Port), nil
+}
@@ -0,0 +1,25 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package host
+
+import (
+	"context"
+	"errors"
+)
+
+// errRateLimitUnsupported is returned because the rate limit service is not
+// managed by the Host infrastructure provider.
+var errRateLimitUnsupported = errors.New("global rate limit is not supported by the host infrastructure provider")
+
+// CreateOrUpdateRateLimitInfra is not supported by the Host infrastructure provider.
+func (i *Infra) CreateOrUpdateRateLimitInfra(context.Context) error {
+	return errRateLimitUnsupported
+}
+
+// DeleteRateLimitInfra is not supported by the Host infrastructure provider.
+func (i *Infra) DeleteRateLimitInfra(context.Context) error {
+	return errRateLimitUnsupported
+}
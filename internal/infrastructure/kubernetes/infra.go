@@ -8,25 +8,33 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
 )
 
 // ResourceRender renders Kubernetes infrastructure resources
 // based on Infra IR resources.
 type ResourceRender interface {
 	Name() string
+	Namespace() string
 	ServiceAccount() (*corev1.ServiceAccount, error)
 	Service() (*corev1.Service, error)
 	ConfigMap() (*corev1.ConfigMap, error)
 	Deployment() (*appsv1.Deployment, error)
+	DaemonSet() (*appsv1.DaemonSet, error)
 	HorizontalPodAutoscaler() (*autoscalingv2.HorizontalPodAutoscaler, error)
+	PodDisruptionBudget() (*policyv1.PodDisruptionBudget, error)
 }
 
 // Infra manages the creation and deletion of Kubernetes infrastructure
@@ -40,63 +48,102 @@ type Infra struct {
 
 	// Client wrap k8s client.
 	Client *InfraClient
+
+	// XdsServerHost is the address of the xDS server the Envoy proxies connect
+	// to, which is the replica of Envoy Gateway owning their Gateway when the
+	// Gateways are sharded, instead of the Envoy Gateway service, or the fully
+	// qualified name of the service when the proxies are deployed to the
+	// namespaces of the Gateways.
+	XdsServerHost *string
+
+	mu sync.Mutex
+	// xdsCertSecrets holds the proxies deployed to the namespaces of the Gateways,
+	// whose xDS client certificates are reissued when the CA is rotated.
+	xdsCertSecrets map[types.NamespacedName]*proxy.ResourceRender
 }
 
 // NewInfra returns a new Infra.
 func NewInfra(cli client.Client, cfg *config.Server) *Infra {
-	return &Infra{
-		Namespace:    cfg.Namespace,
-		EnvoyGateway: cfg.EnvoyGateway,
-		Client:       New(cli),
+	infra := &Infra{
+		Namespace:      cfg.Namespace,
+		EnvoyGateway:   cfg.EnvoyGateway,
+		Client:         New(cli),
+		xdsCertSecrets: map[types.NamespacedName]*proxy.ResourceRender{},
+	}
+	switch {
+	case cfg.EnvoyGateway.GetSharding() != nil:
+		infra.XdsServerHost = ptr.To(cfg.ReplicaAddress())
+	case cfg.EnvoyGateway.GatewayNamespaceMode():
+		// The proxies in the namespaces of the Gateways can't resolve the short
+		// name of the Envoy Gateway service.
+		infra.XdsServerHost = ptr.To(fmt.Sprintf("%s.%s.svc.%s",
+			config.EnvoyGatewayServiceName, cfg.Namespace, cfg.DNSDomain))
 	}
+	return infra
 }
 
-// createOrUpdate creates a ServiceAccount/ConfigMap/Deployment/Service in the kube api server based on the
+// createOrUpdate creates a ServiceAccount/ConfigMap/Deployment/DaemonSet/Service/HPA/PDB in the kube api server based on the
 // provided ResourceRender, if it doesn't exist and updates it if it does.
 func (i *Infra) createOrUpdate(ctx context.Context, r ResourceRender) error {
 	if err := i.createOrUpdateServiceAccount(ctx, r); err != nil {
-		return fmt.Errorf("failed to create or update serviceaccount %s/%s: %w", i.Namespace, r.Name(), err)
+		return fmt.Errorf("failed to create or update serviceaccount %s/%s: %w", r.Namespace(), r.Name(), err)
 	}
 
 	if err := i.createOrUpdateConfigMap(ctx, r); err != nil {
-		return fmt.Errorf("failed to create or update configmap %s/%s: %w", i.Namespace, r.Name(), err)
+		return fmt.Errorf("failed to create or update configmap %s/%s: %w", r.Namespace(), r.Name(), err)
 	}
 
 	if err := i.createOrUpdateDeployment(ctx, r); err != nil {
-		return fmt.Errorf("failed to create or update deployment %s/%s: %w", i.Namespace, r.Name(), err)
+		return fmt.Errorf("failed to create or update deployment %s/%s: %w", r.Namespace(), r.Name(), err)
+	}
+
+	if err := i.createOrUpdateDaemonSet(ctx, r); err != nil {
+		return fmt.Errorf("failed to create or update daemonset %s/%s: %w", r.Namespace(), r.Name(), err)
 	}
 
 	if err := i.createOrUpdateService(ctx, r); err != nil {
-		return fmt.Errorf("failed to create or update service %s/%s: %w", i.Namespace, r.Name(), err)
+		return fmt.Errorf("failed to create or update service %s/%s: %w", r.Namespace(), r.Name(), err)
 	}
 
 	if err := i.createOrUpdateHPA(ctx, r); err != nil {
-		return fmt.Errorf("failed to create or update hpa %s/%s: %w", i.Namespace, r.Name(), err)
+		return fmt.Errorf("failed to create or update hpa %s/%s: %w", r.Namespace(), r.Name(), err)
+	}
+
+	if err := i.createOrUpdatePDB(ctx, r); err != nil {
+		return fmt.Errorf("failed to create or update pdb %s/%s: %w", r.Namespace(), r.Name(), err)
 	}
 
 	return nil
 }
 
-// delete deletes the ServiceAccount/ConfigMap/Deployment/Service in the kube api server, if it exists.
+// delete deletes the ServiceAccount/ConfigMap/Deployment/DaemonSet/Service/HPA/PDB in the kube api server, if it exists.
 func (i *Infra) delete(ctx context.Context, r ResourceRender) error {
 	if err := i.deleteServiceAccount(ctx, r); err != nil {
-		return fmt.Errorf("failed to delete serviceaccount %s/%s: %w", i.Namespace, r.Name(), err)
+		return fmt.Errorf("failed to delete serviceaccount %s/%s: %w", r.Namespace(), r.Name(), err)
 	}
 
 	if err := i.deleteConfigMap(ctx, r); err != nil {
-		return fmt.Errorf("failed to delete configmap %s/%s: %w", i.Namespace, r.Name(), err)
+		return fmt.Errorf("failed to delete configmap %s/%s: %w", r.Namespace(), r.Name(), err)
 	}
 
 	if err := i.deleteDeployment(ctx, r); err != nil {
-		return fmt.Errorf("failed to delete deployment %s/%s: %w", i.Namespace, r.Name(), err)
+		return fmt.Errorf("failed to delete deployment %s/%s: %w", r.Namespace(), r.Name(), err)
+	}
+
+	if err := i.deleteDaemonSet(ctx, r); err != nil {
+		return fmt.Errorf("failed to delete daemonset %s/%s: %w", r.Namespace(), r.Name(), err)
 	}
 
 	if err := i.deleteService(ctx, r); err != nil {
-		return fmt.Errorf("failed to delete service %s/%s: %w", i.Namespace, r.Name(), err)
+		return fmt.Errorf("failed to delete service %s/%s: %w", r.Namespace(), r.Name(), err)
 	}
 
 	if err := i.deleteHPA(ctx, r); err != nil {
-		return fmt.Errorf("failed to delete hpa %s/%s: %w", i.Namespace, r.Name(), err)
+		return fmt.Errorf("failed to delete hpa %s/%s: %w", r.Namespace(), r.Name(), err)
+	}
+
+	if err := i.deletePDB(ctx, r); err != nil {
+		return fmt.Errorf("failed to delete pdb %s/%s: %w", r.Namespace(), r.Name(), err)
 	}
 
 	return nil
@@ -44,6 +44,19 @@ func (cli *InfraClient) Delete(ctx context.Context, object client.Object) error
 	return nil
 }
 
+// DeleteIfExists deletes the object only if it exists, so that no delete request
+// is sent for an object which is usually absent, e.g. on every reconcile.
+func (cli *InfraClient) DeleteIfExists(ctx context.Context, object client.Object) error {
+	if err := cli.Client.Get(ctx, client.ObjectKeyFromObject(object), object); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return cli.Delete(ctx, object)
+}
+
 // GetUID retrieves the uid of one resource.
 func (cli *InfraClient) GetUID(ctx context.Context, key client.ObjectKey, current client.Object) (types.UID, error) {
 	if err := cli.Client.Get(ctx, key, current); err != nil {
@@ -11,6 +11,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -41,14 +42,55 @@ func (i *Infra) createOrUpdateConfigMap(ctx context.Context, r ResourceRender) e
 }
 
 // createOrUpdateDeployment creates a Deployment in the kube api server based on the provided
-// ResourceRender, if it doesn't exist and updates it if it does.
+// ResourceRender, if it doesn't exist and updates it if it does. The DaemonSet it replaces,
+// if any, is deleted once it's applied.
 func (i *Infra) createOrUpdateDeployment(ctx context.Context, r ResourceRender) error {
 	deployment, err := r.Deployment()
 	if err != nil {
 		return err
 	}
 
-	return i.Client.ServerSideApply(ctx, deployment)
+	// when the pods are run by a DaemonSet, the Deployment is not rendered.
+	if deployment == nil {
+		return nil
+	}
+
+	if err := i.Client.ServerSideApply(ctx, deployment); err != nil {
+		return err
+	}
+
+	return i.Client.DeleteIfExists(ctx, &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace(),
+			Name:      r.Name(),
+		},
+	})
+}
+
+// createOrUpdateDaemonSet creates a DaemonSet in the kube api server based on the provided
+// ResourceRender, if it doesn't exist and updates it if it does. The Deployment it replaces,
+// if any, is deleted once it's applied.
+func (i *Infra) createOrUpdateDaemonSet(ctx context.Context, r ResourceRender) error {
+	daemonSet, err := r.DaemonSet()
+	if err != nil {
+		return err
+	}
+
+	// when the pods are run by a Deployment, the DaemonSet is not rendered.
+	if daemonSet == nil {
+		return nil
+	}
+
+	if err := i.Client.ServerSideApply(ctx, daemonSet); err != nil {
+		return err
+	}
+
+	return i.Client.DeleteIfExists(ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace(),
+			Name:      r.Name(),
+		},
+	})
 }
 
 // createOrUpdateHPA creates HorizontalPodAutoscaler object in the kube api server based on
@@ -69,6 +111,24 @@ func (i *Infra) createOrUpdateHPA(ctx context.Context, r ResourceRender) error {
 	return i.Client.ServerSideApply(ctx, hpa)
 }
 
+// createOrUpdatePDB creates PodDisruptionBudget object in the kube api server based on
+// the provided ResourceRender, if it doesn't exist and updates it if it does,
+// and delete pdb if not set.
+func (i *Infra) createOrUpdatePDB(ctx context.Context, r ResourceRender) error {
+	pdb, err := r.PodDisruptionBudget()
+	if err != nil {
+		return err
+	}
+
+	// when PodDisruptionBudget is not set,
+	// then delete the object in the kube api server if any.
+	if pdb == nil {
+		return i.deletePDB(ctx, r)
+	}
+
+	return i.Client.ServerSideApply(ctx, pdb)
+}
+
 // createOrUpdateRateLimitService creates a Service in the kube api server based on the provided ResourceRender,
 // if it doesn't exist or updates it if it does.
 func (i *Infra) createOrUpdateService(ctx context.Context, r ResourceRender) error {
@@ -84,7 +144,7 @@ func (i *Infra) createOrUpdateService(ctx context.Context, r ResourceRender) err
 func (i *Infra) deleteServiceAccount(ctx context.Context, r ResourceRender) error {
 	sa := &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: i.Namespace,
+			Namespace: r.Namespace(),
 			Name:      r.Name(),
 		},
 	}
@@ -96,7 +156,7 @@ func (i *Infra) deleteServiceAccount(ctx context.Context, r ResourceRender) erro
 func (i *Infra) deleteDeployment(ctx context.Context, r ResourceRender) error {
 	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: i.Namespace,
+			Namespace: r.Namespace(),
 			Name:      r.Name(),
 		},
 	}
@@ -104,11 +164,23 @@ func (i *Infra) deleteDeployment(ctx context.Context, r ResourceRender) error {
 	return i.Client.Delete(ctx, deployment)
 }
 
+// deleteDaemonSet deletes the Envoy DaemonSet in the kube api server, if it exists.
+func (i *Infra) deleteDaemonSet(ctx context.Context, r ResourceRender) error {
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace(),
+			Name:      r.Name(),
+		},
+	}
+
+	return i.Client.Delete(ctx, daemonSet)
+}
+
 // deleteConfigMap deletes the ConfigMap in the kube api server, if it exists.
 func (i *Infra) deleteConfigMap(ctx context.Context, r ResourceRender) error {
 	cm := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: i.Namespace,
+			Namespace: r.Namespace(),
 			Name:      r.Name(),
 		},
 	}
@@ -120,7 +192,7 @@ func (i *Infra) deleteConfigMap(ctx context.Context, r ResourceRender) error {
 func (i *Infra) deleteService(ctx context.Context, r ResourceRender) error {
 	svc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: i.Namespace,
+			Namespace: r.Namespace(),
 			Name:      r.Name(),
 		},
 	}
@@ -132,10 +204,22 @@ func (i *Infra) deleteService(ctx context.Context, r ResourceRender) error {
 func (i *Infra) deleteHPA(ctx context.Context, r ResourceRender) error {
 	hpa := &autoscalingv2.HorizontalPodAutoscaler{
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: i.Namespace,
+			Namespace: r.Namespace(),
 			Name:      r.Name(),
 		},
 	}
 
 	return i.Client.Delete(ctx, hpa)
 }
+
+// deletePDB deletes the Pod Disruption Budget associated to its renderer, if it exists.
+func (i *Infra) deletePDB(ctx context.Context, r ResourceRender) error {
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace(),
+			Name:      r.Name(),
+		},
+	}
+
+	return i.Client.Delete(ctx, pdb)
+}
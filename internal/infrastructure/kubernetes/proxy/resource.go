@@ -35,6 +35,13 @@ const (
 	// XdsTLSCaFilename is the fully qualified path of the file containing Envoy's
 	// trusted CA certificate.
 	XdsTLSCaFilename = "/certs/ca.crt"
+	// XdsCertSecretName is the name of the Secret holding Envoy's xDS client
+	// certificate in the Envoy Gateway namespace.
+	XdsCertSecretName = "envoy"
+	// XdsCASecretName is the name of the Secret holding the CA of the xDS
+	// certificates in the Envoy Gateway namespace, which issues the xDS client
+	// certificates of the proxies deployed to the namespaces of the Gateways.
+	XdsCASecretName = "envoy-gateway-ca"
 	// envoyContainerName is the name of the Envoy container.
 	envoyContainerName = "envoy"
 	// envoyNsEnvVar is the name of the Envoy Gateway namespace environment variable.
@@ -101,8 +108,9 @@ func enablePrometheus(infra *ir.ProxyInfra) bool {
 
 // expectedProxyContainers returns expected proxy containers.
 func expectedProxyContainers(infra *ir.ProxyInfra,
-	deploymentConfig *egv1a1.KubernetesDeploymentSpec,
-	shutdownConfig *egv1a1.ShutdownConfig) ([]corev1.Container, error) {
+	containerSpec *egv1a1.KubernetesContainerSpec,
+	shutdownConfig *egv1a1.ShutdownConfig,
+	xdsServerHost *string) ([]corev1.Container, error) {
 	// Define slice to hold container ports
 	var ports []corev1.ContainerPort
 
@@ -144,12 +152,14 @@ func expectedProxyContainers(infra *ir.ProxyInfra,
 		proxyMetrics = infra.Config.Spec.Telemetry.Metrics
 	}
 
-	maxHeapSizeBytes := caclulateMaxHeapSizeBytes(deploymentConfig.Container.Resources)
+	maxHeapSizeBytes := caclulateMaxHeapSizeBytes(containerSpec.Resources)
 
 	// Get the default Bootstrap
 	bootstrapConfigurations, err := bootstrap.GetRenderedBootstrapConfig(&bootstrap.RenderBootsrapConfigOptions{
 		ProxyMetrics:     proxyMetrics,
 		MaxHeapSizeBytes: maxHeapSizeBytes,
+		XdsServerHost:    xdsServerHost,
+		WasmServer:       infra.WasmServer,
 	})
 	if err != nil {
 		return nil, err
@@ -194,15 +204,15 @@ func expectedProxyContainers(infra *ir.ProxyInfra,
 	containers := []corev1.Container{
 		{
 			Name:                     envoyContainerName,
-			Image:                    *deploymentConfig.Container.Image,
+			Image:                    *containerSpec.Image,
 			ImagePullPolicy:          corev1.PullIfNotPresent,
 			Command:                  []string{"envoy"},
 			Args:                     args,
-			Env:                      expectedContainerEnv(deploymentConfig.Container),
-			Resources:                *deploymentConfig.Container.Resources,
-			SecurityContext:          deploymentConfig.Container.SecurityContext,
+			Env:                      expectedContainerEnv(containerSpec),
+			Resources:                *containerSpec.Resources,
+			SecurityContext:          containerSpec.SecurityContext,
 			Ports:                    ports,
-			VolumeMounts:             expectedContainerVolumeMounts(deploymentConfig.Container),
+			VolumeMounts:             expectedContainerVolumeMounts(containerSpec),
 			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
 			TerminationMessagePath:   "/dev/termination-log",
 			ReadinessProbe: &corev1.Probe{
@@ -327,14 +337,14 @@ func expectedContainerVolumeMounts(containerSpec *egv1a1.KubernetesContainerSpec
 	return resource.ExpectedContainerVolumeMounts(containerSpec, volumeMounts)
 }
 
-// expectedDeploymentVolumes returns expected proxy deployment volumes.
-func expectedDeploymentVolumes(name string, deploymentSpec *egv1a1.KubernetesDeploymentSpec) []corev1.Volume {
+// expectedVolumes returns expected proxy pod volumes.
+func expectedVolumes(name, certSecretName string, podSpec *egv1a1.KubernetesPodSpec) []corev1.Volume {
 	volumes := []corev1.Volume{
 		{
 			Name: "certs",
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName:  "envoy",
+					SecretName:  certSecretName,
 					DefaultMode: ptr.To[int32](420),
 				},
 			},
@@ -363,7 +373,7 @@ func expectedDeploymentVolumes(name string, deploymentSpec *egv1a1.KubernetesDep
 		},
 	}
 
-	return resource.ExpectedDeploymentVolumes(deploymentSpec.Pod, volumes)
+	return resource.ExpectedDeploymentVolumes(podSpec, volumes)
 }
 
 // expectedContainerEnv returns expected proxy container envs.
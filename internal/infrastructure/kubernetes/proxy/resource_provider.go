@@ -13,8 +13,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -27,13 +29,21 @@ import (
 type ResourceRender struct {
 	infra *ir.ProxyInfra
 
-	// Namespace is the Namespace used for managed infra.
-	Namespace string
+	// namespace is the Namespace used for managed infra.
+	namespace string
+
+	// XdsServerHost overrides the address of the xDS server in the bootstrap
+	// configuration of the proxy.
+	XdsServerHost *string
+
+	// XdsCertSecretName overrides the name of the Secret holding the xDS client
+	// certificate of the proxy.
+	XdsCertSecretName *string
 }
 
 func NewResourceRender(ns string, infra *ir.ProxyInfra) *ResourceRender {
 	return &ResourceRender{
-		Namespace: ns,
+		namespace: ns,
 		infra:     infra,
 	}
 }
@@ -42,6 +52,10 @@ func (r *ResourceRender) Name() string {
 	return ExpectedResourceHashedName(r.infra.Name)
 }
 
+func (r *ResourceRender) Namespace() string {
+	return r.namespace
+}
+
 // ServiceAccount returns the expected proxy serviceAccount.
 func (r *ResourceRender) ServiceAccount() (*corev1.ServiceAccount, error) {
 	// Set the labels based on the owning gateway name.
@@ -56,7 +70,7 @@ func (r *ResourceRender) ServiceAccount() (*corev1.ServiceAccount, error) {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace:   r.Namespace,
+			Namespace:   r.namespace,
 			Name:        r.Name(),
 			Labels:      labels,
 			Annotations: r.infra.GetProxyMetadata().Annotations,
@@ -64,11 +78,84 @@ func (r *ResourceRender) ServiceAccount() (*corev1.ServiceAccount, error) {
 	}, nil
 }
 
+// XdsCertSecret returns the expected Secret holding the xDS client certificate
+// issued for the namespace of the proxy, along with the trusted CA certificate,
+// when the proxy is deployed to another namespace than Envoy Gateway.
+func (r *ResourceRender) XdsCertSecret(caCert, cert, key []byte) (*corev1.Secret, error) {
+	// Set the labels based on the owning gateway name.
+	labels := envoyLabels(r.infra.GetProxyMetadata().Labels)
+	if OwningGatewayLabelsAbsent(labels) {
+		return nil, fmt.Errorf("missing owning gateway labels")
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.namespace,
+			Name:      ptr.Deref(r.XdsCertSecretName, XdsCertSecretName),
+			Labels:    labels,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			"ca.crt":                caCert,
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+		},
+	}, nil
+}
+
 // Service returns the expected Service based on the provided infra.
 func (r *ResourceRender) Service() (*corev1.Service, error) {
+	provider := r.infra.GetProxyConfig().GetEnvoyProxyProvider()
+	envoyServiceConfig := provider.GetEnvoyProxyKubeProvider().EnvoyService
+	return r.service(r.Name(), envoyServiceConfig, nil, r.infra.Addresses)
+}
+
+// AdditionalServices returns the expected additional Services based on the provided infra.
+func (r *ResourceRender) AdditionalServices() ([]*corev1.Service, error) {
+	provider := r.infra.GetProxyConfig().GetEnvoyProxyProvider()
+	additionalServiceConfigs := provider.GetEnvoyProxyKubeProvider().EnvoyAdditionalServices
+
+	svcs := make([]*corev1.Service, 0, len(additionalServiceConfigs))
+	for i := range additionalServiceConfigs {
+		serviceConfig := &additionalServiceConfigs[i]
+		ports := sets.New[int32]()
+		for _, port := range serviceConfig.Ports {
+			ports.Insert(int32(port))
+		}
+
+		svc, err := r.service(r.AdditionalServiceName(serviceConfig.Name), &serviceConfig.KubernetesServiceSpec, ports, nil)
+		if err != nil {
+			return nil, err
+		}
+		svcs = append(svcs, svc)
+	}
+
+	return svcs, nil
+}
+
+// AdditionalServiceName returns the name of the additional Service identified by name.
+func (r *ResourceRender) AdditionalServiceName(name string) string {
+	// Gateway names can't contain a "/", so the name can't collide with the
+	// name of the resources of another Gateway.
+	return ExpectedResourceHashedName(fmt.Sprintf("%s/service/%s", r.infra.Name, name))
+}
+
+// service returns the expected Service named name, exposing the listener ports in
+// selectedPorts, or all the listener ports if empty, on the provided addresses.
+func (r *ResourceRender) service(name string, envoyServiceConfig *egv1a1.KubernetesServiceSpec,
+	selectedPorts sets.Set[int32], addresses []string,
+) (*corev1.Service, error) {
 	var ports []corev1.ServicePort
 	for _, listener := range r.infra.Listeners {
 		for _, port := range listener.Ports {
+			if selectedPorts.Len() > 0 && !selectedPorts.Has(port.ServicePort) {
+				continue
+			}
+
 			target := intstr.IntOrString{IntVal: port.ContainerPort}
 			protocol := corev1.ProtocolTCP
 			if port.Protocol == ir.UDPProtocolType {
@@ -106,9 +193,6 @@ func (r *ResourceRender) Service() (*corev1.Service, error) {
 	// Get annotations
 	annotations := map[string]string{}
 	maps.Copy(annotations, r.infra.GetProxyMetadata().Annotations)
-
-	provider := r.infra.GetProxyConfig().GetEnvoyProxyProvider()
-	envoyServiceConfig := provider.GetEnvoyProxyKubeProvider().EnvoyService
 	if envoyServiceConfig.Annotations != nil {
 		maps.Copy(annotations, envoyServiceConfig.Annotations)
 	}
@@ -122,15 +206,15 @@ func (r *ResourceRender) Service() (*corev1.Service, error) {
 	serviceSpec.Selector = resource.GetSelector(labels).MatchLabels
 
 	if (*envoyServiceConfig.Type) == egv1a1.ServiceTypeClusterIP {
-		if len(r.infra.Addresses) > 0 {
+		if len(addresses) > 0 {
 			// Since K8s Service requires specify no more than one IP for each IP family
 			// So we only use the first address
 			// if address is not set, the automatically assigned clusterIP is used
-			serviceSpec.ClusterIP = r.infra.Addresses[0]
-			serviceSpec.ClusterIPs = r.infra.Addresses[0:1]
+			serviceSpec.ClusterIP = addresses[0]
+			serviceSpec.ClusterIPs = addresses[0:1]
 		}
 	} else {
-		serviceSpec.ExternalIPs = r.infra.Addresses
+		serviceSpec.ExternalIPs = addresses
 	}
 
 	svc := &corev1.Service{
@@ -139,8 +223,8 @@ func (r *ResourceRender) Service() (*corev1.Service, error) {
 			Kind:       "Service",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace:   r.Namespace,
-			Name:        r.Name(),
+			Namespace:   r.namespace,
+			Name:        name,
 			Labels:      labels,
 			Annotations: annotations,
 		},
@@ -170,7 +254,7 @@ func (r *ResourceRender) ConfigMap() (*corev1.ConfigMap, error) {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace:   r.Namespace,
+			Namespace:   r.namespace,
 			Name:        r.Name(),
 			Labels:      labels,
 			Annotations: r.infra.GetProxyMetadata().Annotations,
@@ -182,7 +266,8 @@ func (r *ResourceRender) ConfigMap() (*corev1.ConfigMap, error) {
 	}, nil
 }
 
-// Deployment returns the expected Deployment based on the provided infra.
+// Deployment returns the expected Deployment based on the provided infra,
+// or nil if the proxy is run by a DaemonSet.
 func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 	proxyConfig := r.infra.GetProxyConfig()
 
@@ -192,76 +277,31 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 		return nil, fmt.Errorf("invalid provider type %v for Kubernetes infra manager", provider.Type)
 	}
 	deploymentConfig := provider.GetEnvoyProxyKubeProvider().EnvoyDeployment
+	if deploymentConfig == nil {
+		return nil, nil
+	}
 
-	// Get expected bootstrap configurations rendered ProxyContainers
-	containers, err := expectedProxyContainers(r.infra, deploymentConfig, proxyConfig.Spec.Shutdown)
+	dpLabels, selector, template, err := r.podTemplate(deploymentConfig.Pod, deploymentConfig.Container, deploymentConfig.InitContainers)
 	if err != nil {
 		return nil, err
 	}
 
-	// Set the labels based on the owning gateway name.
-	dpAnnotations := r.infra.GetProxyMetadata().Annotations
-	labels := r.infra.GetProxyMetadata().Labels
-	dpLabels := envoyLabels(labels)
-	if OwningGatewayLabelsAbsent(dpLabels) {
-		return nil, fmt.Errorf("missing owning gateway labels")
-	}
-
-	maps.Copy(labels, deploymentConfig.Pod.Labels)
-	podLabels := envoyLabels(labels)
-	selector := resource.GetSelector(podLabels)
-
-	// Get annotations
-	podAnnotations := map[string]string{}
-	maps.Copy(podAnnotations, dpAnnotations)
-	maps.Copy(podAnnotations, deploymentConfig.Pod.Annotations)
-	if enablePrometheus(r.infra) {
-		podAnnotations["prometheus.io/path"] = "/stats/prometheus" // TODO: make this configurable
-		podAnnotations["prometheus.io/scrape"] = "true"
-		podAnnotations["prometheus.io/port"] = strconv.Itoa(bootstrap.EnvoyReadinessPort)
-	}
-	if len(podAnnotations) == 0 {
-		podAnnotations = nil
-	}
-
 	deployment := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Deployment",
 			APIVersion: "apps/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace:   r.Namespace,
+			Namespace:   r.namespace,
 			Name:        r.Name(),
 			Labels:      dpLabels,
-			Annotations: dpAnnotations,
+			Annotations: r.infra.GetProxyMetadata().Annotations,
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: deploymentConfig.Replicas,
-			Strategy: *deploymentConfig.Strategy,
-			Selector: selector,
-			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels:      selector.MatchLabels,
-					Annotations: podAnnotations,
-				},
-				Spec: corev1.PodSpec{
-					Containers:                    containers,
-					InitContainers:                deploymentConfig.InitContainers,
-					ServiceAccountName:            ExpectedResourceHashedName(r.infra.Name),
-					AutomountServiceAccountToken:  ptr.To(false),
-					TerminationGracePeriodSeconds: expectedTerminationGracePeriodSeconds(proxyConfig.Spec.Shutdown),
-					DNSPolicy:                     corev1.DNSClusterFirst,
-					RestartPolicy:                 corev1.RestartPolicyAlways,
-					SchedulerName:                 "default-scheduler",
-					SecurityContext:               deploymentConfig.Pod.SecurityContext,
-					Affinity:                      deploymentConfig.Pod.Affinity,
-					Tolerations:                   deploymentConfig.Pod.Tolerations,
-					Volumes:                       expectedDeploymentVolumes(r.infra.Name, deploymentConfig),
-					ImagePullSecrets:              deploymentConfig.Pod.ImagePullSecrets,
-					NodeSelector:                  deploymentConfig.Pod.NodeSelector,
-					TopologySpreadConstraints:     deploymentConfig.Pod.TopologySpreadConstraints,
-				},
-			},
+			Replicas:                deploymentConfig.Replicas,
+			Strategy:                *deploymentConfig.Strategy,
+			Selector:                selector,
+			Template:                template,
 			RevisionHistoryLimit:    ptr.To[int32](10),
 			ProgressDeadlineSeconds: ptr.To[int32](600),
 		},
@@ -280,6 +320,117 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 	return deployment, nil
 }
 
+// DaemonSet returns the expected DaemonSet based on the provided infra,
+// or nil if the proxy is run by a Deployment.
+func (r *ResourceRender) DaemonSet() (*appsv1.DaemonSet, error) {
+	proxyConfig := r.infra.GetProxyConfig()
+
+	// Get the EnvoyProxy config to configure the daemonset.
+	provider := proxyConfig.GetEnvoyProxyProvider()
+	if provider.Type != egv1a1.ProviderTypeKubernetes {
+		return nil, fmt.Errorf("invalid provider type %v for Kubernetes infra manager", provider.Type)
+	}
+	daemonSetConfig := provider.GetEnvoyProxyKubeProvider().EnvoyDaemonSet
+	if daemonSetConfig == nil {
+		return nil, nil
+	}
+
+	dsLabels, selector, template, err := r.podTemplate(daemonSetConfig.Pod, daemonSetConfig.Container, daemonSetConfig.InitContainers)
+	if err != nil {
+		return nil, err
+	}
+
+	daemonSet := &appsv1.DaemonSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DaemonSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   r.namespace,
+			Name:        r.Name(),
+			Labels:      dsLabels,
+			Annotations: r.infra.GetProxyMetadata().Annotations,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector:             selector,
+			Template:             template,
+			UpdateStrategy:       *daemonSetConfig.Strategy,
+			RevisionHistoryLimit: ptr.To[int32](10),
+		},
+	}
+
+	// apply merge patch to daemonset
+	if daemonSet, err = daemonSetConfig.ApplyMergePatch(daemonSet); err != nil {
+		return nil, err
+	}
+
+	return daemonSet, nil
+}
+
+// podTemplate returns the labels, the pod selector and the pod template of
+// the Deployment or DaemonSet running the proxy.
+func (r *ResourceRender) podTemplate(podConfig *egv1a1.KubernetesPodSpec, containerConfig *egv1a1.KubernetesContainerSpec,
+	initContainers []corev1.Container) (map[string]string, *metav1.LabelSelector, corev1.PodTemplateSpec, error) {
+	proxyConfig := r.infra.GetProxyConfig()
+
+	// Get expected bootstrap configurations rendered ProxyContainers
+	containers, err := expectedProxyContainers(r.infra, containerConfig, proxyConfig.Spec.Shutdown, r.XdsServerHost)
+	if err != nil {
+		return nil, nil, corev1.PodTemplateSpec{}, err
+	}
+
+	// Set the labels based on the owning gateway name.
+	annotations := r.infra.GetProxyMetadata().Annotations
+	labels := r.infra.GetProxyMetadata().Labels
+	workloadLabels := envoyLabels(labels)
+	if OwningGatewayLabelsAbsent(workloadLabels) {
+		return nil, nil, corev1.PodTemplateSpec{}, fmt.Errorf("missing owning gateway labels")
+	}
+
+	maps.Copy(labels, podConfig.Labels)
+	podLabels := envoyLabels(labels)
+	selector := resource.GetSelector(podLabels)
+
+	// Get annotations
+	podAnnotations := map[string]string{}
+	maps.Copy(podAnnotations, annotations)
+	maps.Copy(podAnnotations, podConfig.Annotations)
+	if enablePrometheus(r.infra) {
+		podAnnotations["prometheus.io/path"] = "/stats/prometheus" // TODO: make this configurable
+		podAnnotations["prometheus.io/scrape"] = "true"
+		podAnnotations["prometheus.io/port"] = strconv.Itoa(bootstrap.EnvoyReadinessPort)
+	}
+	if len(podAnnotations) == 0 {
+		podAnnotations = nil
+	}
+
+	template := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      selector.MatchLabels,
+			Annotations: podAnnotations,
+		},
+		Spec: corev1.PodSpec{
+			Containers:                    containers,
+			InitContainers:                initContainers,
+			ServiceAccountName:            ExpectedResourceHashedName(r.infra.Name),
+			AutomountServiceAccountToken:  ptr.To(false),
+			TerminationGracePeriodSeconds: expectedTerminationGracePeriodSeconds(proxyConfig.Spec.Shutdown),
+			DNSPolicy:                     corev1.DNSClusterFirst,
+			RestartPolicy:                 corev1.RestartPolicyAlways,
+			SchedulerName:                 "default-scheduler",
+			SecurityContext:               podConfig.SecurityContext,
+			Affinity:                      podConfig.Affinity,
+			Tolerations:                   podConfig.Tolerations,
+			Volumes:                       expectedVolumes(r.infra.Name, ptr.Deref(r.XdsCertSecretName, XdsCertSecretName), podConfig),
+			ImagePullSecrets:              podConfig.ImagePullSecrets,
+			NodeSelector:                  podConfig.NodeSelector,
+			TopologySpreadConstraints:     podConfig.TopologySpreadConstraints,
+		},
+	}
+
+	return workloadLabels, selector, template, nil
+}
+
 func expectedTerminationGracePeriodSeconds(cfg *egv1a1.ShutdownConfig) *int64 {
 	s := 900 // default
 	if cfg != nil && cfg.DrainTimeout != nil {
@@ -305,7 +456,7 @@ func (r *ResourceRender) HorizontalPodAutoscaler() (*autoscalingv2.HorizontalPod
 			Kind:       "HorizontalPodAutoscaler",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace:   r.Namespace,
+			Namespace:   r.namespace,
 			Name:        r.Name(),
 			Annotations: r.infra.GetProxyMetadata().Annotations,
 			Labels:      r.infra.GetProxyMetadata().Labels,
@@ -326,6 +477,61 @@ func (r *ResourceRender) HorizontalPodAutoscaler() (*autoscalingv2.HorizontalPod
 	return hpa, nil
 }
 
+// PodDisruptionBudget returns the expected PodDisruptionBudget based on the provided infra.
+func (r *ResourceRender) PodDisruptionBudget() (*policyv1.PodDisruptionBudget, error) {
+	provider := r.infra.GetProxyConfig().GetEnvoyProxyProvider()
+	if provider.Type != egv1a1.ProviderTypeKubernetes {
+		return nil, fmt.Errorf("invalid provider type %v for Kubernetes infra manager", provider.Type)
+	}
+
+	pdbConfig := provider.GetEnvoyProxyKubeProvider().EnvoyPDB
+	if pdbConfig == nil {
+		return nil, nil
+	}
+
+	// Set the labels based on the owning gateway name.
+	labels := envoyLabels(r.infra.GetProxyMetadata().Labels)
+	if OwningGatewayLabelsAbsent(labels) {
+		return nil, fmt.Errorf("missing owning gateway labels")
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "policy/v1",
+			Kind:       "PodDisruptionBudget",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   r.namespace,
+			Name:        r.Name(),
+			Annotations: r.infra.GetProxyMetadata().Annotations,
+			Labels:      labels,
+		},
+		Spec: resource.ExpectedPodDisruptionBudgetSpec(pdbConfig, labels),
+	}
+
+	return pdb, nil
+}
+
+// OwningGatewayLabels returns the labels selecting the resources of the proxy, or nil
+// if the owning gateway labels are missing.
+func (r *ResourceRender) OwningGatewayLabels() map[string]string {
+	labels := EnvoyAppLabel()
+	for _, k := range []string{
+		gatewayapi.OwningGatewayNamespaceLabel,
+		gatewayapi.OwningGatewayNameLabel,
+		gatewayapi.OwningGatewayClassLabel,
+	} {
+		if v, ok := r.infra.GetProxyMetadata().Labels[k]; ok {
+			labels[k] = v
+		}
+	}
+
+	if OwningGatewayLabelsAbsent(labels) {
+		return nil
+	}
+	return labels
+}
+
 // OwningGatewayLabelsAbsent Check if labels are missing some OwningGatewayLabels
 func OwningGatewayLabelsAbsent(labels map[string]string) bool {
 	return (len(labels[gatewayapi.OwningGatewayNameLabel]) == 0 ||
@@ -18,10 +18,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/yaml"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -99,6 +102,7 @@ func TestDeployment(t *testing.T) {
 		telemetry    *egv1a1.ProxyTelemetry
 		concurrency  *int32
 		extraArgs    []string
+		xdsHost      *string
 	}{
 		{
 			caseName: "default",
@@ -510,6 +514,19 @@ func TestDeployment(t *testing.T) {
 				},
 			},
 		},
+		{
+			caseName: "with-xds-server-host",
+			infra:    newTestInfra(),
+			xdsHost:  ptr.To("10.0.0.1"),
+		},
+		{
+			caseName: "with-wasm-server",
+			infra: func() *ir.Infra {
+				infra := newTestInfra()
+				infra.Proxy.WasmServer = true
+				return infra
+			}(),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.caseName, func(t *testing.T) {
@@ -549,6 +566,7 @@ func TestDeployment(t *testing.T) {
 			}
 
 			r := NewResourceRender(cfg.Namespace, tc.infra.GetProxyInfra())
+			r.XdsServerHost = tc.xdsHost
 			dp, err := r.Deployment()
 			require.NoError(t, err)
 
@@ -587,6 +605,105 @@ func loadDeployment(caseName string) (*appsv1.Deployment, error) {
 	return deployment, nil
 }
 
+func TestDaemonSet(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cases := []struct {
+		caseName  string
+		infra     *ir.Infra
+		daemonSet *egv1a1.KubernetesDaemonSetSpec
+	}{
+		{
+			caseName:  "default",
+			infra:     newTestInfra(),
+			daemonSet: &egv1a1.KubernetesDaemonSetSpec{},
+		},
+		{
+			caseName: "custom",
+			infra:    newTestInfra(),
+			daemonSet: &egv1a1.KubernetesDaemonSetSpec{
+				Strategy: egv1a1.DefaultKubernetesDaemonSetStrategy(),
+				Pod: &egv1a1.KubernetesPodSpec{
+					Annotations: map[string]string{
+						"prometheus.io/scrape": "true",
+					},
+					Labels: map[string]string{
+						"foo.bar": "custom-label",
+					},
+					NodeSelector: map[string]string{
+						"node-role.kubernetes.io/ingress": "",
+					},
+				},
+				Container: &egv1a1.KubernetesContainerSpec{
+					Image: ptr.To("envoyproxy/envoy:v1.2.3"),
+					Resources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("400m"),
+							corev1.ResourceMemory: resource.MustParse("2Gi"),
+						},
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("200m"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+			},
+		},
+		{
+			caseName: "patch-daemonset",
+			infra:    newTestInfra(),
+			daemonSet: &egv1a1.KubernetesDaemonSetSpec{
+				Patch: &egv1a1.KubernetesPatchSpec{
+					Type: ptr.To(egv1a1.StrategicMerge),
+					Value: v1.JSON{
+						Raw: []byte("{\"spec\":{\"template\":{\"spec\":{\"hostNetwork\":true,\"dnsPolicy\":\"ClusterFirstWithHostNet\"}}}}"),
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.caseName, func(t *testing.T) {
+			kube := tc.infra.GetProxyInfra().GetProxyConfig().GetEnvoyProxyProvider().GetEnvoyProxyKubeProvider()
+			kube.EnvoyDeployment = nil
+			kube.EnvoyDaemonSet = tc.daemonSet
+
+			r := NewResourceRender(cfg.Namespace, tc.infra.GetProxyInfra())
+			ds, err := r.DaemonSet()
+			require.NoError(t, err)
+
+			// The proxy is run by either a DaemonSet or a Deployment.
+			dp, err := r.Deployment()
+			require.NoError(t, err)
+			require.Nil(t, dp)
+
+			if *overrideTestData {
+				daemonSetYAML, err := yaml.Marshal(ds)
+				require.NoError(t, err)
+				// nolint: gosec
+				err = os.WriteFile(fmt.Sprintf("testdata/daemonsets/%s.yaml", tc.caseName), daemonSetYAML, 0644)
+				require.NoError(t, err)
+				return
+			}
+
+			expected, err := loadDaemonSet(tc.caseName)
+			require.NoError(t, err)
+			assert.Equal(t, expected, ds)
+		})
+	}
+}
+
+func loadDaemonSet(caseName string) (*appsv1.DaemonSet, error) {
+	daemonSetYAML, err := os.ReadFile(fmt.Sprintf("testdata/daemonsets/%s.yaml", caseName))
+	if err != nil {
+		return nil, err
+	}
+	daemonSet := &appsv1.DaemonSet{}
+	_ = yaml.Unmarshal(daemonSetYAML, daemonSet)
+	return daemonSet, nil
+}
+
 func TestService(t *testing.T) {
 	cfg, err := config.New()
 	require.NoError(t, err)
@@ -675,6 +792,69 @@ func TestService(t *testing.T) {
 	}
 }
 
+func TestAdditionalServices(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	infra := newTestInfraWithAddresses([]string{"10.102.168.100"})
+	infra.Proxy.Listeners[0].Ports[0].ServicePort = 80
+	infra.Proxy.Listeners[0].Ports[1].ServicePort = 443
+
+	cases := []struct {
+		caseName string
+		service  egv1a1.KubernetesAdditionalServiceSpec
+	}{
+		{
+			caseName: "additional-internal",
+			service: egv1a1.KubernetesAdditionalServiceSpec{
+				Name:  "internal",
+				Ports: []gwapiv1.PortNumber{443},
+				KubernetesServiceSpec: egv1a1.KubernetesServiceSpec{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+					},
+					ExternalTrafficPolicy: ptr.To(egv1a1.ServiceExternalTrafficPolicyCluster),
+				},
+			},
+		},
+		{
+			caseName: "additional-all-ports",
+			service: egv1a1.KubernetesAdditionalServiceSpec{
+				Name: "cluster",
+				KubernetesServiceSpec: egv1a1.KubernetesServiceSpec{
+					Type: ptr.To(egv1a1.ServiceTypeClusterIP),
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.caseName, func(t *testing.T) {
+			provider := infra.GetProxyInfra().GetProxyConfig().GetEnvoyProxyProvider().GetEnvoyProxyKubeProvider()
+			provider.EnvoyAdditionalServices = []egv1a1.KubernetesAdditionalServiceSpec{tc.service}
+
+			r := NewResourceRender(cfg.Namespace, infra.GetProxyInfra())
+			svcs, err := r.AdditionalServices()
+			require.NoError(t, err)
+			require.Len(t, svcs, 1)
+			require.Equal(t, r.AdditionalServiceName(tc.service.Name), svcs[0].Name)
+
+			if *overrideTestData {
+				serviceYAML, err := yaml.Marshal(svcs[0])
+				require.NoError(t, err)
+				// nolint: gosec
+				err = os.WriteFile(fmt.Sprintf("testdata/services/%s.yaml", tc.caseName), serviceYAML, 0644)
+				require.NoError(t, err)
+				return
+			}
+
+			expected, err := loadService(tc.caseName)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, svcs[0])
+		})
+	}
+}
+
 func loadService(caseName string) (*corev1.Service, error) {
 	serviceYAML, err := os.ReadFile(fmt.Sprintf("testdata/services/%s.yaml", caseName))
 	if err != nil {
@@ -843,6 +1023,78 @@ func TestHorizontalPodAutoscaler(t *testing.T) {
 	}
 }
 
+func TestPodDisruptionBudget(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cases := []struct {
+		caseName string
+		infra    *ir.Infra
+		pdb      *egv1a1.KubernetesPodDisruptionBudgetSpec
+	}{
+		{
+			caseName: "min-available",
+			infra:    newTestInfra(),
+			pdb: &egv1a1.KubernetesPodDisruptionBudgetSpec{
+				MinAvailable: ptr.To(intstr.FromInt32(1)),
+			},
+		},
+		{
+			caseName: "max-unavailable",
+			infra:    newTestInfra(),
+			pdb: &egv1a1.KubernetesPodDisruptionBudgetSpec{
+				MaxUnavailable: ptr.To(intstr.FromString("25%")),
+			},
+		},
+		{
+			caseName: "unset",
+			infra:    newTestInfra(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.caseName, func(t *testing.T) {
+			provider := tc.infra.GetProxyInfra().GetProxyConfig().GetEnvoyProxyProvider()
+			provider.Kubernetes = egv1a1.DefaultEnvoyProxyKubeProvider()
+			provider.Kubernetes.EnvoyPDB = tc.pdb
+
+			r := NewResourceRender(cfg.Namespace, tc.infra.GetProxyInfra())
+			pdb, err := r.PodDisruptionBudget()
+			require.NoError(t, err)
+
+			if tc.pdb == nil {
+				require.Nil(t, pdb)
+				return
+			}
+
+			if *overrideTestData {
+				pdbYAML, err := yaml.Marshal(pdb)
+				require.NoError(t, err)
+				// nolint: gosec
+				err = os.WriteFile(fmt.Sprintf("testdata/pdb/%s.yaml", tc.caseName), pdbYAML, 0644)
+				require.NoError(t, err)
+				return
+			}
+
+			want, err := loadPDB(tc.caseName)
+			require.NoError(t, err)
+
+			assert.Equal(t, want, pdb)
+		})
+	}
+}
+
+func loadPDB(caseName string) (*policyv1.PodDisruptionBudget, error) {
+	pdbYAML, err := os.ReadFile(fmt.Sprintf("testdata/pdb/%s.yaml", caseName))
+	if err != nil {
+		return nil, err
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{}
+	_ = yaml.Unmarshal(pdbYAML, pdb)
+	return pdb, nil
+}
+
 func loadHPA(caseName string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
 	hpaYAML, err := os.ReadFile(fmt.Sprintf("testdata/hpa/%s.yaml", caseName))
 	if err != nil {
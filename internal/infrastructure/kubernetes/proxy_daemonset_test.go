@@ -0,0 +1,143 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+func newDaemonSetInfra() *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.Config = &egv1a1.EnvoyProxy{
+		Spec: egv1a1.EnvoyProxySpec{
+			Provider: &egv1a1.EnvoyProxyProvider{
+				Type: egv1a1.ProviderTypeKubernetes,
+				Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+					EnvoyDaemonSet: &egv1a1.KubernetesDaemonSetSpec{},
+				},
+			},
+		},
+	}
+	return infra
+}
+
+func TestCreateOrUpdateProxyDaemonSet(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	infra := newDaemonSetInfra()
+	r := proxy.NewResourceRender(cfg.Namespace, infra.GetProxyInfra())
+	ds, err := r.DaemonSet()
+	require.NoError(t, err)
+
+	deploymentInfra := ir.NewInfra()
+	deploymentInfra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	deploymentInfra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = deploymentInfra.Proxy.Name
+	deploy, err := proxy.NewResourceRender(cfg.Namespace, deploymentInfra.GetProxyInfra()).Deployment()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		current client.Object
+		// wantDeletes is the number of delete requests sent.
+		wantDeletes int
+	}{
+		{
+			name: "create daemonset",
+		},
+		{
+			name:    "daemonset exists",
+			current: ds,
+		},
+		{
+			name:        "switch from deployment",
+			current:     deploy,
+			wantDeletes: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			deletes := 0
+			funcs := interceptorFunc
+			funcs.Delete = func(ctx context.Context, clnt client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				deletes++
+				return clnt.Delete(ctx, obj, opts...)
+			}
+			builder := fakeclient.NewClientBuilder().
+				WithScheme(envoygateway.GetScheme()).
+				WithInterceptorFuncs(funcs)
+			if tc.current != nil {
+				builder = builder.WithObjects(tc.current)
+			}
+
+			kube := NewInfra(builder.Build(), cfg)
+			r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+			require.NoError(t, kube.createOrUpdateDeployment(context.Background(), r))
+			require.NoError(t, kube.createOrUpdateDaemonSet(context.Background(), r))
+
+			actual := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: kube.Namespace,
+					Name:      r.Name(),
+				},
+			}
+			require.NoError(t, kube.Client.Get(context.Background(), client.ObjectKeyFromObject(actual), actual))
+			require.Equal(t, ds.Spec, actual.Spec)
+
+			// The Deployment is deleted when switching to a DaemonSet.
+			err := kube.Client.Get(context.Background(), client.ObjectKeyFromObject(actual), &appsv1.Deployment{})
+			require.True(t, kerrors.IsNotFound(err))
+			// No delete request is sent when the Deployment doesn't exist.
+			require.Equal(t, tc.wantDeletes, deletes)
+		})
+	}
+}
+
+func TestSwitchProxyDaemonSetToDeployment(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	ds, err := proxy.NewResourceRender(cfg.Namespace, newDaemonSetInfra().GetProxyInfra()).DaemonSet()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().
+		WithScheme(envoygateway.GetScheme()).
+		WithObjects(ds).
+		WithInterceptorFuncs(interceptorFunc).
+		Build()
+	kube := NewInfra(cli, cfg)
+
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdateDeployment(context.Background(), r))
+	require.NoError(t, kube.createOrUpdateDaemonSet(context.Background(), r))
+
+	key := client.ObjectKey{Namespace: kube.Namespace, Name: r.Name()}
+	require.NoError(t, kube.Client.Get(context.Background(), key, &appsv1.Deployment{}))
+	err = kube.Client.Get(context.Background(), key, &appsv1.DaemonSet{})
+	require.True(t, kerrors.IsNotFound(err))
+}
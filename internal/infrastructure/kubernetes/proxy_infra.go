@@ -6,11 +6,35 @@
 package kubernetes
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/envoyproxy/gateway/internal/crypto"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
 	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+const (
+	// caCertKey is the key of the trusted CA certificate in the xDS cert Secrets.
+	caCertKey = "ca.crt"
+	// xdsCAWatchRetryPeriod is the period after which the watch of the CA of the
+	// xDS certificates is restarted once it stops.
+	xdsCAWatchRetryPeriod = 5 * time.Second
 )
 
 // CreateOrUpdateProxyInfra creates the managed kube infra, if it doesn't exist.
@@ -23,8 +47,34 @@ func (i *Infra) CreateOrUpdateProxyInfra(ctx context.Context, infra *ir.Infra) e
 		return errors.New("infra proxy ir is nil")
 	}
 
-	r := proxy.NewResourceRender(i.Namespace, infra.GetProxyInfra())
-	return i.createOrUpdate(ctx, r)
+	r := i.proxyResourceRender(infra.GetProxyInfra())
+	r.XdsServerHost = i.XdsServerHost
+
+	// The proxy pods mount the xDS client certificate, which is issued first for
+	// the namespace of the proxy when it isn't the Envoy Gateway namespace.
+	if r.Namespace() != i.Namespace {
+		if err := i.createOrUpdateXdsCertSecret(ctx, r); err != nil {
+			return fmt.Errorf("failed to create or update xds cert secret %s/%s: %w", r.Namespace(), r.Name(), err)
+		}
+	}
+
+	if err := i.createOrUpdate(ctx, r); err != nil {
+		return err
+	}
+
+	if err := i.createOrUpdateAdditionalServices(ctx, r); err != nil {
+		return fmt.Errorf("failed to create or update additional services %s/%s: %w", r.Namespace(), r.Name(), err)
+	}
+
+	if r.Namespace() != i.Namespace && i.trackXdsCertSecret(r) {
+		// The proxy was deployed to the Envoy Gateway namespace before the proxies
+		// were deployed to the namespaces of the Gateways.
+		if err := i.deleteFromEnvoyGatewayNamespace(ctx, infra.GetProxyInfra()); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // DeleteProxyInfra removes the managed kube infra, if it doesn't exist.
@@ -33,6 +83,242 @@ func (i *Infra) DeleteProxyInfra(ctx context.Context, infra *ir.Infra) error {
 		return errors.New("infra ir is nil")
 	}
 
-	r := proxy.NewResourceRender(i.Namespace, infra.GetProxyInfra())
-	return i.delete(ctx, r)
+	r := i.proxyResourceRender(infra.GetProxyInfra())
+	if err := i.delete(ctx, r); err != nil {
+		return err
+	}
+
+	if err := i.deleteAdditionalServices(ctx, r, nil); err != nil {
+		return fmt.Errorf("failed to delete additional services %s/%s: %w", r.Namespace(), r.Name(), err)
+	}
+
+	if r.Namespace() != i.Namespace {
+		if err := i.deleteXdsCertSecret(ctx, r); err != nil {
+			return fmt.Errorf("failed to delete xds cert secret %s/%s: %w", r.Namespace(), r.Name(), err)
+		}
+		if !i.untrackXdsCertSecret(r) {
+			if err := i.deleteFromEnvoyGatewayNamespace(ctx, infra.GetProxyInfra()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteFromEnvoyGatewayNamespace deletes the resources of the proxy left in the
+// Envoy Gateway namespace when the proxies are deployed to the namespaces of the
+// Gateways instead.
+func (i *Infra) deleteFromEnvoyGatewayNamespace(ctx context.Context, infra *ir.ProxyInfra) error {
+	r := proxy.NewResourceRender(i.Namespace, infra)
+	if err := i.delete(ctx, r); err != nil {
+		return err
+	}
+
+	if err := i.deleteAdditionalServices(ctx, r, nil); err != nil {
+		return fmt.Errorf("failed to delete additional services %s/%s: %w", r.Namespace(), r.Name(), err)
+	}
+
+	return nil
+}
+
+// proxyResourceRender returns the ResourceRender of the proxy infra, in the namespace
+// of its Gateway when the Gateways are deployed to their namespaces, or in the
+// Envoy Gateway namespace otherwise.
+func (i *Infra) proxyResourceRender(infra *ir.ProxyInfra) *proxy.ResourceRender {
+	// The merged Gateways of a GatewayClass have no owning Gateway namespace.
+	ns := infra.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel]
+	if !i.EnvoyGateway.GatewayNamespaceMode() || ns == "" || ns == i.Namespace {
+		return proxy.NewResourceRender(i.Namespace, infra)
+	}
+
+	r := proxy.NewResourceRender(ns, infra)
+	r.XdsCertSecretName = ptr.To(r.Name())
+	return r
+}
+
+// trackXdsCertSecret tracks the xDS client certificate of the proxy, so that it's
+// reissued when the CA is rotated, and returns whether it wasn't tracked yet.
+func (i *Infra) trackXdsCertSecret(r *proxy.ResourceRender) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	key := types.NamespacedName{Namespace: r.Namespace(), Name: r.Name()}
+	_, found := i.xdsCertSecrets[key]
+	i.xdsCertSecrets[key] = r
+	return !found
+}
+
+// untrackXdsCertSecret stops tracking the xDS client certificate of the proxy, and
+// returns whether it was tracked.
+func (i *Infra) untrackXdsCertSecret(r *proxy.ResourceRender) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	key := types.NamespacedName{Namespace: r.Namespace(), Name: r.Name()}
+	_, found := i.xdsCertSecrets[key]
+	delete(i.xdsCertSecrets, key)
+	return found
+}
+
+// xdsCA returns the certificate and the key of the CA of the xDS certificates. The
+// CA must be the one trusted by the xDS client certificate of the proxies in the
+// Envoy Gateway namespace, i.e. the one the xDS server certificate is issued with.
+func (i *Infra) xdsCA(ctx context.Context) ([]byte, []byte, error) {
+	ca := &corev1.Secret{}
+	if err := i.Client.Get(ctx, client.ObjectKey{Namespace: i.Namespace, Name: proxy.XdsCASecretName}, ca); err != nil {
+		return nil, nil, err
+	}
+
+	source := &corev1.Secret{}
+	if err := i.Client.Get(ctx, client.ObjectKey{Namespace: i.Namespace, Name: proxy.XdsCertSecretName}, source); err != nil {
+		return nil, nil, err
+	}
+
+	if !bytes.Equal(ca.Data[corev1.TLSCertKey], source.Data[caCertKey]) {
+		return nil, nil, fmt.Errorf("secret %s/%s doesn't hold the CA of secret %s/%s, the control plane certificates must be regenerated",
+			i.Namespace, proxy.XdsCASecretName, i.Namespace, proxy.XdsCertSecretName)
+	}
+
+	return ca.Data[corev1.TLSCertKey], ca.Data[corev1.TLSPrivateKeyKey], nil
+}
+
+// createOrUpdateXdsCertSecret issues the xDS client certificate of the proxy for
+// its namespace with the CA of the xDS certificates, unless the current one is
+// already issued with it.
+func (i *Infra) createOrUpdateXdsCertSecret(ctx context.Context, r *proxy.ResourceRender) error {
+	caCert, caKey, err := i.xdsCA(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := &corev1.Secret{}
+	if err := i.Client.Get(ctx, client.ObjectKey{Namespace: r.Namespace(), Name: r.Name()}, current); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+	} else if bytes.Equal(current.Data[caCertKey], caCert) {
+		return nil
+	}
+
+	cert, key, err := crypto.GenerateEnvoyCert(caCert, caKey, r.Namespace())
+	if err != nil {
+		return err
+	}
+
+	secret, err := r.XdsCertSecret(caCert, cert, key)
+	if err != nil {
+		return err
+	}
+
+	return i.Client.ServerSideApply(ctx, secret)
+}
+
+// SyncXdsCertSecrets watches the CA of the xDS certificates, and reissues the xDS
+// client certificates of the proxies deployed to the namespaces of the Gateways
+// when it's rotated, until the context is done.
+func (i *Infra) SyncXdsCertSecrets(ctx context.Context, logger logging.Logger) {
+	cli, ok := i.Client.Client.(client.WithWatch)
+	if !ok {
+		logger.Info("the client doesn't support watches, the xds client certificates aren't synced")
+		return
+	}
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		w, err := cli.Watch(ctx, &corev1.SecretList{}, client.InNamespace(i.Namespace),
+			client.MatchingFields{"metadata.name": proxy.XdsCASecretName})
+		if err != nil {
+			logger.Error(err, "failed to watch the xds ca secret")
+			return
+		}
+		defer w.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-w.ResultChan():
+				if !ok {
+					return
+				}
+				if event.Type != watch.Added && event.Type != watch.Modified {
+					continue
+				}
+				for _, r := range i.trackedXdsCertSecrets() {
+					if err := i.createOrUpdateXdsCertSecret(ctx, r); err != nil {
+						logger.Error(err, "failed to update xds cert secret", "namespace", r.Namespace(), "name", r.Name())
+					}
+				}
+			}
+		}
+	}, xdsCAWatchRetryPeriod)
+}
+
+func (i *Infra) trackedXdsCertSecrets() []*proxy.ResourceRender {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	renders := make([]*proxy.ResourceRender, 0, len(i.xdsCertSecrets))
+	for _, r := range i.xdsCertSecrets {
+		renders = append(renders, r)
+	}
+	return renders
+}
+
+// deleteXdsCertSecret deletes the copy of the Secret holding the xDS client certificate
+// of the proxy, if it exists.
+func (i *Infra) deleteXdsCertSecret(ctx context.Context, r *proxy.ResourceRender) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace(),
+			Name:      r.Name(),
+		},
+	}
+
+	return i.Client.Delete(ctx, secret)
+}
+
+// createOrUpdateAdditionalServices creates or updates the additional Services of the proxy,
+// and deletes the additional Services which are no longer specified.
+func (i *Infra) createOrUpdateAdditionalServices(ctx context.Context, r *proxy.ResourceRender) error {
+	svcs, err := r.AdditionalServices()
+	if err != nil {
+		return err
+	}
+
+	expected := sets.New[string]()
+	for _, svc := range svcs {
+		if err := i.Client.ServerSideApply(ctx, svc); err != nil {
+			return err
+		}
+		expected.Insert(svc.Name)
+	}
+
+	return i.deleteAdditionalServices(ctx, r, expected)
+}
+
+// deleteAdditionalServices deletes the additional Services of the proxy, except the
+// expected ones.
+func (i *Infra) deleteAdditionalServices(ctx context.Context, r *proxy.ResourceRender, expected sets.Set[string]) error {
+	// The proxy can't have Services without the owning gateway labels.
+	labels := r.OwningGatewayLabels()
+	if labels == nil {
+		return nil
+	}
+
+	current := &corev1.ServiceList{}
+	if err := i.Client.List(ctx, current, client.InNamespace(r.Namespace()), client.MatchingLabels(labels)); err != nil {
+		return err
+	}
+
+	for j := range current.Items {
+		if current.Items[j].Name == r.Name() || expected.Has(current.Items[j].Name) {
+			continue
+		}
+		if err := i.Client.Delete(ctx, &current.Items[j]); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
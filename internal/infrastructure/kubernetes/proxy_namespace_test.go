@@ -0,0 +1,205 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/crypto"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/logging"
+	provider "github.com/envoyproxy/gateway/internal/provider/kubernetes"
+)
+
+func TestProxyInfraGatewayNamespaceMode(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+	cfg.EnvoyGateway.Provider = &egv1a1.EnvoyGatewayProvider{
+		Type: egv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egv1a1.EnvoyGatewayKubernetesProvider{
+			Deploy: &egv1a1.KubernetesDeployMode{
+				Type: ptr.To(egv1a1.KubernetesDeployModeTypeGatewayNamespace),
+			},
+		},
+	}
+
+	certs, err := crypto.GenerateCerts(cfg)
+	require.NoError(t, err)
+	secrets := provider.CertsToSecret(cfg.Namespace, certs)
+	objs := make([]client.Object, 0, len(secrets))
+	for i := range secrets {
+		objs = append(objs, &secrets[i])
+	}
+
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	name := proxy.ExpectedResourceHashedName(infra.Proxy.Name)
+	key := client.ObjectKey{Namespace: "default", Name: name}
+
+	// The proxy was deployed to the Envoy Gateway namespace before switching modes.
+	orphan := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: cfg.Namespace, Name: name}}
+	objs = append(objs, orphan)
+
+	cli := fakeclient.NewClientBuilder().
+		WithScheme(envoygateway.GetScheme()).
+		WithObjects(objs...).
+		WithInterceptorFuncs(interceptorFunc).
+		Build()
+	kube := NewInfra(cli, cfg)
+	require.Equal(t, ptr.To("envoy-gateway.envoy-gateway-system.svc.cluster.local"), kube.XdsServerHost)
+	require.NoError(t, kube.CreateOrUpdateProxyInfra(context.Background(), infra))
+
+	// An xDS client certificate is issued for the namespace of the Gateway.
+	secret := &corev1.Secret{}
+	require.NoError(t, kube.Client.Get(context.Background(), key, secret))
+	require.Equal(t, corev1.SecretTypeTLS, secret.Type)
+	require.Equal(t, certs.CACertificate, secret.Data["ca.crt"])
+	require.NotEqual(t, certs.EnvoyPrivateKey, secret.Data[corev1.TLSPrivateKeyKey])
+	requireCertIssuedFor(t, certs.CACertificate, secret.Data[corev1.TLSCertKey], "default")
+
+	deploy := &appsv1.Deployment{}
+	require.NoError(t, kube.Client.Get(context.Background(), key, deploy))
+	var certsSecretName string
+	for _, vol := range deploy.Spec.Template.Spec.Volumes {
+		if vol.Name == "certs" {
+			certsSecretName = vol.Secret.SecretName
+		}
+	}
+	require.Equal(t, name, certsSecretName)
+
+	require.NoError(t, kube.Client.Get(context.Background(), key, &corev1.Service{}))
+	require.NoError(t, kube.Client.Get(context.Background(), key, &corev1.ServiceAccount{}))
+	require.NoError(t, kube.Client.Get(context.Background(), key, &corev1.ConfigMap{}))
+
+	// The resources of the proxy left in the Envoy Gateway namespace are deleted.
+	err = kube.Client.Get(context.Background(), client.ObjectKeyFromObject(orphan), &appsv1.Deployment{})
+	require.True(t, kerrors.IsNotFound(err))
+
+	// The xDS client certificate is kept as long as the CA isn't rotated.
+	require.NoError(t, kube.CreateOrUpdateProxyInfra(context.Background(), infra))
+	current := &corev1.Secret{}
+	require.NoError(t, kube.Client.Get(context.Background(), key, current))
+	require.Equal(t, secret.Data, current.Data)
+
+	// The xDS client certificate is deleted along with the proxy infra.
+	require.NoError(t, kube.DeleteProxyInfra(context.Background(), infra))
+	err = kube.Client.Get(context.Background(), key, &corev1.Secret{})
+	require.True(t, kerrors.IsNotFound(err))
+	err = kube.Client.Get(context.Background(), key, &appsv1.Deployment{})
+	require.True(t, kerrors.IsNotFound(err))
+}
+
+func TestProxyInfraGatewayNamespaceModeMerged(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+	cfg.EnvoyGateway.Provider = &egv1a1.EnvoyGatewayProvider{
+		Type: egv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egv1a1.EnvoyGatewayKubernetesProvider{
+			Deploy: &egv1a1.KubernetesDeployMode{
+				Type: ptr.To(egv1a1.KubernetesDeployModeTypeGatewayNamespace),
+			},
+		},
+	}
+	kube := NewInfra(fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build(), cfg)
+
+	// Merged Gateways have no owning Gateway namespace and stay in the Envoy Gateway namespace.
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayClassLabel] = "eg"
+	r := kube.proxyResourceRender(infra.GetProxyInfra())
+	require.Equal(t, kube.Namespace, r.Namespace())
+	require.Nil(t, r.XdsCertSecretName)
+}
+
+func TestSyncXdsCertSecrets(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+	cfg.EnvoyGateway.Provider = &egv1a1.EnvoyGatewayProvider{
+		Type: egv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egv1a1.EnvoyGatewayKubernetesProvider{
+			Deploy: &egv1a1.KubernetesDeployMode{
+				Type: ptr.To(egv1a1.KubernetesDeployModeTypeGatewayNamespace),
+			},
+		},
+	}
+
+	certs, err := crypto.GenerateCerts(cfg)
+	require.NoError(t, err)
+	secrets := provider.CertsToSecret(cfg.Namespace, certs)
+	objs := make([]client.Object, 0, len(secrets))
+	for i := range secrets {
+		objs = append(objs, &secrets[i])
+	}
+	cli := fakeclient.NewClientBuilder().
+		WithScheme(envoygateway.GetScheme()).
+		WithObjects(objs...).
+		WithInterceptorFuncs(interceptorFunc).
+		Build()
+	kube := NewInfra(cli, cfg)
+
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	require.NoError(t, kube.CreateOrUpdateProxyInfra(context.Background(), infra))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go kube.SyncXdsCertSecrets(ctx, logging.DefaultLogger(egv1a1.LogLevelInfo))
+
+	// A CA which doesn't sign the xDS certificates isn't used. The CA Secret is the
+	// last of the Secrets holding the certificates.
+	rotated, err := crypto.GenerateCerts(cfg)
+	require.NoError(t, err)
+	rotatedSecrets := provider.CertsToSecret(cfg.Namespace, rotated)
+	_, err = provider.CreateOrUpdateSecrets(context.Background(), cli, rotatedSecrets[len(rotatedSecrets)-1:], true)
+	require.NoError(t, err)
+	require.Error(t, kube.CreateOrUpdateProxyInfra(context.Background(), infra))
+
+	// The xDS client certificate is reissued once the certificates are rotated.
+	_, err = provider.CreateOrUpdateSecrets(context.Background(), cli, rotatedSecrets, true)
+	require.NoError(t, err)
+	key := client.ObjectKey{Namespace: "default", Name: proxy.ExpectedResourceHashedName(infra.Proxy.Name)}
+	require.Eventually(t, func() bool {
+		secret := &corev1.Secret{}
+		require.NoError(t, kube.Client.Get(context.Background(), key, secret))
+		return bytes.Equal(rotated.CACertificate, secret.Data["ca.crt"])
+	}, 5*time.Second, 10*time.Millisecond)
+	secret := &corev1.Secret{}
+	require.NoError(t, kube.Client.Get(context.Background(), key, secret))
+	requireCertIssuedFor(t, rotated.CACertificate, secret.Data[corev1.TLSCertKey], "default")
+}
+
+func requireCertIssuedFor(t *testing.T, caCert, cert []byte, namespace string) {
+	t.Helper()
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(caCert))
+	block, _ := pem.Decode(cert)
+	require.NotNil(t, block)
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	_, err = parsed.Verify(x509.VerifyOptions{DNSName: "envoy." + namespace, Roots: roots})
+	require.NoError(t, err)
+}
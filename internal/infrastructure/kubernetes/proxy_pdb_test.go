@@ -0,0 +1,81 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+func TestCreateOrUpdateProxyPDB(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	newInfra := func(pdb *egv1a1.KubernetesPodDisruptionBudgetSpec) *ir.Infra {
+		infra := ir.NewInfra()
+		infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+		infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+		infra.Proxy.Config = &egv1a1.EnvoyProxy{
+			Spec: egv1a1.EnvoyProxySpec{
+				Provider: &egv1a1.EnvoyProxyProvider{
+					Type: egv1a1.ProviderTypeKubernetes,
+					Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+						EnvoyPDB: pdb,
+					},
+				},
+			},
+		}
+		return infra
+	}
+
+	cli := fakeclient.NewClientBuilder().
+		WithScheme(envoygateway.GetScheme()).
+		WithInterceptorFuncs(interceptorFunc).
+		Build()
+	kube := NewInfra(cli, cfg)
+
+	// The PDB is created once set.
+	infra := newInfra(&egv1a1.KubernetesPodDisruptionBudgetSpec{
+		MinAvailable: ptr.To(intstr.FromInt32(1)),
+	})
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdatePDB(context.Background(), r))
+
+	key := client.ObjectKey{Namespace: kube.Namespace, Name: r.Name()}
+	actual := &policyv1.PodDisruptionBudget{}
+	require.NoError(t, kube.Client.Get(context.Background(), key, actual))
+	require.Equal(t, ptr.To(intstr.FromInt32(1)), actual.Spec.MinAvailable)
+
+	// The PDB is updated.
+	infra = newInfra(&egv1a1.KubernetesPodDisruptionBudgetSpec{
+		MaxUnavailable: ptr.To(intstr.FromString("50%")),
+	})
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdatePDB(context.Background(), r))
+	require.NoError(t, kube.Client.Get(context.Background(), key, actual))
+	require.Equal(t, ptr.To(intstr.FromString("50%")), actual.Spec.MaxUnavailable)
+
+	// The PDB is deleted once unset.
+	r = proxy.NewResourceRender(kube.Namespace, newInfra(nil).GetProxyInfra())
+	require.NoError(t, kube.createOrUpdatePDB(context.Background(), r))
+	err = kube.Client.Get(context.Background(), key, &policyv1.PodDisruptionBudget{})
+	require.True(t, kerrors.IsNotFound(err))
+}
@@ -10,7 +10,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
 	"github.com/envoyproxy/gateway/internal/ir"
@@ -42,3 +49,63 @@ func TestDeleteProxyService(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateProxyAdditionalServices(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	newInfra := func(names ...string) *ir.Infra {
+		infra := ir.NewInfra()
+		infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+		infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+		services := make([]egv1a1.KubernetesAdditionalServiceSpec, 0, len(names))
+		for _, name := range names {
+			services = append(services, egv1a1.KubernetesAdditionalServiceSpec{Name: name})
+		}
+		infra.Proxy.Config = &egv1a1.EnvoyProxy{
+			Spec: egv1a1.EnvoyProxySpec{
+				Provider: &egv1a1.EnvoyProxyProvider{
+					Type: egv1a1.ProviderTypeKubernetes,
+					Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+						EnvoyAdditionalServices: services,
+					},
+				},
+			},
+		}
+		return infra
+	}
+
+	cli := fakeclient.NewClientBuilder().
+		WithScheme(envoygateway.GetScheme()).
+		WithInterceptorFuncs(interceptorFunc).
+		Build()
+	kube := NewInfra(cli, cfg)
+
+	// The additional services are created along with the service of the proxy.
+	infra := newInfra("internal", "external")
+	require.NoError(t, kube.CreateOrUpdateProxyInfra(context.Background(), infra))
+
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	for _, key := range []client.ObjectKey{
+		{Namespace: kube.Namespace, Name: r.Name()},
+		{Namespace: kube.Namespace, Name: r.AdditionalServiceName("internal")},
+		{Namespace: kube.Namespace, Name: r.AdditionalServiceName("external")},
+	} {
+		require.NoError(t, kube.Client.Get(context.Background(), key, &corev1.Service{}))
+	}
+
+	// The additional services which are no longer specified are deleted.
+	infra = newInfra("internal")
+	require.NoError(t, kube.CreateOrUpdateProxyInfra(context.Background(), infra))
+	key := client.ObjectKey{Namespace: kube.Namespace, Name: r.AdditionalServiceName("external")}
+	err = kube.Client.Get(context.Background(), key, &corev1.Service{})
+	require.True(t, kerrors.IsNotFound(err))
+	key = client.ObjectKey{Namespace: kube.Namespace, Name: r.AdditionalServiceName("internal")}
+	require.NoError(t, kube.Client.Get(context.Background(), key, &corev1.Service{}))
+
+	// All the services are deleted along with the proxy infra.
+	require.NoError(t, kube.DeleteProxyInfra(context.Background(), infra))
+	svcs := &corev1.ServiceList{}
+	require.NoError(t, kube.Client.List(context.Background(), svcs, client.InNamespace(kube.Namespace)))
+	require.Empty(t, svcs.Items)
+}
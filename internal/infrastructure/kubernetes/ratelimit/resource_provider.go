@@ -12,6 +12,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -34,11 +35,12 @@ const (
 var statsConf string
 
 type ResourceRender struct {
-	// Namespace is the Namespace used for managed infra.
-	Namespace string
+	// namespace is the Namespace used for managed infra.
+	namespace string
 
 	rateLimit           *egv1a1.RateLimit
 	rateLimitDeployment *egv1a1.KubernetesDeploymentSpec
+	rateLimitPDB        *egv1a1.KubernetesPodDisruptionBudgetSpec
 
 	// ownerReferenceUID store the uid of its owner reference.
 	ownerReferenceUID map[string]types.UID
@@ -47,9 +49,10 @@ type ResourceRender struct {
 // NewResourceRender returns a new ResourceRender.
 func NewResourceRender(ns string, gateway *egv1a1.EnvoyGateway, ownerReferenceUID map[string]types.UID) *ResourceRender {
 	return &ResourceRender{
-		Namespace:           ns,
+		namespace:           ns,
 		rateLimit:           gateway.RateLimit,
 		rateLimitDeployment: gateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitDeployment,
+		rateLimitPDB:        gateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitPDB,
 		ownerReferenceUID:   ownerReferenceUID,
 	}
 }
@@ -58,6 +61,10 @@ func (r *ResourceRender) Name() string {
 	return InfraName
 }
 
+func (r *ResourceRender) Namespace() string {
+	return r.namespace
+}
+
 func enablePrometheus(rl *egv1a1.RateLimit) bool {
 	if rl != nil &&
 		rl.Telemetry != nil &&
@@ -80,7 +87,7 @@ func (r *ResourceRender) ConfigMap() (*corev1.ConfigMap, error) {
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: r.Namespace,
+			Namespace: r.namespace,
 			Name:      "statsd-exporter-config",
 			Labels:    rateLimitLabels(),
 		},
@@ -127,7 +134,7 @@ func (r *ResourceRender) Service() (*corev1.Service, error) {
 			APIVersion: apiVersion,
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: r.Namespace,
+			Namespace: r.namespace,
 			Name:      InfraName,
 			Labels:    labels,
 		},
@@ -160,7 +167,7 @@ func (r *ResourceRender) ServiceAccount() (*corev1.ServiceAccount, error) {
 			APIVersion: apiVersion,
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: r.Namespace,
+			Namespace: r.namespace,
 			Name:      InfraName,
 		},
 	}
@@ -205,7 +212,7 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 			APIVersion: appsAPIVersion,
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace: r.Namespace,
+			Namespace: r.namespace,
 			Name:      InfraName,
 			Labels:    labels,
 		},
@@ -261,6 +268,47 @@ func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
 	return deployment, nil
 }
 
+// DaemonSet returns nil since rate limit is always run by a Deployment.
+func (r *ResourceRender) DaemonSet() (*appsv1.DaemonSet, error) {
+	return nil, nil
+}
+
 func (r *ResourceRender) HorizontalPodAutoscaler() (*autoscalingv2.HorizontalPodAutoscaler, error) {
 	return nil, nil
 }
+
+// PodDisruptionBudget returns the expected rate limit PodDisruptionBudget based on the provided infra.
+func (r *ResourceRender) PodDisruptionBudget() (*policyv1.PodDisruptionBudget, error) {
+	if r.rateLimitPDB == nil {
+		return nil, nil
+	}
+
+	labels := rateLimitLabels()
+	pdb := &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "policy/v1",
+			Kind:       "PodDisruptionBudget",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.namespace,
+			Name:      InfraName,
+			Labels:    labels,
+		},
+		Spec: resource.ExpectedPodDisruptionBudgetSpec(r.rateLimitPDB, labels),
+	}
+
+	if r.ownerReferenceUID != nil {
+		if uid, ok := r.ownerReferenceUID[ResourceKindDeployment]; ok {
+			pdb.OwnerReferences = []metav1.OwnerReference{
+				{
+					Kind:       ResourceKindDeployment,
+					APIVersion: appsAPIVersion,
+					Name:       "envoy-gateway",
+					UID:        uid,
+				},
+			}
+		}
+	}
+
+	return pdb, nil
+}
@@ -15,10 +15,12 @@ import (
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/yaml"
@@ -125,6 +127,56 @@ func loadServiceAccount() (*corev1.ServiceAccount, error) {
 	return sa, nil
 }
 
+func TestPodDisruptionBudget(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cfg.EnvoyGateway.RateLimit = &egv1a1.RateLimit{
+		Backend: egv1a1.RateLimitDatabaseBackend{
+			Type: egv1a1.RedisBackendType,
+			Redis: &egv1a1.RateLimitRedisSettings{
+				URL: "redis.redis.svc:6379",
+			},
+		},
+	}
+
+	r := NewResourceRender(cfg.Namespace, cfg.EnvoyGateway, ownerReferenceUID)
+	pdb, err := r.PodDisruptionBudget()
+	require.NoError(t, err)
+	require.Nil(t, pdb)
+
+	cfg.EnvoyGateway.GetEnvoyGatewayProvider().GetEnvoyGatewayKubeProvider().RateLimitPDB = &egv1a1.KubernetesPodDisruptionBudgetSpec{
+		MinAvailable: ptr.To(intstr.FromInt32(1)),
+	}
+	r = NewResourceRender(cfg.Namespace, cfg.EnvoyGateway, ownerReferenceUID)
+	pdb, err = r.PodDisruptionBudget()
+	require.NoError(t, err)
+
+	if *overrideTestData {
+		pdbYAML, err := yaml.Marshal(pdb)
+		require.NoError(t, err)
+		// nolint: gosec
+		err = os.WriteFile("testdata/envoy-ratelimit-pdb.yaml", pdbYAML, 0644)
+		require.NoError(t, err)
+		return
+	}
+
+	expected, err := loadPDB()
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, pdb)
+}
+
+func loadPDB() (*policyv1.PodDisruptionBudget, error) {
+	pdbYAML, err := os.ReadFile("testdata/envoy-ratelimit-pdb.yaml")
+	if err != nil {
+		return nil, err
+	}
+	pdb := &policyv1.PodDisruptionBudget{}
+	_ = yaml.Unmarshal(pdbYAML, pdb)
+	return pdb, nil
+}
+
 func TestService(t *testing.T) {
 
 	cfg, err := config.New()
@@ -9,6 +9,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -46,6 +47,16 @@ func ExpectedServiceSpec(service *egv1a1.KubernetesServiceSpec) corev1.ServiceSp
 	return serviceSpec
 }
 
+// ExpectedPodDisruptionBudgetSpec returns the pod disruption budget spec of the pods
+// selected by the provided labels.
+func ExpectedPodDisruptionBudgetSpec(pdb *egv1a1.KubernetesPodDisruptionBudgetSpec, labels map[string]string) policyv1.PodDisruptionBudgetSpec {
+	return policyv1.PodDisruptionBudgetSpec{
+		MinAvailable:   pdb.MinAvailable,
+		MaxUnavailable: pdb.MaxUnavailable,
+		Selector:       GetSelector(labels),
+	}
+}
+
 // CompareSvc compares the Service resource and ignores specific fields that may have been modified by other actors.
 func CompareSvc(currentSvc, originalSvc *corev1.Service) bool {
 	return cmp.Equal(currentSvc.Spec, originalSvc.Spec,
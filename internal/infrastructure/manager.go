@@ -15,11 +15,15 @@ import (
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/infrastructure/host"
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes"
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
-var _ Manager = (*kubernetes.Infra)(nil)
+var (
+	_ Manager = (*kubernetes.Infra)(nil)
+	_ Manager = (*host.Infra)(nil)
+)
 
 // Manager provides the scaffolding for managing infrastructure.
 type Manager interface {
@@ -36,14 +40,22 @@ type Manager interface {
 // NewManager returns a new infrastructure Manager.
 func NewManager(cfg *config.Server) (Manager, error) {
 	var mgr Manager
-	if cfg.EnvoyGateway.Provider.Type == v1alpha1.ProviderTypeKubernetes {
-		cli, err := client.New(clicfg.GetConfigOrDie(), client.Options{Scheme: envoygateway.GetScheme()})
+	switch {
+	case cfg.EnvoyGateway.Provider.Type == v1alpha1.ProviderTypeKubernetes:
+		// The client watches the CA of the xDS certificates when the proxies are
+		// deployed to the namespaces of the Gateways.
+		cli, err := client.NewWithWatch(clicfg.GetConfigOrDie(), client.Options{Scheme: envoygateway.GetScheme()})
 		if err != nil {
 			return nil, err
 		}
 		mgr = kubernetes.NewInfra(cli, cfg)
-	} else {
-		// Kube is the only supported provider type for now.
+	case cfg.EnvoyGateway.Provider.IsRunningOnHost():
+		infra, err := host.NewInfra(cfg)
+		if err != nil {
+			return nil, err
+		}
+		mgr = infra
+	default:
 		return nil, fmt.Errorf("unsupported provider type %v", cfg.EnvoyGateway.Provider.Type)
 	}
 
@@ -0,0 +1,18 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package runner
+
+import "github.com/envoyproxy/gateway/internal/metrics"
+
+var (
+	infraReconcileTotal = metrics.NewCounter("infra_reconcile_total", "Total number of reconciles of the infrastructure.")
+
+	infraReconcileErrorsTotal = metrics.NewCounter("infra_reconcile_errors_total", "Total number of failed reconciles of the infrastructure.")
+
+	infraTypeLabel = metrics.NewLabel("infra_type")
+
+	operationLabel = metrics.NewLabel("operation")
+)
@@ -13,8 +13,11 @@ import (
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/infrastructure"
+	"github.com/envoyproxy/gateway/internal/infrastructure/host"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/supervisor"
 )
 
 type Config struct {
@@ -32,31 +35,44 @@ func (r *Runner) Name() string {
 }
 
 func New(cfg *Config) *Runner {
-	return &Runner{Config: *cfg}
+	r := &Runner{Config: *cfg}
+	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
+	return r
 }
 
 // Start starts the infrastructure runner
 func (r *Runner) Start(ctx context.Context) (err error) {
-	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
-	r.mgr, err = infrastructure.NewManager(&r.Config.Server)
-	if err != nil {
-		r.Logger.Error(err, "failed to create new manager")
-		return err
+	// The manager is kept when the runner is restarted, so that the Envoy
+	// processes of the host infrastructure keep running.
+	if r.mgr == nil {
+		r.mgr, err = infrastructure.NewManager(&r.Config.Server)
+		if err != nil {
+			r.Logger.Error(err, "failed to create new manager")
+			return err
+		}
 	}
 
 	var initInfra = func() {
-		go r.subscribeToProxyInfraIR(ctx)
+		supervisor.Go(ctx, func() { r.subscribeToProxyInfraIR(ctx) })
+
+		// Reissue the xDS client certificates of the proxies deployed to the
+		// namespaces of the Gateways when the CA is rotated.
+		if kube, ok := r.mgr.(*kubernetes.Infra); ok && r.EnvoyGateway.GatewayNamespaceMode() {
+			supervisor.Go(ctx, func() { kube.SyncXdsCertSecrets(ctx, r.Logger) })
+		}
 
 		// Enable global ratelimit if it has been configured.
 		if r.EnvoyGateway.RateLimit != nil {
-			go r.enableRateLimitInfra(ctx)
+			supervisor.Go(ctx, func() { r.enableRateLimitInfra(ctx) })
 		}
 		r.Logger.Info("started")
 	}
 
 	// When leader election is active, infrastructure initialization occurs only upon acquiring leadership
 	// to avoid multiple EG instances processing envoy proxy infra resources.
-	if !ptr.Deref(r.EnvoyGateway.Provider.Kubernetes.LeaderElection.Disable, false) {
+	// When the Gateways are sharded, each instance processes the infra resources
+	// of the Gateways of its shard instead.
+	if r.EnvoyGateway.GetSharding() == nil && !ptr.Deref(r.EnvoyGateway.Provider.Kubernetes.LeaderElection.Disable, false) {
 		go func() {
 			select {
 			case <-ctx.Done():
@@ -71,6 +87,14 @@ func (r *Runner) Start(ctx context.Context) (err error) {
 	return
 }
 
+// Close stops the Envoy processes of the host infrastructure, which outlive
+// the context of the runner.
+func (r *Runner) Close() {
+	if h, ok := r.mgr.(*host.Infra); ok {
+		h.Close()
+	}
+}
+
 func (r *Runner) subscribeToProxyInfraIR(ctx context.Context) {
 	// Subscribe to resources
 	message.HandleSubscription(message.Metadata{Runner: string(v1alpha1.LogComponentInfrastructureRunner), Message: "infra-ir"}, r.InfraIR.Subscribe(ctx),
@@ -79,8 +103,10 @@ func (r *Runner) subscribeToProxyInfraIR(ctx context.Context) {
 			val := update.Value
 
 			if update.Delete {
+				infraReconcileTotal.With(infraTypeLabel.Value("proxy"), operationLabel.Value("delete")).Increment()
 				if err := r.mgr.DeleteProxyInfra(ctx, val); err != nil {
 					r.Logger.Error(err, "failed to delete infra")
+					infraReconcileErrorsTotal.With(infraTypeLabel.Value("proxy"), operationLabel.Value("delete")).Increment()
 					errChan <- err
 				}
 			} else {
@@ -90,8 +116,10 @@ func (r *Runner) subscribeToProxyInfraIR(ctx context.Context) {
 					return
 				}
 
+				infraReconcileTotal.With(infraTypeLabel.Value("proxy"), operationLabel.Value("create_or_update")).Increment()
 				if err := r.mgr.CreateOrUpdateProxyInfra(ctx, val); err != nil {
 					r.Logger.Error(err, "failed to create new infra")
+					infraReconcileErrorsTotal.With(infraTypeLabel.Value("proxy"), operationLabel.Value("create_or_update")).Increment()
 					errChan <- err
 				}
 			}
@@ -101,7 +129,9 @@ func (r *Runner) subscribeToProxyInfraIR(ctx context.Context) {
 }
 
 func (r *Runner) enableRateLimitInfra(ctx context.Context) {
+	infraReconcileTotal.With(infraTypeLabel.Value("ratelimit"), operationLabel.Value("create_or_update")).Increment()
 	if err := r.mgr.CreateOrUpdateRateLimitInfra(ctx); err != nil {
 		r.Logger.Error(err, "failed to create ratelimit infra")
+		infraReconcileErrorsTotal.With(infraTypeLabel.Value("ratelimit"), operationLabel.Value("create_or_update")).Increment()
 	}
 }
@@ -48,6 +48,9 @@ type ProxyInfra struct {
 	// Addresses contain the external addresses this gateway has been
 	// requested to be available at.
 	Addresses []string `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+	// WasmServer defines whether the proxies fetch the wasm modules of OCI images
+	// from the Wasm server of Envoy Gateway.
+	WasmServer bool `json:"wasmServer,omitempty" yaml:"wasmServer,omitempty"`
 }
 
 // InfraMetadata defines metadata for the managed proxy infrastructure.
@@ -233,6 +233,9 @@ type HTTPListener struct {
 	Timeout *ClientTimeout `json:"timeout,omitempty" yaml:"clientTimeout,omitempty"`
 	// Connection settings
 	Connection *Connection `json:"connection,omitempty" yaml:"connection,omitempty"`
+	// ExtensionPolicies holds unstructured policy resources that were introduced by an extension and target
+	// the listener or its Gateway
+	ExtensionPolicies []*UnstructuredRef `json:"extensionPolicies,omitempty" yaml:"extensionPolicies,omitempty"`
 }
 
 // Validate the fields within the HTTPListener structure
@@ -485,6 +488,8 @@ type HTTPRoute struct {
 	FaultInjection *FaultInjection `json:"faultInjection,omitempty" yaml:"faultInjection,omitempty"`
 	// ExtensionRefs holds unstructured resources that were introduced by an extension and used on the HTTPRoute as extensionRef filters
 	ExtensionRefs []*UnstructuredRef `json:"extensionRefs,omitempty" yaml:"extensionRefs,omitempty"`
+	// ExtensionPolicies holds unstructured policy resources that were introduced by an extension and target the HTTPRoute
+	ExtensionPolicies []*UnstructuredRef `json:"extensionPolicies,omitempty" yaml:"extensionPolicies,omitempty"`
 	// Circuit Breaker Settings
 	CircuitBreaker *CircuitBreaker `json:"circuitBreaker,omitempty" yaml:"circuitBreaker,omitempty"`
 	// Request and connection timeout settings
@@ -495,6 +500,8 @@ type HTTPRoute struct {
 	Retry *Retry `json:"retry,omitempty" yaml:"retry,omitempty"`
 	// External Processing extensions
 	ExtProcs []ExtProc `json:"extProc,omitempty" yaml:"extProc,omitempty"`
+	// Wasm extensions
+	Wasms []Wasm `json:"wasm,omitempty" yaml:"wasm,omitempty"`
 }
 
 // UnstructuredRef holds unstructured data for an arbitrary k8s resource introduced by an extension
@@ -872,6 +879,18 @@ type DestinationSetting struct {
 	AddressType *DestinationAddressType `json:"addressType,omitempty" yaml:"addressType,omitempty"`
 
 	TLS *TLSUpstreamConfig `json:"tls,omitempty" yaml:"tls,omitempty"`
+	// BackendRef is the backendRef of the route this destination was generated from.
+	BackendRef *BackendRef `json:"backendRef,omitempty" yaml:"backendRef,omitempty"`
+}
+
+// BackendRef identifies the backend resource referenced by a route.
+// +k8s:deepcopy-gen=true
+type BackendRef struct {
+	Group     string `json:"group,omitempty" yaml:"group,omitempty"`
+	Kind      string `json:"kind" yaml:"kind"`
+	Name      string `json:"name" yaml:"name"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	Port      uint32 `json:"port,omitempty" yaml:"port,omitempty"`
 }
 
 // Validate the fields within the RouteDestination structure
@@ -1854,3 +1873,40 @@ type ExtProc struct {
 	// Authority is the hostname:port of the HTTP External Processing service.
 	Authority string `json:"authority"`
 }
+
+// Wasm holds the information associated with the Wasm extensions.
+// +k8s:deepcopy-gen=true
+type Wasm struct {
+	// Name is a unique name for a Wasm configuration.
+	// The xds translator only generates one Wasm filter for each unique name.
+	Name string `json:"name" yaml:"name"`
+
+	// WasmName is the name of the Wasm extension, as defined in the policy.
+	// It is used to identify the extension in the logs and stats.
+	WasmName string `json:"wasmName" yaml:"wasmName"`
+
+	// Config is the configuration for the Wasm extension.
+	// This configuration will be passed as a JSON string to the Wasm extension.
+	Config *apiextensionsv1.JSON `json:"config,omitempty" yaml:"config,omitempty"`
+
+	// FailOpen is a switch used to control the behavior when a fatal error occurs
+	// during the initialization or the execution of the Wasm extension.
+	FailOpen bool `json:"failOpen" yaml:"failOpen"`
+
+	// Code is the HTTP location of the wasm code.
+	Code *HTTPWasmCode `json:"httpWasmCode,omitempty" yaml:"httpWasmCode,omitempty"`
+}
+
+// HTTPWasmCode holds the information associated with the HTTP Wasm code source.
+// +k8s:deepcopy-gen=true
+type HTTPWasmCode struct {
+	// URL is the URL the wasm code is fetched from by Envoy.
+	URL string `json:"url" yaml:"url"`
+
+	// SHA256 checksum that will be used to verify the wasm code.
+	SHA256 string `json:"sha256" yaml:"sha256"`
+
+	// OriginalURL is the URL of the OCI image the wasm code was pulled from, if any.
+	// The wasm code of OCI images is served to Envoy by Envoy Gateway at URL.
+	OriginalURL string `json:"originalURL,omitempty" yaml:"originalURL,omitempty"`
+}
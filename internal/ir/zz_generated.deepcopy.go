@@ -149,6 +149,21 @@ func (in *BackOffPolicy) DeepCopy() *BackOffPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendRef) DeepCopyInto(out *BackendRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendRef.
+func (in *BackendRef) DeepCopy() *BackendRef {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BasicAuth) DeepCopyInto(out *BasicAuth) {
 	*out = *in
@@ -414,6 +429,11 @@ func (in *DestinationSetting) DeepCopyInto(out *DestinationSetting) {
 		*out = new(TLSUpstreamConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(BackendRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationSetting.
@@ -842,6 +862,17 @@ func (in *HTTPListener) DeepCopyInto(out *HTTPListener) {
 		*out = new(Connection)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExtensionPolicies != nil {
+		in, out := &in.ExtensionPolicies, &out.ExtensionPolicies
+		*out = make([]*UnstructuredRef, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UnstructuredRef)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPListener.
@@ -1022,6 +1053,17 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 			}
 		}
 	}
+	if in.ExtensionPolicies != nil {
+		in, out := &in.ExtensionPolicies, &out.ExtensionPolicies
+		*out = make([]*UnstructuredRef, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UnstructuredRef)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.CircuitBreaker != nil {
 		in, out := &in.CircuitBreaker, &out.CircuitBreaker
 		*out = new(CircuitBreaker)
@@ -1049,6 +1091,13 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Wasms != nil {
+		in, out := &in.Wasms, &out.Wasms
+		*out = make([]Wasm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRoute.
@@ -1091,6 +1140,21 @@ func (in *HTTPTimeout) DeepCopy() *HTTPTimeout {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPWasmCode) DeepCopyInto(out *HTTPWasmCode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPWasmCode.
+func (in *HTTPWasmCode) DeepCopy() *HTTPWasmCode {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPWasmCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HeaderSettings) DeepCopyInto(out *HeaderSettings) {
 	*out = *in
@@ -2368,6 +2432,31 @@ func (in *UnstructuredRef) DeepCopy() *UnstructuredRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Wasm) DeepCopyInto(out *Wasm) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(HTTPWasmCode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Wasm.
+func (in *Wasm) DeepCopy() *Wasm {
+	if in == nil {
+		return nil
+	}
+	out := new(Wasm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Xds) DeepCopyInto(out *Xds) {
 	*out = *in
@@ -16,6 +16,8 @@ var (
 
 	watchableSubscribedErrorsTotal = metrics.NewCounter("watchable_subscribed_errors_total", "Total number of subscribed watchable errors.")
 
+	watchableCoalescedTotal = metrics.NewCounter("watchable_coalesced_total", "Total number of watchable updates coalesced with the following ones.")
+
 	runnerLabel = metrics.NewLabel("runner")
 
 	messageLabel = metrics.NewLabel("message")
@@ -0,0 +1,51 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package message
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceContexts carries the trace context of the updates of the keys of a
+// watchable map to its subscriber, so that the spans of the runners handling
+// an update belong to the trace of the provider update it originates from.
+// The trace context isn't stored with the values, since the updates of the
+// maps are deduplicated by comparing the values.
+type TraceContexts[K comparable] struct {
+	mu       sync.Mutex
+	contexts map[K]trace.SpanContext
+}
+
+// Inject records the span context of ctx, if any, for the next update of
+// the key. It's called before the update is stored.
+func (t *TraceContexts[K]) Inject(ctx context.Context, key K) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.contexts == nil {
+		t.contexts = make(map[K]trace.SpanContext)
+	}
+	t.contexts[key] = sc
+}
+
+// Extract returns ctx with the span context recorded for the update of the
+// key, if any, and forgets it.
+func (t *TraceContexts[K]) Extract(ctx context.Context, key K) context.Context {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sc, ok := t.contexts[key]
+	if !ok {
+		return ctx
+	}
+	delete(t.contexts, key)
+	return trace.ContextWithSpanContext(ctx, sc)
+}
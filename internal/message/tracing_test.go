@@ -0,0 +1,35 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package message
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceContexts(t *testing.T) {
+	var traces TraceContexts[string]
+	ctx := context.Background()
+
+	// Nothing is recorded without a span context
+	traces.Inject(ctx, "gw")
+	require.False(t, trace.SpanContextFromContext(traces.Extract(ctx, "gw")).IsValid())
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	traces.Inject(trace.ContextWithSpanContext(ctx, sc), "gw")
+
+	// The span context is only extracted for its key, and only once
+	require.False(t, trace.SpanContextFromContext(traces.Extract(ctx, "other")).IsValid())
+	require.Equal(t, sc, trace.SpanContextFromContext(traces.Extract(ctx, "gw")))
+	require.False(t, trace.SpanContextFromContext(traces.Extract(ctx, "gw")).IsValid())
+}
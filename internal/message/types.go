@@ -6,7 +6,10 @@
 package message
 
 import (
+	"slices"
+
 	"github.com/telepresenceio/watchable"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -21,6 +24,9 @@ type ProviderResources struct {
 	// GatewayAPIResources is a map from a GatewayClass name to
 	// a group of gateway API and other related resources.
 	GatewayAPIResources watchable.Map[string, *gatewayapi.ControllerResources]
+	// GatewayAPIResourcesTraces carries the trace of the updates of the
+	// GatewayAPIResources.
+	GatewayAPIResourcesTraces TraceContexts[string]
 
 	// GatewayAPIStatuses is a group of gateway api
 	// resource statuses maps.
@@ -28,6 +34,10 @@ type ProviderResources struct {
 
 	// PolicyStatuses is a group of policy statuses maps.
 	PolicyStatuses
+
+	// GatewayXdsNACKs is a map from a Gateway to the last xDS response
+	// rejected by the Envoy proxies serving it.
+	GatewayXdsNACKs watchable.Map[types.NamespacedName, *xdstypes.XdsNACK]
 }
 
 func (p *ProviderResources) GetResources() []*gatewayapi.Resources {
@@ -66,13 +76,14 @@ func (p *ProviderResources) Close() {
 	p.GatewayAPIResources.Close()
 	p.GatewayAPIStatuses.Close()
 	p.PolicyStatuses.Close()
+	p.GatewayXdsNACKs.Close()
 }
 
 // GatewayAPIStatuses contains gateway API resources statuses
 type GatewayAPIStatuses struct {
 	GatewayStatuses   watchable.Map[types.NamespacedName, *gwapiv1.GatewayStatus]
 	HTTPRouteStatuses watchable.Map[types.NamespacedName, *gwapiv1.HTTPRouteStatus]
-	GRPCRouteStatuses watchable.Map[types.NamespacedName, *gwapiv1a2.GRPCRouteStatus]
+	GRPCRouteStatuses watchable.Map[types.NamespacedName, *gwapiv1.GRPCRouteStatus]
 	TLSRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.TLSRouteStatus]
 	TCPRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.TCPRouteStatus]
 	UDPRouteStatuses  watchable.Map[types.NamespacedName, *gwapiv1a2.UDPRouteStatus]
@@ -95,6 +106,7 @@ type PolicyStatuses struct {
 	SecurityPolicyStatuses       watchable.Map[types.NamespacedName, *gwapiv1a2.PolicyStatus]
 	BackendTLSPolicyStatuses     watchable.Map[types.NamespacedName, *gwapiv1a2.PolicyStatus]
 	EnvoyExtensionPolicyStatuses watchable.Map[types.NamespacedName, *gwapiv1a2.PolicyStatus]
+	ExtensionPolicyStatuses      watchable.Map[NamespacedNameAndGVK, *gwapiv1a2.PolicyStatus]
 }
 
 func (p *PolicyStatuses) Close() {
@@ -103,11 +115,21 @@ func (p *PolicyStatuses) Close() {
 	p.EnvoyPatchPolicyStatuses.Close()
 	p.BackendTLSPolicyStatuses.Close()
 	p.EnvoyExtensionPolicyStatuses.Close()
+	p.ExtensionPolicyStatuses.Close()
+}
+
+// NamespacedNameAndGVK identifies a resource introduced by an extension,
+// whose kind isn't known to Envoy Gateway.
+type NamespacedNameAndGVK struct {
+	types.NamespacedName
+	schema.GroupVersionKind
 }
 
 // XdsIR message
 type XdsIR struct {
 	watchable.Map[string, *ir.Xds]
+	// Traces carries the trace of the updates of the xds IR.
+	Traces TraceContexts[string]
 }
 
 // InfraIR message
@@ -118,4 +140,25 @@ type InfraIR struct {
 // Xds message
 type Xds struct {
 	watchable.Map[string, *xdstypes.ResourceVersionTable]
+	// Traces carries the trace of the updates of the xds resources.
+	Traces TraceContexts[string]
+}
+
+// XdsIRKeys message, holding the keys of the xds IRs published by the last
+// translation of the provider resources, under the controller name.
+type XdsIRKeys struct {
+	watchable.Map[string, IRKeys]
+}
+
+// IRKeys is a list of IR keys.
+type IRKeys []string
+
+func (k IRKeys) DeepCopy() IRKeys {
+	return slices.Clone(k)
+}
+
+// XdsNACKs message, a map from an IR key to the last xDS response
+// rejected by the Envoy proxies.
+type XdsNACKs struct {
+	watchable.Map[string, *xdstypes.XdsNACK]
 }
@@ -79,3 +79,72 @@ func HandleSubscription[K comparable, V any](
 		}
 	}
 }
+
+// CoalesceSubscription takes a channel returned by watchable.Map.Subscribe()
+// and returns a channel receiving its snapshots, where the snapshots received
+// within the debounce window of each other are coalesced into one holding the
+// last state and the last update of each key. A snapshot waits at most maxDelay
+// while snapshots keep arriving. The returned channel is closed once the
+// subscription is.
+func CoalesceSubscription[K comparable, V any](
+	meta Metadata,
+	subscription <-chan watchable.Snapshot[K, V],
+	debounce, maxDelay time.Duration,
+) <-chan watchable.Snapshot[K, V] {
+	coalesced := make(chan watchable.Snapshot[K, V])
+	go func() {
+		defer close(coalesced)
+		for snapshot := range subscription {
+			deadline := time.Now().Add(maxDelay)
+			timer := time.NewTimer(min(debounce, maxDelay))
+			count, closed := 1, false
+		collect:
+			for {
+				select {
+				case next, ok := <-subscription:
+					if !ok {
+						closed = true
+						break collect
+					}
+					snapshot = mergeSnapshots(snapshot, next)
+					count++
+					if !timer.Stop() {
+						<-timer.C
+					}
+					timer.Reset(min(debounce, time.Until(deadline)))
+				case <-timer.C:
+					break collect
+				}
+			}
+			timer.Stop()
+
+			if count > 1 {
+				watchableCoalescedTotal.With(meta.LabelValues()...).Add(float64(count - 1))
+			}
+			coalesced <- snapshot
+			if closed {
+				return
+			}
+		}
+	}()
+	return coalesced
+}
+
+// mergeSnapshots merges the next snapshot into the previous one, keeping the
+// last update of each key.
+func mergeSnapshots[K comparable, V any](prev, next watchable.Snapshot[K, V]) watchable.Snapshot[K, V] {
+	updated := make(map[K]bool, len(next.Updates))
+	for _, update := range next.Updates {
+		updated[update.Key] = true
+	}
+	updates := make([]watchable.Update[K, V], 0, len(prev.Updates)+len(next.Updates))
+	for _, update := range prev.Updates {
+		if !updated[update.Key] {
+			updates = append(updates, update)
+		}
+	}
+	return watchable.Snapshot[K, V]{
+		State:   next.State,
+		Updates: append(updates, next.Updates...),
+	}
+}
@@ -7,6 +7,7 @@ package message_test
 
 import (
 	"context"
+	"maps"
 	"testing"
 	"time"
 
@@ -136,3 +137,72 @@ func TestXdsIRUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestCoalesceSubscription(t *testing.T) {
+	ch := make(chan watchable.Snapshot[string, int])
+	coalesced := message.CoalesceSubscription[string, int](
+		message.Metadata{Runner: "demo", Message: "demo"},
+		ch, 50*time.Millisecond, 200*time.Millisecond,
+	)
+
+	// The snapshots sent within the debounce window are coalesced
+	state := map[string]int{}
+	for i := 1; i <= 5; i++ {
+		state["foo"] = i
+		state["bar"] = -i
+		ch <- watchable.Snapshot[string, int]{
+			State: maps.Clone(state),
+			Updates: []watchable.Update[string, int]{
+				{Key: "foo", Value: i},
+				{Key: "bar", Value: -i},
+			},
+		}
+	}
+	delete(state, "bar")
+	ch <- watchable.Snapshot[string, int]{
+		State:   maps.Clone(state),
+		Updates: []watchable.Update[string, int]{{Key: "bar", Delete: true, Value: -5}},
+	}
+
+	snapshot := <-coalesced
+	assert.Equal(t, map[string]int{"foo": 5}, snapshot.State)
+	assert.Equal(t, []watchable.Update[string, int]{
+		{Key: "foo", Value: 5},
+		{Key: "bar", Delete: true, Value: -5},
+	}, snapshot.Updates)
+
+	// A snapshot isn't delayed longer than the max delay while snapshots keep
+	// arriving
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 40; i++ {
+			ch <- watchable.Snapshot[string, int]{
+				State:   map[string]int{"foo": i},
+				Updates: []watchable.Update[string, int]{{Key: "foo", Value: i}},
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	var last watchable.Snapshot[string, int]
+	select {
+	case last = <-coalesced:
+		assert.Less(t, last.State["foo"], 39)
+	case <-done:
+		t.Fatal("the snapshot was delayed until the end of the updates")
+	}
+	for sending := true; sending; {
+		select {
+		case last = <-coalesced:
+		case <-done:
+			sending = false
+		}
+	}
+
+	// The pending snapshot is sent before the channel is closed
+	close(ch)
+	for snapshot := range coalesced {
+		last = snapshot
+	}
+	assert.Equal(t, map[string]int{"foo": 39}, last.State)
+}
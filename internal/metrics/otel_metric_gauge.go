@@ -46,6 +46,8 @@ func (f *Gauge) With(labelValues ...LabelValue) *Gauge {
 		name:   f.name,
 		attrs:  attrs,
 	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 	if _, f := m.stores[set]; !f {
 		m.stores[set] = &GaugeValues{
 			opt: []api.ObserveOption{api.WithAttributeSet(set)},
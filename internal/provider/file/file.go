@@ -0,0 +1,249 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/tracing"
+)
+
+// Provider is the File provider. It reads the Gateway API and Envoy Gateway
+// resources defined in the configured paths, publishes them, and republishes
+// them whenever one of the files they are loaded from changes.
+//
+// Provider is also a sink.Sink, writing the status of every resource next to
+// the file it was loaded from.
+type Provider struct {
+	*statusWriter
+
+	paths            []string
+	controllerName   gwapiv1.GatewayController
+	defaultNamespace string
+	logger           logging.Logger
+	resources        *message.ProviderResources
+	closeElected     func()
+}
+
+// New creates a new File Provider from the provided EnvoyGateway.
+func New(svr *config.Server, resources *message.ProviderResources) (*Provider, error) {
+	custom := svr.EnvoyGateway.Provider.Custom
+	if custom == nil || custom.Resource.File == nil || len(custom.Resource.File.Paths) == 0 {
+		return nil, errors.New("no paths specified for the file provider")
+	}
+
+	paths := make([]string, 0, len(custom.Resource.File.Paths))
+	for _, path := range custom.Resource.File.Paths {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %s: %w", path, err)
+		}
+		paths = append(paths, abs)
+	}
+
+	return &Provider{
+		statusWriter:     newStatusWriter(),
+		paths:            paths,
+		controllerName:   gwapiv1.GatewayController(svr.EnvoyGateway.Gateway.ControllerName),
+		defaultNamespace: svr.Namespace,
+		logger:           svr.Logger,
+		resources:        resources,
+		closeElected:     svr.CloseElected,
+	}, nil
+}
+
+// Type returns the type of the provider.
+func (p *Provider) Type() v1alpha1.ResourceProviderType {
+	return v1alpha1.ResourceProviderTypeFile
+}
+
+// Start starts the Provider synchronously until a message is received from ctx.
+func (p *Provider) Start(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// Files are watched through their parent directory, so that they are still
+	// tracked after being replaced by an atomic rename, which is how most
+	// editors and ConfigMap volume mounts update them.
+	dirs := map[string]struct{}{}
+	for _, path := range p.paths {
+		dir := path
+		if fi, err := os.Stat(path); err != nil || !fi.IsDir() {
+			dir = filepath.Dir(path)
+		}
+		if _, ok := dirs[dir]; ok {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+		dirs[dir] = struct{}{}
+	}
+
+	// There is no leader election for the file provider, so signal it
+	// straight away to let the infrastructure runner proceed.
+	p.closeElected()
+
+	stats := p.stats()
+	p.reload(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod || isStatusFile(event.Name) {
+				continue
+			}
+			// The event may be about a file which is not loaded itself, e.g. the
+			// "..data" symlink swapped by the kubelet to update the files of a
+			// ConfigMap volume, so the loaded files are stat again to detect
+			// their changes.
+			current := p.stats()
+			if current.equal(stats) {
+				continue
+			}
+			stats = current
+			p.logger.Info("files changed", "name", event.Name, "op", event.Op.String())
+			p.reload(ctx)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			p.logger.Error(err, "file watcher error")
+		}
+	}
+}
+
+// fileStats are the stats of the files resources are loaded from, by path.
+type fileStats map[string]os.FileInfo
+
+// stats returns the stats of the files resources are loaded from. The
+// symlinks are followed, so that the stats change when their target does.
+func (p *Provider) stats() fileStats {
+	stats := fileStats{}
+	for _, file := range p.files() {
+		if fi, err := os.Stat(file); err == nil {
+			stats[file] = fi
+		}
+	}
+	return stats
+}
+
+// equal returns true if the files and their stats are the same.
+func (s fileStats) equal(other fileStats) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for file, fi := range s {
+		o, ok := other[file]
+		if !ok || !os.SameFile(fi, o) || !fi.ModTime().Equal(o.ModTime()) || fi.Size() != o.Size() {
+			return false
+		}
+	}
+	return true
+}
+
+// reload loads all the resources from the configured paths and stores them.
+// Invalid documents are logged and skipped, so that a single bad resource does
+// not prevent the rest of the configuration from being applied.
+func (p *Provider) reload(ctx context.Context) {
+	ctx, span := tracing.Tracer().Start(ctx, "provider.Reload")
+	defer span.End()
+
+	objs := newObjects()
+	origins := map[statusKey]origin{}
+	for _, file := range p.files() {
+		loaded, err := loadObjects(file)
+		if err != nil {
+			p.logger.Error(err, "failed to load resources", "file", file)
+		}
+		for _, obj := range loaded {
+			if err := objs.add(obj, p.defaultNamespace); err != nil {
+				p.logger.Error(err, "skipping resource", "file", file)
+				continue
+			}
+			if mo, ok := obj.(metav1.Object); ok {
+				gvk := obj.GetObjectKind().GroupVersionKind()
+				key := statusKey{kind: gvk.Kind, NamespacedName: types.NamespacedName{Namespace: mo.GetNamespace(), Name: mo.GetName()}}
+				origins[key] = origin{path: file, apiVersion: gvk.GroupVersion().String()}
+			}
+		}
+	}
+
+	if err := p.setOrigins(origins); err != nil {
+		p.logger.Error(err, "failed to write status files")
+	}
+
+	gwcResources, err := objs.controllerResources(p.controllerName)
+	if err != nil {
+		p.logger.Error(err, "failed to process gatewayclasses")
+	}
+
+	// The Store is triggered even when there are no resources, so that
+	// removing the last resource cleans up the translated output.
+	p.resources.GatewayAPIResourcesTraces.Inject(ctx, string(p.controllerName))
+	p.resources.GatewayAPIResources.Store(string(p.controllerName), &gwcResources)
+	p.logger.Info("loaded resources", "gatewayclasses", len(gwcResources))
+}
+
+// files returns the sorted list of files to load resources from. Directories
+// are not traversed recursively.
+func (p *Provider) files() []string {
+	var files []string
+	for _, path := range p.paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			p.logger.Error(err, "failed to stat path", "path", path)
+			continue
+		}
+		if !fi.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			p.logger.Error(err, "failed to read directory", "path", path)
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !isYAML(entry.Name()) || isStatusFile(entry.Name()) {
+				continue
+			}
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+
+	sort.Strings(files)
+	return files
+}
+
+func isYAML(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	return ext == ".yaml" || ext == ".yml"
+}
@@ -0,0 +1,190 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/message"
+)
+
+func TestControllerResources(t *testing.T) {
+	loaded, err := loadObjects(filepath.Join("testdata", "resources.yaml"))
+	require.NoError(t, err)
+
+	objs := newObjects()
+	for _, obj := range loaded {
+		require.NoError(t, objs.add(obj, "envoy-gateway-system"))
+	}
+
+	gwcResources, err := objs.controllerResources(v1alpha1.GatewayControllerName)
+	require.NoError(t, err)
+	require.Len(t, gwcResources, 1)
+
+	res := gwcResources[0]
+	require.Equal(t, "eg", res.GatewayClass.Name)
+	require.NotNil(t, res.EnvoyProxy)
+	require.Equal(t, "proxy-config", res.EnvoyProxy.Name)
+
+	// Only the Gateways of the managed GatewayClass are kept.
+	require.Len(t, res.Gateways, 1)
+	require.Equal(t, "eg", res.Gateways[0].Name)
+
+	// The EnvoyProxy referenced by the Gateway is kept for the Gateway.
+	require.Len(t, res.EnvoyProxiesForGateways, 1)
+	require.Equal(t, "eg-proxy-config", res.EnvoyProxiesForGateways[0].Name)
+
+	// The v1beta1 HTTPRoute is converted, and defaulted to the provided namespace.
+	require.Len(t, res.HTTPRoutes, 1)
+	require.Equal(t, gatewayapi.KindHTTPRoute, res.HTTPRoutes[0].Kind)
+	require.Equal(t, "envoy-gateway-system", res.HTTPRoutes[0].Namespace)
+
+	require.Len(t, res.Services, 1)
+	require.Len(t, res.BackendTrafficPolicies, 1)
+
+	// Namespaces referenced by objects are added.
+	var namespaces []string
+	for _, ns := range res.Namespaces {
+		namespaces = append(namespaces, ns.Name)
+	}
+	require.ElementsMatch(t, []string{"default", "envoy-gateway-system"}, namespaces)
+}
+
+func TestControllerResourcesMissingEnvoyProxy(t *testing.T) {
+	loaded, err := loadObjects(filepath.Join("testdata", "resources.yaml"))
+	require.NoError(t, err)
+
+	objs := newObjects()
+	for _, obj := range loaded {
+		if obj.GetObjectKind().GroupVersionKind().Kind == v1alpha1.KindEnvoyProxy {
+			continue
+		}
+		require.NoError(t, objs.add(obj, "envoy-gateway-system"))
+	}
+
+	gwcResources, err := objs.controllerResources(v1alpha1.GatewayControllerName)
+	require.Error(t, err)
+	require.Empty(t, gwcResources)
+}
+
+func TestDecodeObjectsInvalid(t *testing.T) {
+	objs, err := loadObjects(filepath.Join("testdata", "invalid.yaml"))
+	require.Error(t, err)
+	// The valid documents are still decoded.
+	require.Len(t, objs, 1)
+}
+
+func newTestConfig(t *testing.T, paths ...string) *config.Server {
+	t.Helper()
+
+	cfg, err := config.New()
+	require.NoError(t, err)
+	cfg.EnvoyGateway.Provider = &v1alpha1.EnvoyGatewayProvider{
+		Type: v1alpha1.ProviderTypeCustom,
+		Custom: &v1alpha1.EnvoyGatewayCustomProvider{
+			Resource: v1alpha1.EnvoyGatewayResourceProvider{
+				Type: v1alpha1.ResourceProviderTypeFile,
+				File: &v1alpha1.EnvoyGatewayFileResourceProvider{
+					Paths: paths,
+				},
+			},
+			Infrastructure: v1alpha1.EnvoyGatewayInfrastructureProvider{
+				Type: v1alpha1.InfrastructureProviderTypeHost,
+			},
+		},
+	}
+	return cfg
+}
+
+func TestStart(t *testing.T) {
+	dir := t.TempDir()
+	resources := new(message.ProviderResources)
+	t.Cleanup(resources.Close)
+
+	cfg := newTestConfig(t, dir)
+	p, err := New(cfg, resources)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		require.NoError(t, p.Start(ctx))
+	}()
+
+	// An empty set of resources is published at startup.
+	require.Eventually(t, func() bool {
+		res, ok := resources.GatewayAPIResources.Load(v1alpha1.GatewayControllerName)
+		return ok && len(*res) == 0
+	}, 5*time.Second, 50*time.Millisecond)
+	select {
+	case <-cfg.Elected:
+	default:
+		t.Fatal("expected the file provider to signal election")
+	}
+
+	data, err := os.ReadFile(filepath.Join("testdata", "resources.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "resources.yaml"), data, 0o600))
+
+	require.Eventually(t, func() bool {
+		res, ok := resources.GatewayAPIResources.Load(v1alpha1.GatewayControllerName)
+		return ok && len(*res) == 1 && len((*res)[0].Gateways) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "resources.yaml")))
+
+	require.Eventually(t, func() bool {
+		res, ok := resources.GatewayAPIResources.Load(v1alpha1.GatewayControllerName)
+		return ok && len(*res) == 0
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestStartConfigMapVolume(t *testing.T) {
+	// The kubelet updates the files of a ConfigMap volume by swapping the
+	// "..data" symlink to a new directory, the files being symlinks to "..data".
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..v1"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "..v1", "resources.yaml"), nil, 0o600))
+	require.NoError(t, os.Symlink("..v1", filepath.Join(dir, "..data")))
+	require.NoError(t, os.Symlink(filepath.Join("..data", "resources.yaml"), filepath.Join(dir, "resources.yaml")))
+
+	resources := new(message.ProviderResources)
+	t.Cleanup(resources.Close)
+	p, err := New(newTestConfig(t, filepath.Join(dir, "resources.yaml")), resources)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		require.NoError(t, p.Start(ctx))
+	}()
+
+	require.Eventually(t, func() bool {
+		res, ok := resources.GatewayAPIResources.Load(v1alpha1.GatewayControllerName)
+		return ok && len(*res) == 0
+	}, 5*time.Second, 50*time.Millisecond)
+
+	data, err := os.ReadFile(filepath.Join("testdata", "resources.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..v2"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "..v2", "resources.yaml"), data, 0o600))
+	require.NoError(t, os.Symlink("..v2", filepath.Join(dir, "..data_tmp")))
+	require.NoError(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+
+	require.Eventually(t, func() bool {
+		res, ok := resources.GatewayAPIResources.Load(v1alpha1.GatewayControllerName)
+		return ok && len(*res) == 1 && len((*res)[0].Gateways) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+}
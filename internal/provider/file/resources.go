@@ -0,0 +1,311 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	mcsapi "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/api/v1alpha1/validation"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+)
+
+// decoder decodes YAML or JSON documents into the typed objects registered
+// in the Envoy Gateway scheme.
+var decoder = serializer.NewCodecFactory(envoygateway.GetScheme()).UniversalDeserializer()
+
+// loadObjects reads all the objects defined in the multi-document YAML file.
+// Documents that cannot be decoded are reported through the returned error,
+// while the successfully decoded objects are still returned.
+func loadObjects(path string) ([]runtime.Object, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return decodeObjects(f)
+}
+
+// decodeObjects decodes all the documents from the provided reader.
+func decodeObjects(r io.Reader) ([]runtime.Object, error) {
+	var (
+		objs []runtime.Object
+		errs error
+	)
+
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return objs, errors.Join(errs, err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		objs = append(objs, obj)
+	}
+
+	return objs, errs
+}
+
+// objects holds all the objects loaded from the file provider paths,
+// grouped by type.
+type objects struct {
+	gatewayClasses []*gwapiv1.GatewayClass
+	resources      *gatewayapi.Resources
+	envoyProxies   []*egv1a1.EnvoyProxy
+}
+
+func newObjects() *objects {
+	return &objects{
+		resources: gatewayapi.NewResources(),
+	}
+}
+
+// add adds the object to the typed collection it belongs to. Namespaced objects
+// without a namespace are placed in defaultNamespace, mirroring what kubectl
+// does when applying them.
+func (o *objects) add(obj runtime.Object, defaultNamespace string) error {
+	if mo, ok := obj.(metav1.Object); ok && mo.GetNamespace() == "" && !clusterScoped(obj) {
+		mo.SetNamespace(defaultNamespace)
+	}
+
+	res := o.resources
+	switch obj := obj.(type) {
+	case *gwapiv1.GatewayClass:
+		o.gatewayClasses = append(o.gatewayClasses, obj)
+	case *gwapiv1b1.GatewayClass:
+		o.gatewayClasses = append(o.gatewayClasses, (*gwapiv1.GatewayClass)(obj))
+	case *gwapiv1.Gateway:
+		res.Gateways = append(res.Gateways, obj)
+	case *gwapiv1b1.Gateway:
+		res.Gateways = append(res.Gateways, (*gwapiv1.Gateway)(obj))
+	case *gwapiv1.HTTPRoute:
+		res.HTTPRoutes = append(res.HTTPRoutes, obj)
+	case *gwapiv1b1.HTTPRoute:
+		res.HTTPRoutes = append(res.HTTPRoutes, (*gwapiv1.HTTPRoute)(obj))
+	case *gwapiv1.GRPCRoute:
+		res.GRPCRoutes = append(res.GRPCRoutes, (*gwapiv1a2.GRPCRoute)(obj))
+	case *gwapiv1a2.GRPCRoute:
+		res.GRPCRoutes = append(res.GRPCRoutes, obj)
+	case *gwapiv1a2.TLSRoute:
+		res.TLSRoutes = append(res.TLSRoutes, obj)
+	case *gwapiv1a2.TCPRoute:
+		res.TCPRoutes = append(res.TCPRoutes, obj)
+	case *gwapiv1a2.UDPRoute:
+		res.UDPRoutes = append(res.UDPRoutes, obj)
+	case *gwapiv1b1.ReferenceGrant:
+		res.ReferenceGrants = append(res.ReferenceGrants, obj)
+	case *gwapiv1a2.ReferenceGrant:
+		res.ReferenceGrants = append(res.ReferenceGrants, (*gwapiv1b1.ReferenceGrant)(obj))
+	case *gwapiv1a3.BackendTLSPolicy:
+		res.BackendTLSPolicies = append(res.BackendTLSPolicies, obj)
+	case *corev1.Namespace:
+		res.Namespaces = append(res.Namespaces, obj)
+	case *corev1.Service:
+		res.Services = append(res.Services, obj)
+	case *corev1.Secret:
+		res.Secrets = append(res.Secrets, obj)
+	case *corev1.ConfigMap:
+		res.ConfigMaps = append(res.ConfigMaps, obj)
+	case *discoveryv1.EndpointSlice:
+		res.EndpointSlices = append(res.EndpointSlices, obj)
+	case *mcsapi.ServiceImport:
+		res.ServiceImports = append(res.ServiceImports, obj)
+	case *egv1a1.EnvoyProxy:
+		o.envoyProxies = append(o.envoyProxies, obj)
+	case *egv1a1.EnvoyPatchPolicy:
+		res.EnvoyPatchPolicies = append(res.EnvoyPatchPolicies, obj)
+	case *egv1a1.ClientTrafficPolicy:
+		res.ClientTrafficPolicies = append(res.ClientTrafficPolicies, obj)
+	case *egv1a1.BackendTrafficPolicy:
+		res.BackendTrafficPolicies = append(res.BackendTrafficPolicies, obj)
+	case *egv1a1.SecurityPolicy:
+		res.SecurityPolicies = append(res.SecurityPolicies, obj)
+	case *egv1a1.EnvoyExtensionPolicy:
+		res.EnvoyExtensionPolicies = append(res.EnvoyExtensionPolicies, obj)
+	default:
+		return fmt.Errorf("unsupported resource type %s", obj.GetObjectKind().GroupVersionKind())
+	}
+
+	return nil
+}
+
+// clusterScoped returns true if the object is not namespaced.
+func clusterScoped(obj runtime.Object) bool {
+	switch obj.(type) {
+	case *gwapiv1.GatewayClass, *gwapiv1b1.GatewayClass, *corev1.Namespace:
+		return true
+	default:
+		return false
+	}
+}
+
+// controllerResources groups the loaded objects per GatewayClass managed by
+// the given controller, in the same shape the Kubernetes provider publishes them.
+func (o *objects) controllerResources(controllerName gwapiv1.GatewayController) (gatewayapi.ControllerResources, error) {
+	sort.Slice(o.gatewayClasses, func(i, j int) bool {
+		return o.gatewayClasses[i].Name < o.gatewayClasses[j].Name
+	})
+
+	namespaces := o.namespaces()
+
+	var errs error
+	gwcResources := make(gatewayapi.ControllerResources, 0, len(o.gatewayClasses))
+	for _, gc := range o.gatewayClasses {
+		if gc.Spec.ControllerName != controllerName {
+			continue
+		}
+
+		res := o.resources.DeepCopy()
+		res.GatewayClass = gc
+		res.Namespaces = namespaces
+		res.Gateways = res.Gateways[:0]
+		for _, gtw := range o.resources.Gateways {
+			if string(gtw.Spec.GatewayClassName) == gc.Name {
+				res.Gateways = append(res.Gateways, gtw.DeepCopy())
+			}
+		}
+		res.EnvoyProxiesForGateways = o.envoyProxiesForGateways(res.Gateways)
+
+		if gc.Spec.ParametersRef != nil {
+			ep, err := o.envoyProxyForClass(gc)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			res.EnvoyProxy = ep
+		}
+
+		gwcResources = append(gwcResources, res)
+	}
+
+	return gwcResources, errs
+}
+
+// namespaces returns the explicitly defined namespaces, and a placeholder
+// for every namespace that is referenced by an object but not defined.
+func (o *objects) namespaces() []*corev1.Namespace {
+	known := make(map[string]struct{}, len(o.resources.Namespaces))
+	namespaces := make([]*corev1.Namespace, 0, len(o.resources.Namespaces))
+	for _, ns := range o.resources.Namespaces {
+		known[ns.Name] = struct{}{}
+		namespaces = append(namespaces, ns)
+	}
+
+	var referenced []string
+	addNamespace := func(ns string) {
+		if _, ok := known[ns]; ok || ns == "" {
+			return
+		}
+		known[ns] = struct{}{}
+		referenced = append(referenced, ns)
+	}
+	for _, gtw := range o.resources.Gateways {
+		addNamespace(gtw.Namespace)
+	}
+	for _, route := range o.resources.HTTPRoutes {
+		addNamespace(route.Namespace)
+	}
+	for _, route := range o.resources.GRPCRoutes {
+		addNamespace(route.Namespace)
+	}
+	for _, route := range o.resources.TLSRoutes {
+		addNamespace(route.Namespace)
+	}
+	for _, route := range o.resources.TCPRoutes {
+		addNamespace(route.Namespace)
+	}
+	for _, route := range o.resources.UDPRoutes {
+		addNamespace(route.Namespace)
+	}
+	for _, svc := range o.resources.Services {
+		addNamespace(svc.Namespace)
+	}
+
+	sort.Strings(referenced)
+	for _, ns := range referenced {
+		namespaces = append(namespaces, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: ns,
+			},
+		})
+	}
+
+	return namespaces
+}
+
+// envoyProxyForClass returns the EnvoyProxy referenced by the parametersRef
+// of the GatewayClass.
+func (o *objects) envoyProxyForClass(gc *gwapiv1.GatewayClass) (*egv1a1.EnvoyProxy, error) {
+	ref := gc.Spec.ParametersRef
+	if string(ref.Group) != egv1a1.GroupVersion.Group || ref.Kind != egv1a1.KindEnvoyProxy || ref.Namespace == nil {
+		return nil, fmt.Errorf("unsupported parametersRef for gatewayclass %s", gc.Name)
+	}
+
+	for _, ep := range o.envoyProxies {
+		if ep.Namespace == string(*ref.Namespace) && ep.Name == ref.Name {
+			if err := validation.ValidateEnvoyProxy(ep); err != nil {
+				return nil, fmt.Errorf("invalid envoyproxy: %w", err)
+			}
+			return ep, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to find envoyproxy referenced by gatewayclass: %s", gc.Name)
+}
+
+// envoyProxiesForGateways returns the EnvoyProxies referenced by the parametersRef
+// of the infrastructure of the Gateways. They are merged on top of the EnvoyProxy of
+// the GatewayClass, and validated, by the translator.
+func (o *objects) envoyProxiesForGateways(gateways []*gwapiv1.Gateway) []*egv1a1.EnvoyProxy {
+	var envoyProxies []*egv1a1.EnvoyProxy
+	for _, ep := range o.envoyProxies {
+		for _, gtw := range gateways {
+			if gtw.Spec.Infrastructure == nil || gtw.Spec.Infrastructure.ParametersRef == nil {
+				continue
+			}
+			// The EnvoyProxy must be in the same namespace as the Gateway.
+			ref := gtw.Spec.Infrastructure.ParametersRef
+			if string(ref.Group) == egv1a1.GroupVersion.Group && ref.Kind == egv1a1.KindEnvoyProxy &&
+				ep.Namespace == gtw.Namespace && ep.Name == ref.Name {
+				envoyProxies = append(envoyProxies, ep.DeepCopy())
+				break
+			}
+		}
+	}
+
+	return envoyProxies
+}
@@ -0,0 +1,186 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package file
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/envoyproxy/gateway/internal/status/sink"
+)
+
+// statusFileSuffix is the suffix of the files holding the statuses of the
+// resources defined in the file with the same base name.
+const statusFileSuffix = ".status.yaml"
+
+var _ sink.Sink = (*statusWriter)(nil)
+
+// statusKey identifies a resource by kind and name.
+type statusKey struct {
+	kind string
+	types.NamespacedName
+}
+
+// origin is the file a resource was loaded from.
+type origin struct {
+	path       string
+	apiVersion string
+}
+
+// statusWriter is a sink.Sink writing the statuses of the resources into a
+// "<name>.status.yaml" file next to the "<name>.yaml" file each resource was
+// loaded from.
+type statusWriter struct {
+	mu       sync.Mutex
+	origins  map[statusKey]origin
+	statuses map[statusKey]any
+}
+
+func newStatusWriter() *statusWriter {
+	return &statusWriter{
+		origins:  map[statusKey]origin{},
+		statuses: map[statusKey]any{},
+	}
+}
+
+// statusDocument is the YAML document written for every resource.
+type statusDocument struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        statusMetadata `json:"metadata"`
+	Status          any            `json:"status"`
+}
+
+type statusMetadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// setOrigins replaces the files the resources were loaded from, and rewrites
+// the status files that are affected.
+func (w *statusWriter) setOrigins(origins map[statusKey]origin) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	paths := map[string]struct{}{}
+	for _, o := range w.origins {
+		paths[o.path] = struct{}{}
+	}
+	for _, o := range origins {
+		paths[o.path] = struct{}{}
+	}
+	w.origins = origins
+
+	var errs error
+	for path := range paths {
+		errs = errors.Join(errs, w.write(path))
+	}
+	return errs
+}
+
+// Update implements sink.Sink.
+func (w *statusWriter) Update(kind string, key types.NamespacedName, status any) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	k := statusKey{kind: kind, NamespacedName: key}
+	w.statuses[k] = status
+	if o, ok := w.origins[k]; ok {
+		return w.write(o.path)
+	}
+	return nil
+}
+
+// Delete implements sink.Sink.
+func (w *statusWriter) Delete(kind string, key types.NamespacedName) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	k := statusKey{kind: kind, NamespacedName: key}
+	delete(w.statuses, k)
+	if o, ok := w.origins[k]; ok {
+		return w.write(o.path)
+	}
+	return nil
+}
+
+// write writes the statuses of all the resources loaded from the file into
+// its status file, or removes the status file when there are none.
+func (w *statusWriter) write(path string) error {
+	var keys []statusKey
+	for k, o := range w.origins {
+		if _, ok := w.statuses[k]; ok && o.path == path {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].kind != keys[j].kind {
+			return keys[i].kind < keys[j].kind
+		}
+		return keys[i].String() < keys[j].String()
+	})
+
+	statusPath := statusFilePath(path)
+	if len(keys) == 0 {
+		if err := os.Remove(statusPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove status file %s: %w", statusPath, err)
+		}
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for i, k := range keys {
+		doc, err := yaml.Marshal(statusDocument{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: w.origins[k].apiVersion,
+				Kind:       k.kind,
+			},
+			Metadata: statusMetadata{
+				Name:      k.Name,
+				Namespace: k.Namespace,
+			},
+			Status: w.statuses[k],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal status of %s %s: %w", k.kind, k.String(), err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(doc)
+	}
+
+	// Skip unchanged files, to avoid needless writes on every translation.
+	if current, err := os.ReadFile(statusPath); err == nil && bytes.Equal(current, buf.Bytes()) {
+		return nil
+	}
+	if err := os.WriteFile(statusPath, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write status file %s: %w", statusPath, err)
+	}
+	return nil
+}
+
+// statusFilePath returns the path of the status file for the resources
+// loaded from the file, e.g. "gateway.status.yaml" for "gateway.yaml".
+func statusFilePath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + statusFileSuffix
+}
+
+// isStatusFile returns true if the file is a status file written by the File
+// provider, which must not be loaded as resources.
+func isStatusFile(name string) bool {
+	ext := filepath.Ext(name)
+	return strings.HasSuffix(strings.TrimSuffix(strings.ToLower(name), strings.ToLower(ext)), ".status")
+}
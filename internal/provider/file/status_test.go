@@ -0,0 +1,81 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/message"
+)
+
+func TestStatusWriter(t *testing.T) {
+	dir := t.TempDir()
+	data, err := os.ReadFile(filepath.Join("testdata", "resources.yaml"))
+	require.NoError(t, err)
+	resourcesFile := filepath.Join(dir, "resources.yaml")
+	require.NoError(t, os.WriteFile(resourcesFile, data, 0o600))
+
+	resources := new(message.ProviderResources)
+	t.Cleanup(resources.Close)
+	p, err := New(newTestConfig(t, dir), resources)
+	require.NoError(t, err)
+	p.reload(context.Background())
+
+	statusFile := filepath.Join(dir, "resources.status.yaml")
+	require.NoFileExists(t, statusFile)
+
+	gtw := types.NamespacedName{Namespace: "default", Name: "eg"}
+	require.NoError(t, p.Update(gatewayapi.KindGateway, gtw, &gwapiv1.GatewayStatus{
+		Conditions: []metav1.Condition{{
+			Type:   string(gwapiv1.GatewayConditionProgrammed),
+			Status: metav1.ConditionTrue,
+			Reason: string(gwapiv1.GatewayReasonProgrammed),
+		}},
+	}))
+	got, err := os.ReadFile(statusFile)
+	require.NoError(t, err)
+	require.Equal(t, `apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: eg
+  namespace: default
+status:
+  conditions:
+  - lastTransitionTime: null
+    message: ""
+    reason: Programmed
+    status: "True"
+    type: Programmed
+`, string(got))
+
+	// The status file is not loaded as resources.
+	require.Equal(t, []string{resourcesFile}, p.files())
+	require.NotContains(t, p.stats(), statusFile)
+
+	// Statuses of resources that are not loaded from a file are ignored.
+	require.NoError(t, p.Update(gatewayapi.KindGateway, types.NamespacedName{Namespace: "default", Name: "unknown"}, &gwapiv1.GatewayStatus{}))
+
+	require.NoError(t, p.Delete(gatewayapi.KindGateway, gtw))
+	require.NoFileExists(t, statusFile)
+}
+
+func TestStatusFilePath(t *testing.T) {
+	require.Equal(t, "/etc/eg/gateway.status.yaml", statusFilePath("/etc/eg/gateway.yaml"))
+	require.Equal(t, "/etc/eg/gateway.status.yaml", statusFilePath("/etc/eg/gateway.yml"))
+	require.True(t, isStatusFile("gateway.status.yaml"))
+	require.True(t, isStatusFile("gateway.STATUS.yml"))
+	require.False(t, isStatusFile("gateway.yaml"))
+	require.False(t, isStatusFile("status.yaml"))
+}
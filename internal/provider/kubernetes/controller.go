@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	mcsapi "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
 
@@ -39,22 +40,24 @@ import (
 	"github.com/envoyproxy/gateway/internal/logging"
 	"github.com/envoyproxy/gateway/internal/message"
 	"github.com/envoyproxy/gateway/internal/status"
+	"github.com/envoyproxy/gateway/internal/tracing"
 	"github.com/envoyproxy/gateway/internal/utils"
 	"github.com/envoyproxy/gateway/internal/utils/slice"
 )
 
 type gatewayAPIReconciler struct {
-	client          client.Client
-	log             logging.Logger
-	statusUpdater   status.Updater
-	classController gwapiv1.GatewayController
-	store           *kubernetesProviderStore
-	namespace       string
-	namespaceLabel  *metav1.LabelSelector
-	envoyGateway    *egv1a1.EnvoyGateway
-	mergeGateways   sets.Set[string]
-	resources       *message.ProviderResources
-	extGVKs         []schema.GroupVersionKind
+	client            client.Client
+	log               logging.Logger
+	statusUpdater     status.Updater
+	classController   gwapiv1.GatewayController
+	store             *kubernetesProviderStore
+	namespace         string
+	namespaceLabel    *metav1.LabelSelector
+	envoyGateway      *egv1a1.EnvoyGateway
+	mergeGateways     sets.Set[string]
+	resources         *message.ProviderResources
+	extGVKs           []schema.GroupVersionKind
+	extServerPolicies []schema.GroupVersionKind
 }
 
 // newGatewayAPIController
@@ -63,12 +66,16 @@ func newGatewayAPIController(mgr manager.Manager, cfg *config.Server, su status.
 	ctx := context.Background()
 
 	// Gather additional resources to watch from registered extensions
-	var extGVKs []schema.GroupVersionKind
+	var extGVKs, extServerPoliciesGVKs []schema.GroupVersionKind
 	if cfg.EnvoyGateway.ExtensionManager != nil {
 		for _, rsrc := range cfg.EnvoyGateway.ExtensionManager.Resources {
 			gvk := schema.GroupVersionKind(rsrc)
 			extGVKs = append(extGVKs, gvk)
 		}
+		for _, rsrc := range cfg.EnvoyGateway.ExtensionManager.PolicyResources {
+			gvk := schema.GroupVersionKind(rsrc)
+			extServerPoliciesGVKs = append(extServerPoliciesGVKs, gvk)
+		}
 	}
 
 	byNamespaceSelector := cfg.EnvoyGateway.Provider != nil &&
@@ -79,16 +86,17 @@ func newGatewayAPIController(mgr manager.Manager, cfg *config.Server, su status.
 			len(cfg.EnvoyGateway.Provider.Kubernetes.Watch.NamespaceSelector.MatchExpressions) > 0)
 
 	r := &gatewayAPIReconciler{
-		client:          mgr.GetClient(),
-		log:             cfg.Logger,
-		classController: gwapiv1.GatewayController(cfg.EnvoyGateway.Gateway.ControllerName),
-		namespace:       cfg.Namespace,
-		statusUpdater:   su,
-		resources:       resources,
-		extGVKs:         extGVKs,
-		store:           newProviderStore(),
-		envoyGateway:    cfg.EnvoyGateway,
-		mergeGateways:   sets.New[string](),
+		client:            mgr.GetClient(),
+		log:               cfg.Logger,
+		classController:   gwapiv1.GatewayController(cfg.EnvoyGateway.Gateway.ControllerName),
+		namespace:         cfg.Namespace,
+		statusUpdater:     su,
+		resources:         resources,
+		extGVKs:           extGVKs,
+		extServerPolicies: extServerPoliciesGVKs,
+		store:             newProviderStore(),
+		envoyGateway:      cfg.EnvoyGateway,
+		mergeGateways:     sets.New[string](),
 	}
 
 	if byNamespaceSelector {
@@ -133,11 +141,13 @@ func newResourceMapping() *resourceMappings {
 // Reconcile handles reconciling all resources in a single call. Any resource event should enqueue the
 // same reconcile.Request containing the gateway controller name. This allows multiple resource updates to
 // be handled by a single call to Reconcile. The reconcile.Request DOES NOT map to a specific resource.
-func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
-	var (
-		managedGCs []*gwapiv1.GatewayClass
-		err        error
-	)
+func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, _ reconcile.Request) (_ reconcile.Result, err error) {
+	// The trace of the reconciliation follows the resources through the
+	// translations, up to the push of the xDS snapshots to the Envoy proxies.
+	ctx, span := tracing.Tracer().Start(ctx, "provider.Reconcile")
+	defer func() { tracing.End(span, err) }()
+
+	var managedGCs []*gwapiv1.GatewayClass
 	r.log.Info("reconciling gateways")
 
 	// Get the GatewayClasses managed by the Envoy Gateway Controller.
@@ -204,6 +214,11 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, _ reconcile.Reques
 			return reconcile.Result{}, err
 		}
 
+		// Add all the policies introduced by the extension to the resourceTree
+		if err = r.processExtensionServerPolicies(ctx, gwcResource); err != nil {
+			return reconcile.Result{}, err
+		}
+
 		// Add the referenced services, ServiceImports, and EndpointSlices in
 		// the collected BackendRefs to the resourceTree.
 		// BackendRefs are referred by various Route objects and the ExtAuth in SecurityPolicies.
@@ -272,6 +287,7 @@ func (r *gatewayAPIReconciler) Reconcile(ctx context.Context, _ reconcile.Reques
 	// The Store is triggered even when there are no Gateways associated to the
 	// GatewayClass. This would happen in case the last Gateway is removed and the
 	// Store will be required to trigger a cleanup of envoy infra resources.
+	r.resources.GatewayAPIResourcesTraces.Inject(ctx, string(r.classController))
 	r.resources.GatewayAPIResources.Store(string(r.classController), &gwcResources)
 
 	r.log.Info("reconciled gateways successfully")
@@ -744,6 +760,13 @@ func (r *gatewayAPIReconciler) processGateways(ctx context.Context, managedGC *g
 			}
 		}
 
+		// Get the EnvoyProxy referenced by the infrastructure of the gateway if it exists.
+		if gatewayRefsEnvoyProxy(&gtw) {
+			if err := r.processGatewayParamsRef(ctx, &gtw, resourceTree); err != nil {
+				return err
+			}
+		}
+
 		// Route Processing
 		// Get TLSRoute objects and check if it exists.
 		if err := r.processTLSRoutes(ctx, utils.NamespacedName(&gtw).String(), resourceMap, resourceTree); err != nil {
@@ -835,6 +858,23 @@ func (r *gatewayAPIReconciler) processBackendTrafficPolicies(ctx context.Context
 	return nil
 }
 
+// processExtensionServerPolicies adds the policies introduced by the extension to the resourceTree
+func (r *gatewayAPIReconciler) processExtensionServerPolicies(ctx context.Context, resourceTree *gatewayapi.Resources) error {
+	policies, err := r.getExtensionServerPolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		policy := policy
+		// Discard Status to reduce memory consumption in watchable
+		// It will be recomputed by the gateway-api layer
+		delete(policy.Object, "status")
+		resourceTree.ExtensionServerPolicies = append(resourceTree.ExtensionServerPolicies, policy)
+	}
+	return nil
+}
+
 // processSecurityPolicies adds SecurityPolicies and their referenced resources to the resourceTree
 func (r *gatewayAPIReconciler) processSecurityPolicies(
 	ctx context.Context, resourceTree *gatewayapi.Resources, resourceMap *resourceMappings) error {
@@ -862,7 +902,7 @@ func (r *gatewayAPIReconciler) processSecurityPolicies(
 // processBackendTLSPolicies adds BackendTLSPolicies and their referenced resources to the resourceTree
 func (r *gatewayAPIReconciler) processBackendTLSPolicies(
 	ctx context.Context, resourceTree *gatewayapi.Resources, resourceMap *resourceMappings) error {
-	backendTLSPolicies := gwapiv1a2.BackendTLSPolicyList{}
+	backendTLSPolicies := gwapiv1a3.BackendTLSPolicyList{}
 	if err := r.client.List(ctx, &backendTLSPolicies); err != nil {
 		return fmt.Errorf("error listing BackendTLSPolicies: %w", err)
 	}
@@ -910,17 +950,16 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 	// process status updates and infrastructure changes. This step is crucial for synchronizing resources
 	// that may have been altered or introduced while there was no elected leader.
 	if err := c.Watch(
-		NewWatchAndReconcileSource(mgr.Elected(), &gwapiv1.GatewayClass{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass)); err != nil {
+		NewWatchAndReconcileSource(mgr.Elected(), &gwapiv1.GatewayClass{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass))); err != nil {
 		return err
 	}
 
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &gwapiv1.GatewayClass{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		predicate.GenerationChangedPredicate{},
-		predicate.NewPredicateFuncs(r.hasMatchingController),
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &gwapiv1.GatewayClass{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			predicate.GenerationChangedPredicate{},
+			predicate.NewPredicateFuncs(r.hasMatchingController))); err != nil {
 		return err
 	}
 
@@ -934,10 +973,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		epPredicates = append(epPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &egv1a1.EnvoyProxy{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		epPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &egv1a1.EnvoyProxy{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			epPredicates...)); err != nil {
 		return err
 	}
 
@@ -950,10 +988,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		gPredicates = append(gPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &gwapiv1.Gateway{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		gPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &gwapiv1.Gateway{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			gPredicates...)); err != nil {
 		return err
 	}
 	if err := addGatewayIndexers(ctx, mgr); err != nil {
@@ -966,10 +1003,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		httprPredicates = append(httprPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &gwapiv1.HTTPRoute{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		httprPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &gwapiv1.HTTPRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			httprPredicates...)); err != nil {
 		return err
 	}
 	if err := addHTTPRouteIndexers(ctx, mgr); err != nil {
@@ -982,10 +1018,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		grpcrPredicates = append(grpcrPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &gwapiv1a2.GRPCRoute{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		grpcrPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &gwapiv1a2.GRPCRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			grpcrPredicates...)); err != nil {
 		return err
 	}
 	if err := addGRPCRouteIndexers(ctx, mgr); err != nil {
@@ -998,10 +1033,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		tlsrPredicates = append(tlsrPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &gwapiv1a2.TLSRoute{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		tlsrPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &gwapiv1a2.TLSRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			tlsrPredicates...)); err != nil {
 		return err
 	}
 	if err := addTLSRouteIndexers(ctx, mgr); err != nil {
@@ -1014,10 +1048,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		udprPredicates = append(udprPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &gwapiv1a2.UDPRoute{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		udprPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &gwapiv1a2.UDPRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			udprPredicates...)); err != nil {
 		return err
 	}
 	if err := addUDPRouteIndexers(ctx, mgr); err != nil {
@@ -1030,10 +1063,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		tcprPredicates = append(tcprPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &gwapiv1a2.TCPRoute{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		tcprPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &gwapiv1a2.TCPRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			tcprPredicates...)); err != nil {
 		return err
 	}
 	if err := addTCPRouteIndexers(ctx, mgr); err != nil {
@@ -1046,10 +1078,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		servicePredicates = append(servicePredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &corev1.Service{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		servicePredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &corev1.Service{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			servicePredicates...)); err != nil {
 		return err
 	}
 
@@ -1061,10 +1092,10 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 	// Watch ServiceImport CRUDs and process affected *Route objects.
 	if serviceImportCRDExists {
 		if err := c.Watch(
-			source.Kind(mgr.GetCache(), &mcsapi.ServiceImport{}),
-			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-			predicate.GenerationChangedPredicate{},
-			predicate.NewPredicateFuncs(r.validateServiceImportForReconcile)); err != nil {
+			source.Kind[client.Object](mgr.GetCache(), &mcsapi.ServiceImport{},
+				handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+				predicate.GenerationChangedPredicate{},
+				predicate.NewPredicateFuncs(r.validateServiceImportForReconcile))); err != nil {
 			// ServiceImport is not available in the cluster, skip the watch and not throw error.
 			r.log.Info("unable to watch ServiceImport: %s", err.Error())
 		}
@@ -1079,10 +1110,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		esPredicates = append(esPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &discoveryv1.EndpointSlice{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		esPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &discoveryv1.EndpointSlice{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			esPredicates...)); err != nil {
 		return err
 	}
 
@@ -1097,10 +1127,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 	}
 	// resource address.
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &corev1.Node{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		nPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			nPredicates...)); err != nil {
 		return err
 	}
 
@@ -1113,10 +1142,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		secretPredicates = append(secretPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &corev1.Secret{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		secretPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			secretPredicates...)); err != nil {
 		return err
 	}
 
@@ -1129,10 +1157,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		configMapPredicates = append(configMapPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &corev1.ConfigMap{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		configMapPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			configMapPredicates...)); err != nil {
 		return err
 	}
 
@@ -1142,10 +1169,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		rgPredicates = append(rgPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &gwapiv1b1.ReferenceGrant{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		rgPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &gwapiv1b1.ReferenceGrant{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			rgPredicates...)); err != nil {
 		return err
 	}
 	if err := addReferenceGrantIndexers(ctx, mgr); err != nil {
@@ -1158,10 +1184,21 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 		dPredicates = append(dPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
 	}
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &appsv1.Deployment{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		dPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &appsv1.Deployment{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			dPredicates...)); err != nil {
+		return err
+	}
+
+	// Watch DaemonSet CRUDs and process affected Gateways.
+	dsPredicates := []predicate.Predicate{predicate.NewPredicateFuncs(r.validateDaemonSetForReconcile)}
+	if r.namespaceLabel != nil {
+		dsPredicates = append(dsPredicates, predicate.NewPredicateFuncs(r.hasMatchingNamespaceLabels))
+	}
+	if err := c.Watch(
+		source.Kind[client.Object](mgr.GetCache(), &appsv1.DaemonSet{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			dsPredicates...)); err != nil {
 		return err
 	}
 
@@ -1173,10 +1210,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 	if r.envoyGateway.ExtensionAPIs != nil && r.envoyGateway.ExtensionAPIs.EnableEnvoyPatchPolicy {
 		// Watch EnvoyPatchPolicy CRUDs
 		if err := c.Watch(
-			source.Kind(mgr.GetCache(), &egv1a1.EnvoyPatchPolicy{}),
-			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-			eppPredicates...,
-		); err != nil {
+			source.Kind[client.Object](mgr.GetCache(), &egv1a1.EnvoyPatchPolicy{},
+				handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+				eppPredicates...)); err != nil {
 			return err
 		}
 	}
@@ -1188,10 +1224,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 	}
 
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &egv1a1.ClientTrafficPolicy{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		ctpPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &egv1a1.ClientTrafficPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			ctpPredicates...)); err != nil {
 		return err
 	}
 
@@ -1206,10 +1241,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 	}
 
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &egv1a1.BackendTrafficPolicy{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		btpPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &egv1a1.BackendTrafficPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			btpPredicates...)); err != nil {
 		return err
 	}
 
@@ -1220,10 +1254,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 	}
 
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &egv1a1.SecurityPolicy{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		spPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &egv1a1.SecurityPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			spPredicates...)); err != nil {
 		return err
 	}
 	if err := addSecurityPolicyIndexers(ctx, mgr); err != nil {
@@ -1237,10 +1270,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 	}
 
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &gwapiv1a2.BackendTLSPolicy{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		btlsPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &gwapiv1a3.BackendTLSPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			btlsPredicates...)); err != nil {
 		return err
 	}
 
@@ -1256,10 +1288,9 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 
 	// Watch EnvoyExtensionPolicy CRUDs
 	if err := c.Watch(
-		source.Kind(mgr.GetCache(), &egv1a1.EnvoyExtensionPolicy{}),
-		handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-		eepPredicates...,
-	); err != nil {
+		source.Kind[client.Object](mgr.GetCache(), &egv1a1.EnvoyExtensionPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+			eepPredicates...)); err != nil {
 		return err
 	}
 	if err := addEnvoyExtensionPolicyIndexers(ctx, mgr); err != nil {
@@ -1276,14 +1307,27 @@ func (r *gatewayAPIReconciler) watchResources(ctx context.Context, mgr manager.M
 	for _, gvk := range r.extGVKs {
 		u := &unstructured.Unstructured{}
 		u.SetGroupVersionKind(gvk)
-		if err := c.Watch(source.Kind(mgr.GetCache(), u),
-			handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
-			uPredicates...,
-		); err != nil {
+		if err := c.Watch(
+			source.Kind[client.Object](mgr.GetCache(), u,
+				handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+				uPredicates...)); err != nil {
 			return err
 		}
 		r.log.Info("Watching additional resource", "resource", gvk.String())
 	}
+
+	// Watch any additional policy GVKs from the registered extension.
+	for _, gvk := range r.extServerPolicies {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		if err := c.Watch(
+			source.Kind[client.Object](mgr.GetCache(), u,
+				handler.EnqueueRequestsFromMapFunc(r.enqueueClass),
+				uPredicates...)); err != nil {
+			return err
+		}
+		r.log.Info("Watching additional policy resource", "resource", gvk.String())
+	}
 	return nil
 }
 
@@ -1299,13 +1343,6 @@ func (r *gatewayAPIReconciler) hasManagedClass(obj client.Object) bool {
 		panic(fmt.Sprintf("unsupported object type %T", obj))
 	}
 
-	// The EnvoyProxy must be in the same namespace as EG.
-	if ep.Namespace != r.namespace {
-		r.log.Info("envoyproxy namespace does not match Envoy Gateway's namespace",
-			"namespace", ep.Namespace, "name", ep.Name)
-		return false
-	}
-
 	gcList := new(gwapiv1.GatewayClassList)
 	err := r.client.List(context.TODO(), gcList)
 	if err != nil {
@@ -1313,6 +1350,33 @@ func (r *gatewayAPIReconciler) hasManagedClass(obj client.Object) bool {
 		return false
 	}
 
+	// Reconcile the managed GatewayClass if one of its Gateways references the EnvoyProxy,
+	// which is in the same namespace as the Gateway.
+	gwList := new(gwapiv1.GatewayList)
+	if err := r.client.List(context.TODO(), gwList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(envoyProxyGatewayIndex, utils.NamespacedName(ep).String()),
+	}); err != nil {
+		r.log.Error(err, "failed to list gateways")
+		return false
+	}
+	for i := range gwList.Items {
+		for j := range gcList.Items {
+			gc := gcList.Items[j]
+			if string(gwList.Items[i].Spec.GatewayClassName) == gc.Name &&
+				r.hasMatchingController(&gc) &&
+				classAccepted(&gc) {
+				return true
+			}
+		}
+	}
+
+	// The EnvoyProxy of the GatewayClass must be in the same namespace as EG.
+	if ep.Namespace != r.namespace {
+		r.log.Info("envoyproxy namespace does not match Envoy Gateway's namespace",
+			"namespace", ep.Namespace, "name", ep.Name)
+		return false
+	}
+
 	for i := range gcList.Items {
 		gc := gcList.Items[i]
 		// Reconcile the managed GatewayClass if it's referenced by the EnvoyProxy.
@@ -1373,6 +1437,33 @@ func (r *gatewayAPIReconciler) processParamsRef(ctx context.Context, gc *gwapiv1
 	return nil
 }
 
+// processGatewayParamsRef adds the EnvoyProxy referenced by the infrastructure of the provided
+// Gateway to the resourceTree. The EnvoyProxy is merged on top of the EnvoyProxy of the
+// GatewayClass, and validated, by the gateway-api layer, which rejects the Gateway if the
+// EnvoyProxy doesn't exist or is invalid.
+func (r *gatewayAPIReconciler) processGatewayParamsRef(ctx context.Context, gtw *gwapiv1.Gateway, resourceTree *gatewayapi.Resources) error {
+	// The EnvoyProxy must be in the same namespace as the Gateway.
+	key := types.NamespacedName{Namespace: gtw.Namespace, Name: gtw.Spec.Infrastructure.ParametersRef.Name}
+	for _, ep := range resourceTree.EnvoyProxiesForGateways {
+		if utils.NamespacedName(ep) == key {
+			return nil
+		}
+	}
+
+	ep := new(egv1a1.EnvoyProxy)
+	if err := r.client.Get(ctx, key, ep); err != nil {
+		if kerrors.IsNotFound(err) {
+			r.log.Info("envoyproxy referenced by gateway not found",
+				"namespace", key.Namespace, "name", key.Name)
+			return nil
+		}
+		return fmt.Errorf("failed to get envoyproxy %s: %w", key, err)
+	}
+
+	resourceTree.EnvoyProxiesForGateways = append(resourceTree.EnvoyProxiesForGateways, ep)
+	return nil
+}
+
 // serviceImportCRDExists checks for the existence of the ServiceImport CRD in k8s APIServer before watching it
 func (r *gatewayAPIReconciler) serviceImportCRDExists(mgr manager.Manager) bool {
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
@@ -1398,10 +1489,10 @@ func (r *gatewayAPIReconciler) serviceImportCRDExists(mgr manager.Manager) bool
 
 func (r *gatewayAPIReconciler) processBackendTLSPolicyConfigMapRefs(ctx context.Context, resourceTree *gatewayapi.Resources, resourceMap *resourceMappings) {
 	for _, policy := range resourceTree.BackendTLSPolicies {
-		tls := policy.Spec.TLS
+		tls := policy.Spec.Validation
 
-		if tls.CACertRefs != nil {
-			for _, caCertRef := range tls.CACertRefs {
+		if tls.CACertificateRefs != nil {
+			for _, caCertRef := range tls.CACertificateRefs {
 				if string(caCertRef.Kind) == gatewayapi.KindConfigMap {
 					caRefNew := gwapiv1b1.SecretObjectReference{
 						Group:     gatewayapi.GroupPtr(string(caCertRef.Group)),
@@ -1458,6 +1549,7 @@ func (r *gatewayAPIReconciler) processEnvoyExtensionPolicies(
 // processEnvoyExtensionPolicyObjectRefs adds the referenced resources in EnvoyExtensionPolicies
 // to the resourceTree
 // - BackendRefs for ExtProcs
+// - SecretRefs for Wasms
 func (r *gatewayAPIReconciler) processEnvoyExtensionPolicyObjectRefs(
 	ctx context.Context, resourceTree *gatewayapi.Resources, resourceMap *resourceMappings) {
 	// we don't return errors from this method, because we want to continue reconciling
@@ -1505,5 +1597,24 @@ func (r *gatewayAPIReconciler) processEnvoyExtensionPolicyObjectRefs(
 				}
 			}
 		}
+
+		// Add the referenced pull Secrets of the Wasm images to the resourceTree
+		for _, wasm := range policy.Spec.WASM {
+			if wasm.Code.Image == nil {
+				continue
+			}
+			if err := r.processSecretRef(
+				ctx,
+				resourceMap,
+				resourceTree,
+				egv1a1.KindEnvoyExtensionPolicy,
+				policy.Namespace,
+				policy.Name,
+				wasm.Code.Image.PullSecretRef); err != nil {
+				r.log.Error(err,
+					"failed to process Wasm image pull SecretRef for EnvoyExtensionPolicy",
+					"policy", policy, "secretRef", wasm.Code.Image.PullSecretRef)
+			}
+		}
 	}
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
 	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/internal/utils"
 )
 
 func TestAddGatewayClassFinalizer(t *testing.T) {
@@ -158,6 +159,7 @@ func TestHasManagedClass(t *testing.T) {
 		name     string
 		ep       client.Object
 		classes  []*gwapiv1.GatewayClass
+		gateways []*gwapiv1.Gateway
 		expected bool
 	}{
 		{
@@ -302,6 +304,94 @@ func TestHasManagedClass(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "envoyproxy referenced by a gateway of an accepted gatewayclass",
+			ep: &egv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "not-eg-ns",
+					Name:      "test-envoyproxy",
+				},
+			},
+			classes: []*gwapiv1.GatewayClass{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-gc",
+					},
+					Spec: gwapiv1.GatewayClassSpec{ControllerName: gcCtrlName},
+					Status: gwapiv1.GatewayClassStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:   string(gwapiv1.GatewayClassConditionStatusAccepted),
+								Status: metav1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+			gateways: []*gwapiv1.Gateway{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "not-eg-ns",
+						Name:      "test-gw",
+					},
+					Spec: gwapiv1.GatewaySpec{
+						GatewayClassName: "test-gc",
+						Infrastructure: &gwapiv1.GatewayInfrastructure{
+							ParametersRef: &gwapiv1.LocalParametersReference{
+								Group: gwapiv1.Group(egv1a1.GroupVersion.Group),
+								Kind:  gwapiv1.Kind(egv1a1.KindEnvoyProxy),
+								Name:  "test-envoyproxy",
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "envoyproxy referenced by a gateway of another gatewayclass",
+			ep: &egv1a1.EnvoyProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "not-eg-ns",
+					Name:      "test-envoyproxy",
+				},
+			},
+			classes: []*gwapiv1.GatewayClass{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-gc",
+					},
+					Spec: gwapiv1.GatewayClassSpec{ControllerName: "SomeOtherController"},
+					Status: gwapiv1.GatewayClassStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:   string(gwapiv1.GatewayClassConditionStatusAccepted),
+								Status: metav1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+			gateways: []*gwapiv1.Gateway{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "not-eg-ns",
+						Name:      "test-gw",
+					},
+					Spec: gwapiv1.GatewaySpec{
+						GatewayClassName: "test-gc",
+						Infrastructure: &gwapiv1.GatewayInfrastructure{
+							ParametersRef: &gwapiv1.LocalParametersReference{
+								Group: gwapiv1.Group(egv1a1.GroupVersion.Group),
+								Kind:  gwapiv1.Kind(egv1a1.KindEnvoyProxy),
+								Name:  "test-envoyproxy",
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for i := range testCases {
@@ -322,11 +412,15 @@ func TestHasManagedClass(t *testing.T) {
 			for _, gc := range tc.classes {
 				objs = append(objs, gc)
 			}
+			for _, gw := range tc.gateways {
+				objs = append(objs, gw)
+			}
 
 			// Create the client.
 			r.client = fakeclient.NewClientBuilder().
 				WithScheme(envoygateway.GetScheme()).
 				WithObjects(objs...).
+				WithIndex(&gwapiv1.Gateway{}, envoyProxyGatewayIndex, envoyProxyGatewayIndexFunc).
 				Build()
 
 			// Process the test case gatewayclasses.
@@ -473,3 +567,50 @@ func TestProcessParamsRef(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessGatewayParamsRef(t *testing.T) {
+	ep := &egv1a1.EnvoyProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+			Name:      "test-envoyproxy",
+		},
+	}
+	newGateway := func(namespace, name string) *gwapiv1.Gateway {
+		return &gwapiv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Spec: gwapiv1.GatewaySpec{
+				GatewayClassName: "test-gc",
+				Infrastructure: &gwapiv1.GatewayInfrastructure{
+					ParametersRef: &gwapiv1.LocalParametersReference{
+						Group: gwapiv1.Group(egv1a1.GroupVersion.Group),
+						Kind:  gwapiv1.Kind(egv1a1.KindEnvoyProxy),
+						Name:  "test-envoyproxy",
+					},
+				},
+			},
+		}
+	}
+
+	r := &gatewayAPIReconciler{
+		log: logging.DefaultLogger(egv1a1.LogLevelInfo),
+		client: fakeclient.NewClientBuilder().
+			WithScheme(envoygateway.GetScheme()).
+			WithObjects(ep).
+			Build(),
+	}
+	resourceTree := gatewayapi.NewResources()
+
+	// The EnvoyProxy is added once, even if it's referenced by several Gateways.
+	require.NoError(t, r.processGatewayParamsRef(context.Background(), newGateway("test-ns", "gw-1"), resourceTree))
+	require.NoError(t, r.processGatewayParamsRef(context.Background(), newGateway("test-ns", "gw-2"), resourceTree))
+	require.Len(t, resourceTree.EnvoyProxiesForGateways, 1)
+	require.Equal(t, utils.NamespacedName(ep), utils.NamespacedName(resourceTree.EnvoyProxiesForGateways[0]))
+
+	// The EnvoyProxy is looked up in the namespace of the Gateway, and a missing
+	// EnvoyProxy is left for the gateway-api layer to reject the Gateway.
+	require.NoError(t, r.processGatewayParamsRef(context.Background(), newGateway("other-ns", "gw-3"), resourceTree))
+	require.Len(t, resourceTree.EnvoyProxiesForGateways, 1)
+}
@@ -10,11 +10,22 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func (r *gatewayAPIReconciler) getExtensionRefFilters(ctx context.Context) ([]unstructured.Unstructured, error) {
+	return r.getExtensionResources(ctx, r.extGVKs)
+}
+
+func (r *gatewayAPIReconciler) getExtensionServerPolicies(ctx context.Context) ([]unstructured.Unstructured, error) {
+	return r.getExtensionResources(ctx, r.extServerPolicies)
+}
+
+// getExtensionResources lists the resources of the given kinds introduced by the extension,
+// in the watched namespaces.
+func (r *gatewayAPIReconciler) getExtensionResources(ctx context.Context, gvks []schema.GroupVersionKind) ([]unstructured.Unstructured, error) {
 	var resourceItems []unstructured.Unstructured
-	for _, gvk := range r.extGVKs {
+	for _, gvk := range gvks {
 		uExtResourceList := &unstructured.UnstructuredList{}
 		uExtResourceList.SetGroupVersionKind(gvk)
 		if err := r.client.List(ctx, uExtResourceList); err != nil {
@@ -29,7 +40,7 @@ func (r *gatewayAPIReconciler) getExtensionRefFilters(ctx context.Context) ([]un
 				extR := extR
 				ok, err := r.checkObjectNamespaceLabels(&extR)
 				if err != nil {
-					r.log.Error(err, "failed to check namespace labels for ExtensionRefFilter %s in namespace %s: %w", extR.GetName(), extR.GetNamespace())
+					r.log.Error(err, "failed to check namespace labels for extension resource %s in namespace %s: %w", extR.GetName(), extR.GetNamespace())
 					continue
 				}
 				if ok {
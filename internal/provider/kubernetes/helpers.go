@@ -199,6 +199,20 @@ func refsEnvoyProxy(gc *gwapiv1.GatewayClass) bool {
 		len(gc.Spec.ParametersRef.Name) > 0
 }
 
+// gatewayRefsEnvoyProxy returns true if the infrastructure of the provided Gateway
+// references an EnvoyProxy.
+func gatewayRefsEnvoyProxy(gtw *gwapiv1.Gateway) bool {
+	if gtw == nil {
+		return false
+	}
+
+	return gtw.Spec.Infrastructure != nil &&
+		gtw.Spec.Infrastructure.ParametersRef != nil &&
+		string(gtw.Spec.Infrastructure.ParametersRef.Group) == egv1a1.GroupVersion.Group &&
+		gtw.Spec.Infrastructure.ParametersRef.Kind == egv1a1.KindEnvoyProxy &&
+		len(gtw.Spec.Infrastructure.ParametersRef.Name) > 0
+}
+
 // classAccepted returns true if the provided GatewayClass is accepted.
 func classAccepted(gc *gwapiv1.GatewayClass) bool {
 	if gc == nil {
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	gwapiv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/envoyproxy/gateway/api/v1alpha1"
@@ -27,6 +28,7 @@ const (
 	gatewayTCPRouteIndex             = "gatewayTCPRouteIndex"
 	gatewayUDPRouteIndex             = "gatewayUDPRouteIndex"
 	secretGatewayIndex               = "secretGatewayIndex"
+	envoyProxyGatewayIndex           = "envoyProxyGatewayIndex"
 	targetRefGrantRouteIndex         = "targetRefGrantRouteIndex"
 	backendHTTPRouteIndex            = "backendHTTPRouteIndex"
 	backendGRPCRouteIndex            = "backendGRPCRouteIndex"
@@ -39,6 +41,7 @@ const (
 	secretCtpIndex                   = "secretCtpIndex"
 	configMapBtlsIndex               = "configMapBtlsIndex"
 	backendEnvoyExtensionPolicyIndex = "backendSecurityPolicyIndex"
+	secretEnvoyExtensionPolicyIndex  = "secretEnvoyExtensionPolicyIndex"
 )
 
 func addReferenceGrantIndexers(ctx context.Context, mgr manager.Manager) error {
@@ -327,9 +330,28 @@ func addGatewayIndexers(ctx context.Context, mgr manager.Manager) error {
 	}); err != nil {
 		return err
 	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1.Gateway{}, envoyProxyGatewayIndex, envoyProxyGatewayIndexFunc); err != nil {
+		return err
+	}
 	return nil
 }
 
+// envoyProxyGatewayIndexFunc indexes the Gateways by the EnvoyProxy referenced by their
+// infrastructure, which is in the same namespace as the Gateway.
+func envoyProxyGatewayIndexFunc(rawObj client.Object) []string {
+	gateway := rawObj.(*gwapiv1.Gateway)
+	if !gatewayRefsEnvoyProxy(gateway) {
+		return nil
+	}
+	return []string{
+		types.NamespacedName{
+			Namespace: gateway.Namespace,
+			Name:      gateway.Spec.Infrastructure.ParametersRef.Name,
+		}.String(),
+	}
+}
+
 func secretGatewayIndexFunc(rawObj client.Object) []string {
 	gateway := rawObj.(*gwapiv1.Gateway)
 	var secretReferences []string
@@ -488,7 +510,7 @@ func secretCtpIndexFunc(rawObj client.Object) []string {
 // referenced in BackendTLSPolicy objects. This helps in querying for BackendTLSPolicies that are
 // affected by a particular ConfigMap CRUD.
 func addBtlsIndexers(ctx context.Context, mgr manager.Manager) error {
-	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1a2.BackendTLSPolicy{}, configMapBtlsIndex, configMapBtlsIndexFunc); err != nil {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &gwapiv1a3.BackendTLSPolicy{}, configMapBtlsIndex, configMapBtlsIndexFunc); err != nil {
 		return err
 	}
 
@@ -496,10 +518,10 @@ func addBtlsIndexers(ctx context.Context, mgr manager.Manager) error {
 }
 
 func configMapBtlsIndexFunc(rawObj client.Object) []string {
-	btls := rawObj.(*gwapiv1a2.BackendTLSPolicy)
+	btls := rawObj.(*gwapiv1a3.BackendTLSPolicy)
 	var configMapReferences []string
-	if btls.Spec.TLS.CACertRefs != nil {
-		for _, caCertRef := range btls.Spec.TLS.CACertRefs {
+	if btls.Spec.Validation.CACertificateRefs != nil {
+		for _, caCertRef := range btls.Spec.Validation.CACertificateRefs {
 			if string(caCertRef.Kind) == gatewayapi.KindConfigMap {
 				configMapReferences = append(configMapReferences,
 					types.NamespacedName{
@@ -526,6 +548,12 @@ func addEnvoyExtensionPolicyIndexers(ctx context.Context, mgr manager.Manager) e
 		return err
 	}
 
+	if err = mgr.GetFieldIndexer().IndexField(
+		ctx, &v1alpha1.EnvoyExtensionPolicy{}, secretEnvoyExtensionPolicyIndex,
+		secretEnvoyExtensionPolicyIndexFunc); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -545,3 +573,23 @@ func backendEnvoyExtensionPolicyIndexFunc(rawObj client.Object) []string {
 
 	return ret
 }
+
+func secretEnvoyExtensionPolicyIndexFunc(rawObj client.Object) []string {
+	envoyExtensionPolicy := rawObj.(*v1alpha1.EnvoyExtensionPolicy)
+
+	var ret []string
+
+	for _, wasm := range envoyExtensionPolicy.Spec.WASM {
+		if wasm.Code.Image == nil {
+			continue
+		}
+		pullSecretRef := wasm.Code.Image.PullSecretRef
+		ret = append(ret,
+			types.NamespacedName{
+				Namespace: gatewayapi.NamespaceDerefOr(pullSecretRef.Namespace, envoyExtensionPolicy.Namespace),
+				Name:      string(pullSecretRef.Name),
+			}.String())
+	}
+
+	return ret
+}
@@ -8,6 +8,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"k8s.io/client-go/rest"
@@ -84,7 +85,7 @@ func New(cfg *rest.Config, svr *ec.Server, resources *message.ProviderResources)
 		return nil, fmt.Errorf("failed to create manager: %w", err)
 	}
 
-	updateHandler := status.NewUpdateHandler(mgr.GetLogger(), mgr.GetClient())
+	updateHandler := status.NewUpdateHandler(mgr.GetLogger(), mgr.GetClient(), svr.EnvoyGateway.GetSharding() == nil)
 	if err := mgr.Add(updateHandler); err != nil {
 		return nil, fmt.Errorf("failed to add status update handler %w", err)
 	}
@@ -107,7 +108,7 @@ func New(cfg *rest.Config, svr *ec.Server, resources *message.ProviderResources)
 	// Emit elected & continue with deployment of infra resources
 	go func() {
 		<-mgr.Elected()
-		close(svr.Elected)
+		svr.CloseElected()
 	}()
 
 	return &Provider{
@@ -116,6 +117,15 @@ func New(cfg *rest.Config, svr *ec.Server, resources *message.ProviderResources)
 	}, nil
 }
 
+// AddHealthChecks adds the checks to the health and readiness probes of the
+// provider.
+func (p *Provider) AddHealthChecks(name string, healthzCheck, readyzCheck func(*http.Request) error) error {
+	if err := p.manager.AddHealthzCheck(name, healthzCheck); err != nil {
+		return err
+	}
+	return p.manager.AddReadyzCheck(name, readyzCheck)
+}
+
 // Start starts the Provider synchronously until a message is received from ctx.
 func (p *Provider) Start(ctx context.Context) error {
 	errChan := make(chan error)
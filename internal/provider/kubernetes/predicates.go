@@ -8,6 +8,8 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"maps"
+	"sort"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -20,10 +22,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	mcsapi "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
 	"github.com/envoyproxy/gateway/internal/utils"
 )
 
@@ -156,6 +160,10 @@ func (r *gatewayAPIReconciler) validateSecretForReconcile(obj client.Object) boo
 		return true
 	}
 
+	if r.isEnvoyExtensionPolicyReferencingSecret(&nsName) {
+		return true
+	}
+
 	if r.isOIDCHMACSecret(&nsName) {
 		return true
 	}
@@ -209,6 +217,18 @@ func (r *gatewayAPIReconciler) isCtpReferencingSecret(nsName *types.NamespacedNa
 	return len(ctpList.Items) > 0
 }
 
+func (r *gatewayAPIReconciler) isEnvoyExtensionPolicyReferencingSecret(nsName *types.NamespacedName) bool {
+	eepList := &egv1a1.EnvoyExtensionPolicyList{}
+	if err := r.client.List(context.Background(), eepList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(secretEnvoyExtensionPolicyIndex, nsName.String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated EnvoyExtensionPolicies")
+		return false
+	}
+
+	return len(eepList.Items) > 0
+}
+
 func (r *gatewayAPIReconciler) isOIDCHMACSecret(nsName *types.NamespacedName) bool {
 	oidcHMACSecret := types.NamespacedName{
 		Namespace: r.namespace,
@@ -378,21 +398,47 @@ func (r *gatewayAPIReconciler) validateEndpointSliceForReconcile(obj client.Obje
 // if it exists, finds the Gateway's Service, and further updates the Gateway
 // status Ready condition. No Deployments are pushed for reconciliation.
 func (r *gatewayAPIReconciler) validateDeploymentForReconcile(obj client.Object) bool {
-	ctx := context.Background()
-	deployment, ok := obj.(*appsv1.Deployment)
-	if !ok {
+	if _, ok := obj.(*appsv1.Deployment); !ok {
 		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
 		return false
 	}
-	labels := deployment.GetLabels()
 
-	// Only deployments in the configured namespace should be reconciled.
-	if deployment.Namespace == r.namespace {
-		// Check if the deployment belongs to a Gateway, if so, update the Gateway status.
+	r.updateStatusForEnvoyObject(obj)
+
+	// There is no need to reconcile the Deployment any further.
+	return false
+}
+
+// validateDaemonSetForReconcile tries finding the owning Gateway of the DaemonSet
+// if it exists, finds the Gateway's Service, and further updates the Gateway
+// status Ready condition. No DaemonSets are pushed for reconciliation.
+func (r *gatewayAPIReconciler) validateDaemonSetForReconcile(obj client.Object) bool {
+	if _, ok := obj.(*appsv1.DaemonSet); !ok {
+		r.log.Info("unexpected object type, bypassing reconciliation", "object", obj)
+		return false
+	}
+
+	r.updateStatusForEnvoyObject(obj)
+
+	// There is no need to reconcile the DaemonSet any further.
+	return false
+}
+
+// updateStatusForEnvoyObject updates the status of the Gateways run by the
+// Envoy Deployment or DaemonSet.
+func (r *gatewayAPIReconciler) updateStatusForEnvoyObject(obj client.Object) {
+	ctx := context.Background()
+	labels := obj.GetLabels()
+
+	// Only deployments and daemonsets in the configured namespace, or in the namespace of
+	// their Gateway when deployed there, should be reconciled.
+	if obj.GetNamespace() == r.namespace ||
+		(r.gatewayNamespaceMode() && obj.GetNamespace() == labels[gatewayapi.OwningGatewayNamespaceLabel]) {
+		// Check if the object belongs to a Gateway, if so, update the Gateway status.
 		gtw := r.findOwningGateway(ctx, labels)
 		if gtw != nil {
 			r.updateStatusForGateway(ctx, gtw)
-			return false
+			return
 		}
 	}
 
@@ -401,45 +447,87 @@ func (r *gatewayAPIReconciler) validateDeploymentForReconcile(obj client.Object)
 	if ok && r.mergeGateways.Has(gcName) {
 		if err := r.updateStatusForGatewaysUnderGatewayClass(ctx, gcName); err != nil {
 			r.log.Info("no Gateways found under GatewayClass", "name", gcName)
-			return false
 		}
-		return false
 	}
+}
 
-	// There is no need to reconcile the Deployment any further.
-	return false
+// gatewayNamespaceMode returns if the managed resources of the Gateways are
+// deployed to the namespaces of the Gateways.
+func (r *gatewayAPIReconciler) gatewayNamespaceMode() bool {
+	return r.envoyGateway != nil && r.envoyGateway.GatewayNamespaceMode()
+}
+
+// infraNamespace returns the namespace of the managed resources of the Gateway.
+// The resources of the merged Gateways are always in the configured namespace.
+func (r *gatewayAPIReconciler) infraNamespace(gateway *gwapiv1.Gateway, merged bool) string {
+	if r.gatewayNamespaceMode() && !merged {
+		return gateway.Namespace
+	}
+	return r.namespace
 }
 
-// envoyDeploymentForGateway returns the Envoy Deployment, returning nil if the Deployment doesn't exist.
-func (r *gatewayAPIReconciler) envoyDeploymentForGateway(ctx context.Context, gateway *gwapiv1.Gateway) (*appsv1.Deployment, error) {
+// envoyObjectForGateway returns the Envoy Deployment, or the Envoy DaemonSet if the
+// Deployment doesn't exist, returning nil if neither exist.
+func (r *gatewayAPIReconciler) envoyObjectForGateway(ctx context.Context, gateway *gwapiv1.Gateway) (client.Object, error) {
+	merged := r.mergeGateways.Has(string(gateway.Spec.GatewayClassName))
 	key := types.NamespacedName{
-		Namespace: r.namespace,
-		Name:      infraName(gateway, r.mergeGateways.Has(string(gateway.Spec.GatewayClassName))),
+		Namespace: r.infraNamespace(gateway, merged),
+		Name:      infraName(gateway, merged),
 	}
 	deployment := new(appsv1.Deployment)
-	if err := r.client.Get(ctx, key, deployment); err != nil {
+	if err := r.client.Get(ctx, key, deployment); err == nil {
+		return deployment, nil
+	} else if !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+	daemonSet := new(appsv1.DaemonSet)
+	if err := r.client.Get(ctx, key, daemonSet); err != nil {
 		if kerrors.IsNotFound(err) {
 			return nil, nil
 		}
 		return nil, err
 	}
-	return deployment, nil
+	return daemonSet, nil
 }
 
-// envoyServiceForGateway returns the Envoy service, returning nil if the service doesn't exist.
-func (r *gatewayAPIReconciler) envoyServiceForGateway(ctx context.Context, gateway *gwapiv1.Gateway) (*corev1.Service, error) {
+// envoyServicesForGateway returns the Envoy service, followed by the additional Envoy
+// services, of the Gateway. The Envoy service is nil if it doesn't exist.
+func (r *gatewayAPIReconciler) envoyServicesForGateway(ctx context.Context, gateway *gwapiv1.Gateway) ([]*corev1.Service, error) {
+	merged := r.mergeGateways.Has(string(gateway.Spec.GatewayClassName))
 	key := types.NamespacedName{
-		Namespace: r.namespace,
-		Name:      infraName(gateway, r.mergeGateways.Has(string(gateway.Spec.GatewayClassName))),
+		Namespace: r.infraNamespace(gateway, merged),
+		Name:      infraName(gateway, merged),
 	}
 	svc := new(corev1.Service)
 	if err := r.client.Get(ctx, key, svc); err != nil {
-		if kerrors.IsNotFound(err) {
-			return nil, nil
+		if !kerrors.IsNotFound(err) {
+			return nil, err
 		}
+		svc = nil
+	}
+	svcs := []*corev1.Service{svc}
+
+	// The additional services share the labels of the Envoy service.
+	svcLabels := proxy.EnvoyAppLabel()
+	if merged {
+		maps.Copy(svcLabels, gatewayapi.GatewayClassOwnerLabel(string(gateway.Spec.GatewayClassName)))
+	} else {
+		maps.Copy(svcLabels, gatewayapi.GatewayOwnerLabels(gateway.Namespace, gateway.Name))
+	}
+	svcList := new(corev1.ServiceList)
+	if err := r.client.List(ctx, svcList, client.InNamespace(key.Namespace), client.MatchingLabels(svcLabels)); err != nil {
 		return nil, err
 	}
-	return svc, nil
+	sort.Slice(svcList.Items, func(i, j int) bool {
+		return svcList.Items[i].Name < svcList.Items[j].Name
+	})
+	for i := range svcList.Items {
+		if svcList.Items[i].Name != key.Name {
+			svcs = append(svcs, &svcList.Items[i])
+		}
+	}
+
+	return svcs, nil
 }
 
 // findOwningGateway attempts finds a Gateway using "labels".
@@ -527,7 +615,7 @@ func (r *gatewayAPIReconciler) validateConfigMapForReconcile(obj client.Object)
 		return false
 	}
 
-	btlsList := &gwapiv1a2.BackendTLSPolicyList{}
+	btlsList := &gwapiv1a3.BackendTLSPolicyList{}
 	if err := r.client.List(context.Background(), btlsList, &client.ListOptions{
 		FieldSelector: fields.OneTermEqualSelector(configMapBtlsIndex, utils.NamespacedName(configMap).String()),
 	}); err != nil {
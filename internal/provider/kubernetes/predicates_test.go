@@ -6,10 +6,12 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -24,6 +26,7 @@ import (
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
 	"github.com/envoyproxy/gateway/internal/logging"
 	"github.com/envoyproxy/gateway/internal/provider/kubernetes/test"
 )
@@ -223,8 +226,8 @@ func TestValidateSecretForReconcile(t *testing.T) {
 						Name: "oidc",
 					},
 					Spec: v1alpha1.SecurityPolicySpec{
-						TargetRef: gwapiv1a2.PolicyTargetReferenceWithSectionName{
-							PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+						TargetRef: v1alpha1.PolicyTargetReferenceWithSectionName{
+							PolicyTargetReference: v1alpha1.PolicyTargetReference{
 								Kind: "Gateway",
 								Name: "scheduled-status-test",
 							},
@@ -256,8 +259,8 @@ func TestValidateSecretForReconcile(t *testing.T) {
 						Name: "basic-auth",
 					},
 					Spec: v1alpha1.SecurityPolicySpec{
-						TargetRef: gwapiv1a2.PolicyTargetReferenceWithSectionName{
-							PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+						TargetRef: v1alpha1.PolicyTargetReferenceWithSectionName{
+							PolicyTargetReference: v1alpha1.PolicyTargetReference{
 								Kind: "Gateway",
 								Name: "scheduled-status-test",
 							},
@@ -273,6 +276,42 @@ func TestValidateSecretForReconcile(t *testing.T) {
 			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
 			expect: true,
 		},
+		{
+			name: "references EnvoyExtensionPolicy Wasm image pull secret",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", v1alpha1.GatewayControllerName, nil),
+				test.GetGateway(types.NamespacedName{Name: "scheduled-status-test"}, "test-gc", 8080),
+				&v1alpha1.EnvoyExtensionPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "wasm-image",
+					},
+					Spec: v1alpha1.EnvoyExtensionPolicySpec{
+						TargetRef: v1alpha1.PolicyTargetReferenceWithSectionName{
+							PolicyTargetReference: v1alpha1.PolicyTargetReference{
+								Kind: "Gateway",
+								Name: "scheduled-status-test",
+							},
+						},
+						WASM: []v1alpha1.Wasm{
+							{
+								Name: "wasm-filter",
+								Code: v1alpha1.WasmCodeSource{
+									Type: v1alpha1.ImageWasmCodeSourceType,
+									Image: &v1alpha1.ImageWasmCodeSource{
+										URL: "oci://registry.example.com/org/wasm-filter:v1.0.0",
+										PullSecretRef: gwapiv1b1.SecretObjectReference{
+											Name: "secret",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
+			expect: true,
+		},
 		{
 			name: "secret is not referenced by any EG CRs",
 			configs: []client.Object{
@@ -298,6 +337,7 @@ func TestValidateSecretForReconcile(t *testing.T) {
 			WithObjects(tc.configs...).
 			WithIndex(&gwapiv1.Gateway{}, secretGatewayIndex, secretGatewayIndexFunc).
 			WithIndex(&v1alpha1.SecurityPolicy{}, secretSecurityPolicyIndex, secretSecurityPolicyIndexFunc).
+			WithIndex(&v1alpha1.EnvoyExtensionPolicy{}, secretEnvoyExtensionPolicyIndex, secretEnvoyExtensionPolicyIndexFunc).
 			Build()
 		t.Run(tc.name, func(t *testing.T) {
 			res := r.validateSecretForReconcile(tc.secret)
@@ -495,8 +535,8 @@ func TestValidateServiceForReconcile(t *testing.T) {
 						Name: "ext-auth-http",
 					},
 					Spec: v1alpha1.SecurityPolicySpec{
-						TargetRef: gwapiv1a2.PolicyTargetReferenceWithSectionName{
-							PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+						TargetRef: v1alpha1.PolicyTargetReferenceWithSectionName{
+							PolicyTargetReference: v1alpha1.PolicyTargetReference{
 								Kind: "Gateway",
 								Name: "scheduled-status-test",
 							},
@@ -522,8 +562,8 @@ func TestValidateServiceForReconcile(t *testing.T) {
 						Name: "ext-proc",
 					},
 					Spec: v1alpha1.EnvoyExtensionPolicySpec{
-						TargetRef: gwapiv1a2.PolicyTargetReferenceWithSectionName{
-							PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+						TargetRef: v1alpha1.PolicyTargetReferenceWithSectionName{
+							PolicyTargetReference: v1alpha1.PolicyTargetReference{
 								Kind: "Gateway",
 								Name: "scheduled-status-test",
 							},
@@ -551,8 +591,8 @@ func TestValidateServiceForReconcile(t *testing.T) {
 						Name: "ext-proc",
 					},
 					Spec: v1alpha1.EnvoyExtensionPolicySpec{
-						TargetRef: gwapiv1a2.PolicyTargetReferenceWithSectionName{
-							PolicyTargetReference: gwapiv1a2.PolicyTargetReference{
+						TargetRef: v1alpha1.PolicyTargetReferenceWithSectionName{
+							PolicyTargetReference: v1alpha1.PolicyTargetReference{
 								Kind: "Gateway",
 								Name: "scheduled-status-test",
 							},
@@ -724,6 +764,192 @@ func TestValidateDeploymentForReconcile(t *testing.T) {
 	}
 }
 
+// TestValidateDaemonSetForReconcile tests the validateDaemonSetForReconcile
+// predicate function.
+func TestValidateDaemonSetForReconcile(t *testing.T) {
+	sampleGateway := test.GetGateway(types.NamespacedName{Namespace: "default", Name: "scheduled-status-test"}, "test-gc", 8080)
+	labels := map[string]string{
+		gatewayapi.OwningGatewayNameLabel:      "scheduled-status-test",
+		gatewayapi.OwningGatewayNamespaceLabel: "default",
+	}
+
+	testCases := []struct {
+		name    string
+		configs []client.Object
+		obj     client.Object
+		expect  bool
+	}{
+		{
+			// The main purpose of the DaemonSet watcher is just for update Gateway object statuses.
+			name: "gateway daemonset",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", v1alpha1.GatewayControllerName, nil),
+				sampleGateway,
+			},
+			obj:    test.GetGatewayDaemonSet(types.NamespacedName{Name: "daemonset"}, labels),
+			expect: false,
+		},
+		{
+			name:   "not a daemonset",
+			obj:    test.GetGatewayDeployment(types.NamespacedName{Name: "deployment"}, labels),
+			expect: false,
+		},
+	}
+
+	// Create the reconciler.
+	logger := logging.DefaultLogger(v1alpha1.LogLevelInfo)
+
+	r := gatewayAPIReconciler{
+		classController: v1alpha1.GatewayControllerName,
+		log:             logger,
+		mergeGateways:   sets.New[string](),
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		r.client = fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(tc.configs...).Build()
+		t.Run(tc.name, func(t *testing.T) {
+			res := r.validateDaemonSetForReconcile(tc.obj)
+			require.Equal(t, tc.expect, res)
+		})
+	}
+}
+
+// TestEnvoyObjectForGateway tests that the Envoy Deployment or DaemonSet of a
+// Gateway is found.
+func TestEnvoyObjectForGateway(t *testing.T) {
+	gtw := test.GetGateway(types.NamespacedName{Namespace: "default", Name: "gateway"}, "test-gc", 8080)
+	key := types.NamespacedName{Namespace: "envoy-gateway-system", Name: infraName(gtw, false)}
+
+	testCases := []struct {
+		name    string
+		configs []client.Object
+		expect  client.Object
+	}{
+		{
+			name:    "deployment",
+			configs: []client.Object{test.GetGatewayDeployment(key, nil)},
+			expect:  &appsv1.Deployment{},
+		},
+		{
+			name:    "daemonset",
+			configs: []client.Object{test.GetGatewayDaemonSet(key, nil)},
+			expect:  &appsv1.DaemonSet{},
+		},
+		{
+			name: "none",
+		},
+	}
+
+	r := gatewayAPIReconciler{
+		classController: v1alpha1.GatewayControllerName,
+		log:             logging.DefaultLogger(v1alpha1.LogLevelInfo),
+		namespace:       "envoy-gateway-system",
+		mergeGateways:   sets.New[string](),
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		r.client = fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(tc.configs...).Build()
+		t.Run(tc.name, func(t *testing.T) {
+			obj, err := r.envoyObjectForGateway(context.Background(), gtw)
+			require.NoError(t, err)
+			if tc.expect == nil {
+				require.Nil(t, obj)
+				return
+			}
+			require.IsType(t, tc.expect, obj)
+			require.Equal(t, key.Name, obj.GetName())
+		})
+	}
+}
+
+func TestEnvoyObjectForGatewayInGatewayNamespace(t *testing.T) {
+	gtw := test.GetGateway(types.NamespacedName{Namespace: "default", Name: "gateway"}, "test-gc", 8080)
+	mergedGtw := test.GetGateway(types.NamespacedName{Namespace: "default", Name: "merged"}, "merged-gc", 8080)
+
+	r := gatewayAPIReconciler{
+		classController: v1alpha1.GatewayControllerName,
+		log:             logging.DefaultLogger(v1alpha1.LogLevelInfo),
+		namespace:       "envoy-gateway-system",
+		mergeGateways:   sets.New[string]("merged-gc"),
+		envoyGateway: &v1alpha1.EnvoyGateway{
+			EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+				Provider: &v1alpha1.EnvoyGatewayProvider{
+					Type: v1alpha1.ProviderTypeKubernetes,
+					Kubernetes: &v1alpha1.EnvoyGatewayKubernetesProvider{
+						Deploy: &v1alpha1.KubernetesDeployMode{
+							Type: ptr.To(v1alpha1.KubernetesDeployModeTypeGatewayNamespace),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// The resources of a Gateway are in its namespace, while the resources of merged
+	// Gateways stay in the Envoy Gateway namespace.
+	key := types.NamespacedName{Namespace: "default", Name: infraName(gtw, false)}
+	mergedKey := types.NamespacedName{Namespace: "envoy-gateway-system", Name: infraName(mergedGtw, true)}
+	r.client = fakeclient.NewClientBuilder().
+		WithScheme(envoygateway.GetScheme()).
+		WithObjects(
+			test.GetGatewayDeployment(key, nil),
+			test.GetService(key, nil, nil),
+			test.GetGatewayDeployment(mergedKey, nil),
+		).
+		Build()
+
+	obj, err := r.envoyObjectForGateway(context.Background(), gtw)
+	require.NoError(t, err)
+	require.NotNil(t, obj)
+	require.Equal(t, key.Namespace, obj.GetNamespace())
+
+	svcs, err := r.envoyServicesForGateway(context.Background(), gtw)
+	require.NoError(t, err)
+	require.Len(t, svcs, 1)
+	require.NotNil(t, svcs[0])
+	require.Equal(t, key.Namespace, svcs[0].Namespace)
+
+	obj, err = r.envoyObjectForGateway(context.Background(), mergedGtw)
+	require.NoError(t, err)
+	require.NotNil(t, obj)
+	require.Equal(t, mergedKey.Namespace, obj.GetNamespace())
+}
+
+func TestEnvoyServicesForGateway(t *testing.T) {
+	gtw := test.GetGateway(types.NamespacedName{Namespace: "default", Name: "gateway"}, "test-gc", 8080)
+	name := infraName(gtw, false)
+	svcLabels := proxy.EnvoyAppLabel()
+	svcLabels[gatewayapi.OwningGatewayNamespaceLabel] = gtw.Namespace
+	svcLabels[gatewayapi.OwningGatewayNameLabel] = gtw.Name
+	otherLabels := proxy.EnvoyAppLabel()
+	otherLabels[gatewayapi.OwningGatewayNamespaceLabel] = gtw.Namespace
+	otherLabels[gatewayapi.OwningGatewayNameLabel] = "other"
+
+	r := gatewayAPIReconciler{
+		classController: v1alpha1.GatewayControllerName,
+		log:             logging.DefaultLogger(v1alpha1.LogLevelInfo),
+		namespace:       "envoy-gateway-system",
+		mergeGateways:   sets.New[string](),
+	}
+	r.client = fakeclient.NewClientBuilder().
+		WithScheme(envoygateway.GetScheme()).
+		WithObjects(
+			test.GetService(types.NamespacedName{Namespace: r.namespace, Name: "envoy-internal"}, svcLabels, nil),
+			test.GetService(types.NamespacedName{Namespace: r.namespace, Name: name}, svcLabels, nil),
+			test.GetService(types.NamespacedName{Namespace: r.namespace, Name: "envoy-other"}, otherLabels, nil),
+		).
+		Build()
+
+	// The Envoy service comes first, followed by the additional services of the Gateway.
+	svcs, err := r.envoyServicesForGateway(context.Background(), gtw)
+	require.NoError(t, err)
+	require.Len(t, svcs, 2)
+	require.Equal(t, name, svcs[0].Name)
+	require.Equal(t, "envoy-internal", svcs[1].Name)
+}
+
 func TestCheckObjectNamespaceLabels(t *testing.T) {
 	matchExpressions := func(key string, operator metav1.LabelSelectorOperator, values []string) []metav1.LabelSelectorRequirement {
 		return []metav1.LabelSelectorRequirement{{
@@ -167,7 +167,7 @@ func (r *gatewayAPIReconciler) processGRPCRoutes(ctx context.Context, gatewayNam
 					r.log.Error(err, "bypassing filter rule", "index", i)
 					continue
 				}
-				if filter.Type == gwapiv1a2.GRPCRouteFilterExtensionRef {
+				if filter.Type == gwapiv1.GRPCRouteFilterExtensionRef {
 					// NOTE: filters must be in the same namespace as the GRPCRoute
 					// Check if it's a Kind managed by an extension and add to resourceTree
 					key := types.NamespacedName{
@@ -193,7 +193,7 @@ func (r *gatewayAPIReconciler) processGRPCRoutes(ctx context.Context, gatewayNam
 		resourceMap.allAssociatedNamespaces[grpcRoute.Namespace] = struct{}{}
 		// Discard Status to reduce memory consumption in watchable
 		// It will be recomputed by the gateway-api layer
-		grpcRoute.Status = gwapiv1a2.GRPCRouteStatus{}
+		grpcRoute.Status = gwapiv1.GRPCRouteStatus{}
 		resourceTree.GRPCRoutes = append(resourceTree.GRPCRoutes, &grpcRoute)
 	}
 
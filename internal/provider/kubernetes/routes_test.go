@@ -355,7 +355,7 @@ func TestProcessGRPCRoutes(t *testing.T) {
 						Namespace: "test",
 						Name:      "test",
 					},
-					Spec: gwapiv1a2.GRPCRouteSpec{
+					Spec: gwapiv1.GRPCRouteSpec{
 						CommonRouteSpec: gwapiv1.CommonRouteSpec{
 							ParentRefs: []gwapiv1.ParentReference{
 								{
@@ -363,16 +363,16 @@ func TestProcessGRPCRoutes(t *testing.T) {
 								},
 							},
 						},
-						Rules: []gwapiv1a2.GRPCRouteRule{
+						Rules: []gwapiv1.GRPCRouteRule{
 							{
-								Matches: []gwapiv1a2.GRPCRouteMatch{
+								Matches: []gwapiv1.GRPCRouteMatch{
 									{
-										Method: &gwapiv1a2.GRPCMethodMatch{
+										Method: &gwapiv1.GRPCMethodMatch{
 											Method: ptr.To("Ping"),
 										},
 									},
 								},
-								BackendRefs: []gwapiv1a2.GRPCBackendRef{
+								BackendRefs: []gwapiv1.GRPCBackendRef{
 									{
 										BackendRef: gwapiv1.BackendRef{
 											BackendObjectReference: gwapiv1.BackendObjectReference{
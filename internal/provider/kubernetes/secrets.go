@@ -86,6 +86,17 @@ func CertsToSecret(namespace string, certs *crypto.Certificates) []corev1.Secret
 			map[string][]byte{
 				hmacSecretKey: certs.OIDCHMACSecret,
 			}),
+		// The CA is created last, so that the xDS client certificates issued with it
+		// for the Envoy proxies deployed to the Gateway namespaces are reissued once
+		// the other certificates are rotated.
+		newSecret(
+			corev1.SecretTypeTLS,
+			"envoy-gateway-ca",
+			namespace,
+			map[string][]byte{
+				corev1.TLSCertKey:       certs.CACertificate,
+				corev1.TLSPrivateKeyKey: certs.CAPrivateKey,
+			}),
 	}
 }
 
@@ -13,7 +13,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
-	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
@@ -21,14 +20,15 @@ import (
 type watchAndReconcileSource struct {
 	condition <-chan struct{}
 	object    client.Object
+	handler   handler.EventHandler
 }
 
-func NewWatchAndReconcileSource(cond <-chan struct{}, obj client.Object) source.Source {
-	return &watchAndReconcileSource{condition: cond, object: obj}
+func NewWatchAndReconcileSource(cond <-chan struct{}, obj client.Object, eh handler.EventHandler) source.Source {
+	return &watchAndReconcileSource{condition: cond, object: obj, handler: eh}
 }
 
-// Start implements the Source interface. It registers the EventHandler with the Informer.
-func (s *watchAndReconcileSource) Start(ctx context.Context, eh handler.EventHandler, queue workqueue.RateLimitingInterface, _ ...predicate.Predicate) error {
+// Start implements the Source interface. It triggers the EventHandler once the condition is met.
+func (s *watchAndReconcileSource) Start(ctx context.Context, queue workqueue.RateLimitingInterface) error {
 	if s.object == nil {
 		return errors.New("object to queue is required")
 	}
@@ -39,7 +39,7 @@ func (s *watchAndReconcileSource) Start(ctx context.Context, eh handler.EventHan
 			return
 		case <-s.condition:
 			// Triggers a reconcile
-			eh.Generic(ctx, event.GenericEvent{Object: s.object}, queue)
+			s.handler.Generic(ctx, event.GenericEvent{Object: s.object}, queue)
 		}
 	}()
 	return nil
@@ -10,15 +10,19 @@ import (
 	"fmt"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/message"
 	"github.com/envoyproxy/gateway/internal/status"
 	"github.com/envoyproxy/gateway/internal/utils"
+	xdstypes "github.com/envoyproxy/gateway/internal/xds/types"
 )
 
 // subscribeAndUpdateStatus subscribes to gateway API object status updates and
@@ -49,6 +53,28 @@ func (r *gatewayAPIReconciler) subscribeAndUpdateStatus(ctx context.Context) {
 		r.log.Info("gateway status subscriber shutting down")
 	}()
 
+	// Gateway object status updater for the xDS resources rejected by Envoy
+	go func() {
+		message.HandleSubscription(
+			message.Metadata{Runner: string(v1alpha1.LogComponentProviderRunner), Message: "gateway-xds-nack"},
+			r.resources.GatewayXdsNACKs.Subscribe(ctx),
+			func(update message.Update[types.NamespacedName, *xdstypes.XdsNACK], errChan chan error) {
+				// The Programmed condition is computed again from the rejections left,
+				// so both stores and deletes are handled.
+				gtw := new(gwapiv1.Gateway)
+				if err := r.client.Get(ctx, update.Key, gtw); err != nil {
+					if !kerrors.IsNotFound(err) {
+						r.log.Error(err, "unable to get gateway", "namespace", update.Key.Namespace, "name", update.Key.Name)
+						errChan <- err
+					}
+					return
+				}
+				r.updateStatusForGateway(ctx, gtw)
+			},
+		)
+		r.log.Info("gateway xds nack subscriber shutting down")
+	}()
+
 	// HTTPRoute object status updater
 	go func() {
 		message.HandleSubscription(
@@ -84,7 +110,7 @@ func (r *gatewayAPIReconciler) subscribeAndUpdateStatus(ctx context.Context) {
 	// GRPCRoute object status updater
 	go func() {
 		message.HandleSubscription(message.Metadata{Runner: string(v1alpha1.LogComponentProviderRunner), Message: "grpcroute-status"}, r.resources.GRPCRouteStatuses.Subscribe(ctx),
-			func(update message.Update[types.NamespacedName, *gwapiv1a2.GRPCRouteStatus], errChan chan error) {
+			func(update message.Update[types.NamespacedName, *gwapiv1.GRPCRouteStatus], errChan chan error) {
 				// skip delete updates.
 				if update.Delete {
 					return
@@ -347,9 +373,9 @@ func (r *gatewayAPIReconciler) subscribeAndUpdateStatus(ctx context.Context) {
 				val := update.Value
 				r.statusUpdater.Send(status.Update{
 					NamespacedName: key,
-					Resource:       new(gwapiv1a2.BackendTLSPolicy),
+					Resource:       new(gwapiv1a3.BackendTLSPolicy),
 					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
-						t, ok := obj.(*gwapiv1a2.BackendTLSPolicy)
+						t, ok := obj.(*gwapiv1a3.BackendTLSPolicy)
 						if !ok {
 							err := fmt.Errorf("unsupported object type %T", obj)
 							errChan <- err
@@ -396,6 +422,45 @@ func (r *gatewayAPIReconciler) subscribeAndUpdateStatus(ctx context.Context) {
 		)
 		r.log.Info("envoyExtensionPolicy status subscriber shutting down")
 	}()
+
+	// Extension server policy object status updater
+	go func() {
+		message.HandleSubscription(
+			message.Metadata{Runner: string(v1alpha1.LogComponentProviderRunner), Message: "extensionpolicy-status"},
+			r.resources.ExtensionPolicyStatuses.Subscribe(ctx),
+			func(update message.Update[message.NamespacedNameAndGVK, *gwapiv1a2.PolicyStatus], errChan chan error) {
+				// skip delete updates.
+				if update.Delete {
+					return
+				}
+				key := update.Key
+				val := update.Value
+				obj := &unstructured.Unstructured{}
+				obj.SetGroupVersionKind(key.GroupVersionKind)
+				r.statusUpdater.Send(status.Update{
+					NamespacedName: key.NamespacedName,
+					Resource:       obj,
+					Mutator: status.MutatorFunc(func(obj client.Object) client.Object {
+						t, ok := obj.(*unstructured.Unstructured)
+						if !ok {
+							err := fmt.Errorf("unsupported object type %T", obj)
+							errChan <- err
+							panic(err)
+						}
+						tCopy := t.DeepCopy()
+						policyStatus, err := runtime.DefaultUnstructuredConverter.ToUnstructured(val)
+						if err != nil {
+							errChan <- err
+							return t
+						}
+						tCopy.Object["status"] = policyStatus
+						return tCopy
+					}),
+				})
+			},
+		)
+		r.log.Info("extensionPolicy status subscriber shutting down")
+	}()
 }
 
 func (r *gatewayAPIReconciler) updateStatusForGateway(ctx context.Context, gtw *gwapiv1.Gateway) {
@@ -404,26 +469,35 @@ func (r *gatewayAPIReconciler) updateStatusForGateway(ctx context.Context, gtw *
 		return
 	}
 
-	// Get deployment
-	deploy, err := r.envoyDeploymentForGateway(ctx, gtw)
+	// Get deployment or daemonset
+	envoyObj, err := r.envoyObjectForGateway(ctx, gtw)
 	if err != nil {
-		r.log.Info("failed to get Deployment for gateway",
+		r.log.Info("failed to get Deployment or DaemonSet for gateway",
 			"namespace", gtw.Namespace, "name", gtw.Name)
 	}
 
-	// Get service
-	svc, err := r.envoyServiceForGateway(ctx, gtw)
+	// Get services
+	svcs, err := r.envoyServicesForGateway(ctx, gtw)
 	if err != nil {
-		r.log.Info("failed to get Service for gateway",
+		r.log.Info("failed to get Services for gateway",
 			"namespace", gtw.Namespace, "name", gtw.Name)
 	}
-	// update accepted condition
-	status.UpdateGatewayStatusAcceptedCondition(gtw, true)
+	// update accepted condition, unless the gateway has been rejected for its parametersRef
+	if !status.GatewayHasInvalidParameters(gtw) {
+		status.UpdateGatewayStatusAcceptedCondition(gtw, true)
+	}
 	// update address field and programmed condition
-	status.UpdateGatewayStatusProgrammedCondition(gtw, svc, deploy, r.store.listNodeAddresses()...)
+	status.UpdateGatewayStatusProgrammedCondition(gtw, svcs, envoyObj, r.store.listNodeAddresses()...)
 
 	key := utils.NamespacedName(gtw)
 
+	// the gateway isn't programmed if Envoy rejects its xDS resources
+	if r.resources != nil {
+		if nack, ok := r.resources.GatewayXdsNACKs.Load(key); ok {
+			status.UpdateGatewayStatusRejectedCondition(gtw, nack.ConditionMessage())
+		}
+	}
+
 	// publish status
 	r.statusUpdater.Send(status.Update{
 		NamespacedName: key,
@@ -144,15 +144,15 @@ func GetGRPCRoute(nsName types.NamespacedName, parent string, serviceName types.
 			Namespace: nsName.Namespace,
 			Name:      nsName.Name,
 		},
-		Spec: gwapiv1a2.GRPCRouteSpec{
+		Spec: gwapiv1.GRPCRouteSpec{
 			CommonRouteSpec: gwapiv1.CommonRouteSpec{
 				ParentRefs: []gwapiv1.ParentReference{
 					{Name: gwapiv1.ObjectName(parent)},
 				},
 			},
-			Rules: []gwapiv1a2.GRPCRouteRule{
+			Rules: []gwapiv1.GRPCRouteRule{
 				{
-					BackendRefs: []gwapiv1a2.GRPCBackendRef{
+					BackendRefs: []gwapiv1.GRPCBackendRef{
 						{
 							BackendRef: gwapiv1.BackendRef{
 								BackendObjectReference: gwapiv1.BackendObjectReference{
@@ -283,6 +283,18 @@ func GetGatewayDeployment(nsName types.NamespacedName, labels map[string]string)
 	}
 }
 
+// GetGatewayDaemonSet returns a sample DaemonSet for a Gateway object.
+func GetGatewayDaemonSet(nsName types.NamespacedName, labels map[string]string) *appsv1.DaemonSet {
+	deployment := GetGatewayDeployment(nsName, labels)
+	return &appsv1.DaemonSet{
+		ObjectMeta: deployment.ObjectMeta,
+		Spec: appsv1.DaemonSetSpec{
+			Selector: deployment.Spec.Selector,
+			Template: deployment.Spec.Template,
+		},
+	}
+}
+
 // GetService returns a sample Service with labels and ports.
 func GetService(nsName types.NamespacedName, labels map[string]string, ports map[string]int32) *corev1.Service {
 	service := &corev1.Service{
@@ -8,18 +8,28 @@ package runner
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/provider/file"
 	"github.com/envoyproxy/gateway/internal/provider/kubernetes"
+	"github.com/envoyproxy/gateway/internal/status/sink"
+	"github.com/envoyproxy/gateway/internal/supervisor"
 )
 
 type Config struct {
 	config.Server
 	ProviderResources *message.ProviderResources
+	// StatusSinks are the sinks receiving the statuses of the resources,
+	// in addition to the provider itself when it is a sink.Sink.
+	StatusSinks []sink.Sink
+	// Supervisor, if set, reports the health of the runners on the health
+	// and readiness probes of the provider.
+	Supervisor *supervisor.Supervisor
 }
 
 type Runner struct {
@@ -27,7 +37,9 @@ type Runner struct {
 }
 
 func New(cfg *Config) *Runner {
-	return &Runner{Config: *cfg}
+	r := &Runner{Config: *cfg}
+	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
+	return r
 }
 
 func (r *Runner) Name() string {
@@ -36,25 +48,74 @@ func (r *Runner) Name() string {
 
 // Start the provider runner
 func (r *Runner) Start(ctx context.Context) (err error) {
-	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
-	if r.EnvoyGateway.Provider.Type == v1alpha1.ProviderTypeKubernetes {
+
+	var p provider
+	switch r.EnvoyGateway.Provider.Type {
+	case v1alpha1.ProviderTypeKubernetes:
 		r.Logger.Info("Using provider", "type", v1alpha1.ProviderTypeKubernetes)
 		cfg, err := ctrl.GetConfig()
 		if err != nil {
 			return fmt.Errorf("failed to get kubeconfig: %w", err)
 		}
-		p, err := kubernetes.New(cfg, &r.Config.Server, r.ProviderResources)
+		p, err = kubernetes.New(cfg, &r.Config.Server, r.ProviderResources)
 		if err != nil {
 			return fmt.Errorf("failed to create provider %s: %w", v1alpha1.ProviderTypeKubernetes, err)
 		}
-		go func() {
-			err := p.Start(ctx)
-			if err != nil {
-				r.Logger.Error(err, "unable to start provider")
-			}
-		}()
-		return nil
+	case v1alpha1.ProviderTypeCustom:
+		p, err = r.createCustomResourceProvider()
+		if err != nil {
+			return fmt.Errorf("failed to create provider %s: %w", v1alpha1.ProviderTypeCustom, err)
+		}
+	default:
+		// Unsupported provider.
+		return fmt.Errorf("unsupported provider type %v", r.EnvoyGateway.Provider.Type)
+	}
+
+	if hp, ok := p.(healthProber); ok && r.Supervisor != nil {
+		if err := hp.AddHealthChecks("runners", r.Supervisor.Healthz, r.Supervisor.Readyz); err != nil {
+			return fmt.Errorf("failed to add runners health checks: %w", err)
+		}
+	}
+
+	sinks := r.StatusSinks
+	if s, ok := p.(sink.Sink); ok {
+		sinks = append(sinks, s)
+	}
+	if len(sinks) > 0 {
+		sink.Subscribe(ctx, r.ProviderResources, sinks...)
+	}
+
+	supervisor.Go(ctx, func() {
+		err := p.Start(ctx)
+		if err != nil {
+			r.Logger.Error(err, "unable to start provider")
+		}
+	})
+	return nil
+}
+
+// provider is implemented by the resource providers started by the runner.
+type provider interface {
+	Start(ctx context.Context) error
+}
+
+// healthProber is implemented by the providers serving health and readiness
+// probes.
+type healthProber interface {
+	AddHealthChecks(name string, healthzCheck, readyzCheck func(*http.Request) error) error
+}
+
+func (r *Runner) createCustomResourceProvider() (provider, error) {
+	custom := r.EnvoyGateway.Provider.Custom
+	if custom == nil {
+		return nil, fmt.Errorf("custom provider is unspecified")
+	}
+
+	switch custom.Resource.Type {
+	case v1alpha1.ResourceProviderTypeFile:
+		r.Logger.Info("Using provider", "type", v1alpha1.ProviderTypeCustom, "resource", v1alpha1.ResourceProviderTypeFile)
+		return file.New(&r.Config.Server, r.ProviderResources)
+	default:
+		return nil, fmt.Errorf("unsupported resource provider type %v", custom.Resource.Type)
 	}
-	// Unsupported provider.
-	return fmt.Errorf("unsupported provider type %v", r.EnvoyGateway.Provider.Type)
 }
@@ -8,6 +8,7 @@ package runner
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,6 +21,7 @@ import (
 
 func TestStart(t *testing.T) {
 	logger := logging.DefaultLogger(v1alpha1.LogLevelInfo)
+	resourcesDir := t.TempDir()
 
 	testCases := []struct {
 		name   string
@@ -44,6 +46,30 @@ func TestStart(t *testing.T) {
 			},
 			expect: false,
 		},
+		{
+			name:   "custom file provider",
+			cfg:    newFileProviderConfig(t, resourcesDir),
+			expect: true,
+		},
+		{
+			name: "custom provider without resource provider",
+			cfg: &config.Server{
+				EnvoyGateway: &v1alpha1.EnvoyGateway{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: v1alpha1.GroupVersion.String(),
+						Kind:       v1alpha1.KindEnvoyGateway,
+					},
+					EnvoyGatewaySpec: v1alpha1.EnvoyGatewaySpec{
+						Provider: &v1alpha1.EnvoyGatewayProvider{
+							Type:   v1alpha1.ProviderTypeCustom,
+							Custom: &v1alpha1.EnvoyGatewayCustomProvider{},
+						},
+					},
+				},
+				Logger: logger,
+			},
+			expect: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -66,3 +92,50 @@ func TestStart(t *testing.T) {
 		})
 	}
 }
+
+func newFileProviderConfig(t *testing.T, paths ...string) *config.Server {
+	t.Helper()
+
+	cfg, err := config.New()
+	require.NoError(t, err)
+	cfg.EnvoyGateway.Provider = &v1alpha1.EnvoyGatewayProvider{
+		Type: v1alpha1.ProviderTypeCustom,
+		Custom: &v1alpha1.EnvoyGatewayCustomProvider{
+			Resource: v1alpha1.EnvoyGatewayResourceProvider{
+				Type: v1alpha1.ResourceProviderTypeFile,
+				File: &v1alpha1.EnvoyGatewayFileResourceProvider{
+					Paths: paths,
+				},
+			},
+		},
+	}
+	return cfg
+}
+
+func TestRestart(t *testing.T) {
+	resources := new(message.ProviderResources)
+	t.Cleanup(resources.Close)
+	runner := New(&Config{
+		Server:            *newFileProviderConfig(t, t.TempDir()),
+		ProviderResources: resources,
+	})
+
+	// The supervisor restarts a crashed runner by starting it again with a new
+	// context, which creates a new provider signaling the election again.
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		require.NoError(t, runner.Start(ctx))
+		require.Eventually(t, func() bool {
+			_, ok := resources.GatewayAPIResources.Load(v1alpha1.GatewayControllerName)
+			return ok
+		}, 5*time.Second, 50*time.Millisecond)
+		resources.GatewayAPIResources.Delete(v1alpha1.GatewayControllerName)
+		cancel()
+	}
+
+	select {
+	case <-runner.Elected:
+	default:
+		t.Fatal("expected the provider to signal election")
+	}
+}
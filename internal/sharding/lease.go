@@ -0,0 +1,206 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package sharding
+
+import (
+	"context"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+const (
+	// leaseNamePrefix is the prefix of the names of the Leases of the replicas.
+	leaseNamePrefix = "envoy-gateway-shard-"
+	// MemberLabel labels the Leases of the replicas taking part in the sharding.
+	MemberLabel = "gateway.envoyproxy.io/shard-member"
+	// AddressAnnotation annotates the Lease of a replica with its address.
+	AddressAnnotation = "gateway.envoyproxy.io/shard-address"
+
+	// restartGracePeriod is how long a replica which shut down keeps its
+	// Gateways, so that they don't move to another replica and back while the
+	// replica is restarted with the same name, e.g. during a rollout of the
+	// StatefulSet. Moving a Gateway restarts its Envoy proxies, since they
+	// connect to the replica owning it.
+	restartGracePeriod = 2 * time.Minute
+	// leaseGCPeriod is the time after which the expired Lease of a replica which
+	// never came back, e.g. a replica which crashed before a scale down, is
+	// deleted.
+	leaseGCPeriod = time.Hour
+)
+
+// LeaseMembership maintains the Lease of the replica in the namespace of Envoy
+// Gateway, and sets the members of the Shard to the replicas whose Lease hasn't
+// expired.
+type LeaseMembership struct {
+	client        client.Client
+	namespace     string
+	shard         *Shard
+	leaseDuration time.Duration
+	renewPeriod   time.Duration
+	logger        logging.Logger
+	// now returns the current time, and is overridden by the tests.
+	now func() time.Time
+	// renewed is the last time the Lease of the replica was renewed.
+	renewed time.Time
+}
+
+// NewLeaseMembership returns a LeaseMembership maintaining the Lease of the
+// replica of the shard.
+func NewLeaseMembership(cli client.Client, namespace string, shard *Shard, leaseDuration, renewPeriod time.Duration, logger logging.Logger) *LeaseMembership {
+	return &LeaseMembership{
+		client:        cli,
+		namespace:     namespace,
+		shard:         shard,
+		leaseDuration: leaseDuration,
+		renewPeriod:   renewPeriod,
+		logger:        logger.WithName("sharding"),
+		now:           time.Now,
+	}
+}
+
+// Start renews the Lease of the replica and syncs the members of the shard
+// every renew period, until ctx is done. The Lease is then extended for the
+// restart of the replica instead of being deleted, so that the replica keeps
+// its Gateways when it's back with the same name.
+func (l *LeaseMembership) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(l.renewPeriod)
+		defer ticker.Stop()
+		for {
+			if err := l.sync(ctx); err != nil {
+				shardLeaseSyncErrorsTotal.Increment()
+				l.logger.Error(err, "failed to sync the sharding leases")
+				l.expireSelf()
+			}
+			select {
+			case <-ctx.Done():
+				l.release()
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// sync renews the Lease of the replica, and sets the members of the shard to
+// the replicas whose Lease hasn't expired.
+func (l *LeaseMembership) sync(ctx context.Context) error {
+	if err := l.renew(ctx); err != nil {
+		return err
+	}
+	l.renewed = l.now()
+
+	leases := new(coordinationv1.LeaseList)
+	if err := l.client.List(ctx, leases, client.InNamespace(l.namespace), client.HasLabels{MemberLabel}); err != nil {
+		return err
+	}
+	now := l.now()
+	var members []Member
+	for i := range leases.Items {
+		lease := &leases.Items[i]
+		if expired(lease, now.Add(-leaseGCPeriod)) {
+			l.collect(ctx, lease)
+			continue
+		}
+		if lease.Spec.HolderIdentity == nil || expired(lease, now) {
+			continue
+		}
+		members = append(members, Member{
+			Name:    *lease.Spec.HolderIdentity,
+			Address: lease.Annotations[AddressAnnotation],
+		})
+	}
+
+	if l.shard.SetMembers(members) {
+		names := make([]string, 0, len(members))
+		for _, member := range members {
+			names = append(names, member.Name)
+		}
+		l.logger.Info("sharding members changed", "members", names)
+	}
+	return nil
+}
+
+// expireSelf leaves the sharding once the Lease of the replica expired because
+// it couldn't be renewed, since the other replicas then take over its Gateways.
+func (l *LeaseMembership) expireSelf() {
+	if !l.renewed.IsZero() && l.now().Sub(l.renewed) >= l.leaseDuration && l.shard.SetMembers(nil) {
+		l.logger.Info("sharding lease expired, left the sharding")
+	}
+}
+
+// renew creates or renews the Lease of the replica.
+func (l *LeaseMembership) renew(ctx context.Context) error {
+	return l.renewFor(ctx, l.leaseDuration)
+}
+
+// renewFor creates or renews the Lease of the replica for the duration.
+func (l *LeaseMembership) renewFor(ctx context.Context, duration time.Duration) error {
+	self := l.shard.Self()
+	key := client.ObjectKey{Namespace: l.namespace, Name: leaseNamePrefix + self.Name}
+	lease := new(coordinationv1.Lease)
+	err := l.client.Get(ctx, key, lease)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	// The address changes when the pod of the replica is recreated with the
+	// same name, e.g. by a StatefulSet.
+	now := metav1.NewMicroTime(l.now())
+	lease.Namespace = key.Namespace
+	lease.Name = key.Name
+	lease.Labels = map[string]string{MemberLabel: "true"}
+	lease.Annotations = map[string]string{AddressAnnotation: self.Address}
+	lease.Spec.HolderIdentity = ptr.To(self.Name)
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(duration.Seconds()))
+	lease.Spec.RenewTime = &now
+	if err != nil {
+		lease.Spec.AcquireTime = &now
+		return l.client.Create(ctx, lease)
+	}
+	return l.client.Update(ctx, lease)
+}
+
+// release renews the Lease of the replica for its restart.
+func (l *LeaseMembership) release() {
+	if l.renewed.IsZero() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), l.renewPeriod)
+	defer cancel()
+	if err := l.renewFor(ctx, max(l.leaseDuration, restartGracePeriod)); err != nil {
+		l.logger.Error(err, "failed to extend the sharding lease")
+	}
+}
+
+// collect deletes the Lease of a replica which expired for longer than the GC
+// period, unless it was renewed in the meantime.
+func (l *LeaseMembership) collect(ctx context.Context, lease *coordinationv1.Lease) {
+	err := l.client.Delete(ctx, lease, client.Preconditions{ResourceVersion: ptr.To(lease.ResourceVersion)})
+	if err != nil && !kerrors.IsNotFound(err) && !kerrors.IsConflict(err) {
+		l.logger.Error(err, "failed to delete the expired sharding lease", "lease", lease.Name)
+		return
+	}
+	if err == nil {
+		l.logger.Info("deleted the expired sharding lease", "lease", lease.Name)
+	}
+}
+
+// expired returns whether the Lease wasn't renewed within its duration.
+func expired(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	return !now.Before(expiry)
+}
@@ -0,0 +1,86 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package sharding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+func TestLeaseMembership(t *testing.T) {
+	ctx := context.Background()
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+	logger := logging.DefaultLogger(v1alpha1.LogLevelInfo)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newMembership := func(name, address string) *LeaseMembership {
+		m := NewLeaseMembership(cli, "envoy-gateway-system", NewShard(Member{Name: name, Address: address}),
+			15*time.Second, 5*time.Second, logger)
+		m.now = func() time.Time { return now }
+		return m
+	}
+	eg0 := newMembership("eg-0", "10.0.0.1")
+	eg1 := newMembership("eg-1", "10.0.0.2")
+
+	require.NoError(t, eg0.sync(ctx))
+	require.Equal(t, []Member{{Name: "eg-0", Address: "10.0.0.1"}}, eg0.shard.Members())
+
+	lease := new(coordinationv1.Lease)
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Namespace: "envoy-gateway-system", Name: "envoy-gateway-shard-eg-0"}, lease))
+	require.Equal(t, "true", lease.Labels[MemberLabel])
+	require.Equal(t, "10.0.0.1", lease.Annotations[AddressAnnotation])
+	require.Equal(t, int32(15), *lease.Spec.LeaseDurationSeconds)
+
+	require.NoError(t, eg1.sync(ctx))
+	require.NoError(t, eg0.sync(ctx))
+	want := []Member{{Name: "eg-0", Address: "10.0.0.1"}, {Name: "eg-1", Address: "10.0.0.2"}}
+	require.Equal(t, want, eg0.shard.Members())
+	require.Equal(t, want, eg1.shard.Members())
+
+	// The lease of eg-1 expires when it isn't renewed
+	now = now.Add(20 * time.Second)
+	require.NoError(t, eg0.sync(ctx))
+	require.Equal(t, want[:1], eg0.shard.Members())
+
+	// eg-1 leaves the sharding once its own lease expired
+	eg1.expireSelf()
+	require.Empty(t, eg1.shard.Members())
+	require.False(t, eg1.shard.Owns(Key("gc")))
+
+	// eg-1 rejoins once it renews its lease
+	require.NoError(t, eg1.sync(ctx))
+	require.Equal(t, want, eg1.shard.Members())
+
+	// eg-1 keeps its Gateways while it restarts once released
+	eg1.release()
+	now = now.Add(time.Minute)
+	require.NoError(t, eg0.sync(ctx))
+	require.Equal(t, want, eg0.shard.Members())
+
+	// eg-1 leaves the sharding when it isn't back in time
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, eg0.sync(ctx))
+	require.Equal(t, want[:1], eg0.shard.Members())
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Namespace: "envoy-gateway-system", Name: "envoy-gateway-shard-eg-1"}, lease))
+
+	// The lease of eg-1 is deleted once expired for longer than the GC period
+	now = now.Add(time.Hour)
+	require.NoError(t, eg0.sync(ctx))
+	err := cli.Get(ctx, types.NamespacedName{Namespace: "envoy-gateway-system", Name: "envoy-gateway-shard-eg-1"}, lease)
+	require.True(t, kerrors.IsNotFound(err))
+	require.Equal(t, want[:1], eg0.shard.Members())
+}
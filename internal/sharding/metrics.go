@@ -0,0 +1,14 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package sharding
+
+import "github.com/envoyproxy/gateway/internal/metrics"
+
+var (
+	shardMembers = metrics.NewGauge("shard_members", "Current number of Envoy Gateway replicas taking part in the sharding.")
+
+	shardLeaseSyncErrorsTotal = metrics.NewCounter("shard_lease_sync_errors_total", "Total number of failed renewals or listings of the sharding Leases.")
+)
@@ -0,0 +1,134 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package sharding
+
+import (
+	"hash/fnv"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Member is an Envoy Gateway replica taking part in the sharding.
+type Member struct {
+	// Name identifies the replica, i.e. it's the name of its pod.
+	Name string
+	// Address is the address of the replica, which the Envoy proxies of the
+	// Gateways of its shard connect to.
+	Address string
+}
+
+// Owner returns the member owning the key, selected with rendezvous hashing:
+// each member is scored with the hash of its name and the key, and the member
+// with the highest score owns the key. A member joining or leaving only moves
+// the keys it owns, or will own, and all the members agree on the owner of a
+// key as long as they agree on the members.
+func Owner(key string, members []Member) (Member, bool) {
+	var owner Member
+	var ownerScore uint64
+	found := false
+	for _, member := range members {
+		score := rendezvousScore(member.Name, key)
+		if !found || score > ownerScore || (score == ownerScore && member.Name < owner.Name) {
+			owner, ownerScore, found = member, score, true
+		}
+	}
+	return owner, found
+}
+
+func rendezvousScore(member, key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(member))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(key))
+	// Mix the bits of the hash, since the FNV hashes of strings sharing a prefix
+	// only differ in their low bits.
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+// Key returns the sharding key of a GatewayClass, or of a group of its Gateways
+// identified by groupKey when the Gateways are sharded individually.
+func Key(gatewayClass string, groupKey ...string) string {
+	return strings.Join(append([]string{gatewayClass}, groupKey...), "/")
+}
+
+// Shard tracks the members of the sharding, and the keys owned by the replica.
+// Until the members are first set, the replica owns no keys, so that it doesn't
+// take over the Gateways of the other replicas while it joins the sharding.
+type Shard struct {
+	self Member
+
+	mu      sync.RWMutex
+	members []Member
+	synced  bool
+	changed chan struct{}
+}
+
+// NewShard returns the Shard of the replica.
+func NewShard(self Member) *Shard {
+	return &Shard{
+		self:    self,
+		changed: make(chan struct{}, 1),
+	}
+}
+
+// Self returns the replica.
+func (s *Shard) Self() Member {
+	return s.self
+}
+
+// Members returns the members of the sharding, sorted by name.
+func (s *Shard) Members() []Member {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.members)
+}
+
+// Owns returns whether the replica owns the key.
+func (s *Shard) Owns(key string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.synced {
+		return false
+	}
+	owner, ok := Owner(key, s.members)
+	return ok && owner.Name == s.self.Name
+}
+
+// Changed returns a channel receiving a value when the members changed. The
+// changes are coalesced until the value is received.
+func (s *Shard) Changed() <-chan struct{} {
+	return s.changed
+}
+
+// SetMembers sets the members of the sharding, and returns whether they changed.
+func (s *Shard) SetMembers(members []Member) bool {
+	members = slices.Clone(members)
+	slices.SortFunc(members, func(a, b Member) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.synced && slices.Equal(s.members, members) {
+		return false
+	}
+	s.members = members
+	s.synced = true
+	shardMembers.Record(float64(len(members)))
+
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+	return true
+}
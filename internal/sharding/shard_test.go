@@ -0,0 +1,95 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func members(names ...string) []Member {
+	res := make([]Member, 0, len(names))
+	for _, name := range names {
+		res = append(res, Member{Name: name, Address: name + ".addr"})
+	}
+	return res
+}
+
+func TestOwner(t *testing.T) {
+	_, ok := Owner("gc", nil)
+	require.False(t, ok)
+
+	three := members("eg-0", "eg-1", "eg-2")
+	four := members("eg-0", "eg-1", "eg-2", "eg-3")
+
+	owned := map[string]int{}
+	moved := 0
+	for i := 0; i < 300; i++ {
+		key := Key("gc", fmt.Sprintf("default/gateway-%d", i))
+		owner, ok := Owner(key, three)
+		require.True(t, ok)
+		owned[owner.Name]++
+
+		// The owner doesn't depend on the order of the members
+		reversed, _ := Owner(key, members("eg-2", "eg-1", "eg-0"))
+		require.Equal(t, owner, reversed)
+
+		// Keys only move to the new member
+		next, _ := Owner(key, four)
+		if next != owner {
+			require.Equal(t, "eg-3", next.Name)
+			moved++
+		}
+	}
+
+	// The keys are spread over all the members
+	require.Len(t, owned, 3)
+	for name, n := range owned {
+		require.Greater(t, n, 50, name)
+	}
+	require.Greater(t, moved, 30)
+	require.Less(t, moved, 120)
+}
+
+func TestShard(t *testing.T) {
+	shard := NewShard(Member{Name: "eg-0", Address: "10.0.0.1"})
+	key := Key("gc")
+
+	// Nothing is owned until the members are synced
+	require.False(t, shard.Owns(key))
+	select {
+	case <-shard.Changed():
+		t.Fatal("unexpected change")
+	default:
+	}
+
+	require.True(t, shard.SetMembers(members("eg-0")))
+	require.True(t, shard.Owns(key))
+	<-shard.Changed()
+
+	// Setting the same members, in any order, isn't a change
+	require.True(t, shard.SetMembers(members("eg-1", "eg-0")))
+	require.False(t, shard.SetMembers(members("eg-0", "eg-1")))
+	require.Equal(t, members("eg-0", "eg-1"), shard.Members())
+	owner, _ := Owner(key, shard.Members())
+	require.Equal(t, owner.Name == "eg-0", shard.Owns(key))
+
+	// Changes are coalesced until received
+	require.True(t, shard.SetMembers(members("eg-1")))
+	<-shard.Changed()
+	select {
+	case <-shard.Changed():
+		t.Fatal("unexpected change")
+	default:
+	}
+	require.False(t, shard.Owns(key))
+
+	// A replica that left the sharding owns nothing
+	require.True(t, shard.SetMembers(nil))
+	require.False(t, shard.Owns(key))
+}
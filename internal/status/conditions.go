@@ -20,6 +20,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -29,6 +30,7 @@ const (
 	MsgOlderGatewayClassExists   = "Invalid GatewayClass: another older GatewayClass with the same Spec.Controller exists"
 	MsgValidGatewayClass         = "Valid GatewayClass"
 	MsgGatewayClassInvalidParams = "Invalid parametersRef"
+	MsgGatewayInvalidParams      = "Invalid parametersRef"
 )
 
 // computeGatewayClassAcceptedCondition computes the GatewayClass Accepted status condition.
@@ -72,25 +74,40 @@ func computeGatewayAcceptedCondition(gw *gwapiv1.Gateway, accepted bool) metav1.
 }
 
 // computeGatewayProgrammedCondition computes the Gateway Programmed status condition.
-// Programmed condition surfaces true when the Envoy Deployment status is ready.
-func computeGatewayProgrammedCondition(gw *gwapiv1.Gateway, deployment *appsv1.Deployment) metav1.Condition {
+// Programmed condition surfaces true when the Envoy Deployment or DaemonSet status is ready.
+func computeGatewayProgrammedCondition(gw *gwapiv1.Gateway, envoyObj client.Object) metav1.Condition {
 	if len(gw.Status.Addresses) == 0 {
 		return newCondition(string(gwapiv1.GatewayConditionProgrammed), metav1.ConditionFalse,
 			string(gwapiv1.GatewayReasonAddressNotAssigned),
 			"No addresses have been assigned to the Gateway", time.Now(), gw.Generation)
 	}
 
-	// If there are no available replicas for the Envoy Deployment, don't
-	// mark the Gateway as ready yet.
-
-	if deployment == nil || deployment.Status.AvailableReplicas == 0 {
+	// If there are no available replicas for the Envoy Deployment, or no available
+	// pods for the Envoy DaemonSet, don't mark the Gateway as ready yet.
+	var message string
+	switch envoyObj := envoyObj.(type) {
+	case *appsv1.DaemonSet:
+		if envoyObj == nil || envoyObj.Status.NumberAvailable == 0 {
+			return newCondition(string(gwapiv1.GatewayConditionProgrammed), metav1.ConditionFalse,
+				string(gwapiv1.GatewayReasonNoResources),
+				"DaemonSet pods unavailable", time.Now(), gw.Generation)
+		}
+		message = fmt.Sprintf("Address assigned to the Gateway, %d/%d envoy DaemonSet pods available",
+			envoyObj.Status.NumberAvailable, envoyObj.Status.DesiredNumberScheduled)
+	case *appsv1.Deployment:
+		if envoyObj == nil || envoyObj.Status.AvailableReplicas == 0 {
+			return newCondition(string(gwapiv1.GatewayConditionProgrammed), metav1.ConditionFalse,
+				string(gwapiv1.GatewayReasonNoResources),
+				"Deployment replicas unavailable", time.Now(), gw.Generation)
+		}
+		message = fmt.Sprintf("Address assigned to the Gateway, %d/%d envoy Deployment replicas available",
+			envoyObj.Status.AvailableReplicas, envoyObj.Status.Replicas)
+	default:
 		return newCondition(string(gwapiv1.GatewayConditionProgrammed), metav1.ConditionFalse,
 			string(gwapiv1.GatewayReasonNoResources),
 			"Deployment replicas unavailable", time.Now(), gw.Generation)
 	}
 
-	message := fmt.Sprintf("Address assigned to the Gateway, %d/%d envoy Deployment replicas available",
-		deployment.Status.AvailableReplicas, deployment.Status.Replicas)
 	return newCondition(string(gwapiv1.GatewayConditionProgrammed), metav1.ConditionTrue,
 		string(gwapiv1.GatewayConditionProgrammed), message, time.Now(), gw.Generation)
 }
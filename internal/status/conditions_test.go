@@ -24,6 +24,7 @@ import (
 	utilclock "k8s.io/utils/clock"
 	fakeclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -285,6 +286,7 @@ func TestGatewayReadyCondition(t *testing.T) {
 		name             string
 		serviceAddress   bool
 		deploymentStatus appsv1.DeploymentStatus
+		daemonSetStatus  *appsv1.DaemonSetStatus
 		expect           metav1.Condition
 	}{
 		{
@@ -314,6 +316,24 @@ func TestGatewayReadyCondition(t *testing.T) {
 				Reason: string(gwapiv1.GatewayReasonNoResources),
 			},
 		},
+		{
+			name:            "ready gateway with daemonset",
+			serviceAddress:  true,
+			daemonSetStatus: &appsv1.DaemonSetStatus{NumberAvailable: 2, DesiredNumberScheduled: 3},
+			expect: metav1.Condition{
+				Status: metav1.ConditionTrue,
+				Reason: string(gwapiv1.GatewayConditionProgrammed),
+			},
+		},
+		{
+			name:            "not ready gateway with daemonset unavailable pods",
+			serviceAddress:  true,
+			daemonSetStatus: &appsv1.DaemonSetStatus{NumberAvailable: 0, DesiredNumberScheduled: 3},
+			expect: metav1.Condition{
+				Status: metav1.ConditionFalse,
+				Reason: string(gwapiv1.GatewayReasonNoResources),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -332,8 +352,11 @@ func TestGatewayReadyCondition(t *testing.T) {
 				}
 			}
 
-			deployment := &appsv1.Deployment{Status: tc.deploymentStatus}
-			got := computeGatewayProgrammedCondition(gtw, deployment)
+			var envoyObj client.Object = &appsv1.Deployment{Status: tc.deploymentStatus}
+			if tc.daemonSetStatus != nil {
+				envoyObj = &appsv1.DaemonSet{Status: *tc.daemonSetStatus}
+			}
+			got := computeGatewayProgrammedCondition(gtw, envoyObj)
 
 			assert.Equal(t, string(gwapiv1.GatewayConditionProgrammed), got.Type)
 			assert.Equal(t, tc.expect.Status, got.Status)
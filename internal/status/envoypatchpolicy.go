@@ -50,3 +50,31 @@ func SetResourceNotFoundErrorForEnvoyPatchPolicy(s *gwv1a2.PolicyStatus, notFoun
 		s.Ancestors[i].Conditions = MergeConditions(s.Ancestors[i].Conditions, cond)
 	}
 }
+
+// SetRejectedForEnvoyPatchPolicy sets the Programmed condition to False for each ancestor
+// reference in policy status, when the patched resources are rejected by the Envoy proxies.
+func SetRejectedForEnvoyPatchPolicy(s *gwv1a2.PolicyStatus, errMsg string) {
+	cond := newCondition(string(egv1a1.PolicyConditionProgrammed), metav1.ConditionFalse, string(egv1a1.PolicyReasonRejected), errMsg, time.Now(), 0)
+	for i := range s.Ancestors {
+		s.Ancestors[i].Conditions = MergeConditions(s.Ancestors[i].Conditions, cond)
+	}
+}
+
+// ClearRejectedForEnvoyPatchPolicy sets the Programmed condition back to True for each ancestor
+// reference in policy status, once the patched resources are no longer rejected by the Envoy proxies.
+// It returns whether the policy status was updated.
+func ClearRejectedForEnvoyPatchPolicy(s *gwv1a2.PolicyStatus) bool {
+	updated := false
+	message := "Patches have been successfully applied."
+	cond := newCondition(string(egv1a1.PolicyConditionProgrammed), metav1.ConditionTrue, string(egv1a1.PolicyReasonProgrammed), message, time.Now(), 0)
+	for i := range s.Ancestors {
+		for _, c := range s.Ancestors[i].Conditions {
+			if c.Type == string(egv1a1.PolicyConditionProgrammed) && c.Reason == string(egv1a1.PolicyReasonRejected) {
+				s.Ancestors[i].Conditions = MergeConditions(s.Ancestors[i].Conditions, cond)
+				updated = true
+				break
+			}
+		}
+	}
+	return updated
+}
@@ -6,9 +6,13 @@
 package status
 
 import (
-	appsv1 "k8s.io/api/apps/v1"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -18,16 +22,43 @@ func UpdateGatewayStatusAcceptedCondition(gw *gwapiv1.Gateway, accepted bool) *g
 	return gw
 }
 
+// UpdateGatewayStatusInvalidParametersCondition updates the Accepted condition of the provided
+// Gateway to False, with the message of the parametersRef of its infrastructure being invalid.
+func UpdateGatewayStatusInvalidParametersCondition(gw *gwapiv1.Gateway, message string) {
+	gw.Status.Conditions = MergeConditions(gw.Status.Conditions,
+		newCondition(string(gwapiv1.GatewayConditionAccepted), metav1.ConditionFalse,
+			string(gwapiv1.GatewayReasonInvalidParameters), message, time.Now(), gw.Generation))
+}
+
+// GatewayHasInvalidParameters returns whether the provided Gateway has been rejected for
+// the parametersRef of its infrastructure.
+func GatewayHasInvalidParameters(gw *gwapiv1.Gateway) bool {
+	for _, cond := range gw.Status.Conditions {
+		if cond.Type == string(gwapiv1.GatewayConditionAccepted) && cond.Status == metav1.ConditionFalse &&
+			cond.Reason == string(gwapiv1.GatewayReasonInvalidParameters) {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateGatewayStatusProgrammedCondition updates the status addresses for the provided gateway
-// based on the status IP/Hostname of svc and updates the Programmed condition based on the
-// service and deployment state.
-func UpdateGatewayStatusProgrammedCondition(gw *gwapiv1.Gateway, svc *corev1.Service, deployment *appsv1.Deployment, nodeAddresses ...string) {
+// based on the status IP/Hostname of svcs, the Envoy service followed by the additional Envoy
+// services, and updates the Programmed condition based on the services and the state of the
+// Envoy Deployment or DaemonSet.
+func UpdateGatewayStatusProgrammedCondition(gw *gwapiv1.Gateway, svcs []*corev1.Service, envoyObj client.Object, nodeAddresses ...string) {
 	var addresses, hostnames []string
+	found := false
 	// Update the status addresses field.
-	if svc != nil {
+	for i, svc := range svcs {
+		if svc == nil {
+			continue
+		}
+		found = true
+
 		// If the addresses is explicitly set in the Gateway spec by the user, use it
-		// to populate the Status
-		if len(gw.Spec.Addresses) > 0 {
+		// to populate the Status. They are only assigned to the Envoy service.
+		if i == 0 && len(gw.Spec.Addresses) > 0 {
 			// Make sure the addresses have been populated into ExternalIPs/ClusterIPs
 			// and use that value
 			if len(svc.Spec.ExternalIPs) > 0 {
@@ -35,38 +66,22 @@ func UpdateGatewayStatusProgrammedCondition(gw *gwapiv1.Gateway, svc *corev1.Ser
 			} else if len(svc.Spec.ClusterIPs) > 0 {
 				addresses = append(addresses, svc.Spec.ClusterIPs...)
 			}
-		} else {
-			if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
-				for i := range svc.Status.LoadBalancer.Ingress {
-					switch {
-					case len(svc.Status.LoadBalancer.Ingress[i].IP) > 0:
-						addresses = append(addresses, svc.Status.LoadBalancer.Ingress[i].IP)
-					case len(svc.Status.LoadBalancer.Ingress[i].Hostname) > 0:
-						// Remove when the following supports the hostname address type:
-						// https://github.com/kubernetes-sigs/gateway-api/blob/v0.5.0/conformance/utils/kubernetes/helpers.go#L201-L207
-						if svc.Status.LoadBalancer.Ingress[i].Hostname == "localhost" {
-							addresses = append(addresses, "127.0.0.1")
-						}
-						hostnames = append(hostnames, svc.Status.LoadBalancer.Ingress[i].Hostname)
-					}
-				}
-			}
-
-			if svc.Spec.Type == corev1.ServiceTypeClusterIP {
-				for i := range svc.Spec.ClusterIPs {
-					if svc.Spec.ClusterIPs[i] != "" {
-						addresses = append(addresses, svc.Spec.ClusterIPs[i])
-					}
-				}
-			}
-
-			if svc.Spec.Type == corev1.ServiceTypeNodePort {
-				addresses = nodeAddresses
-			}
+			continue
 		}
 
+		svcAddresses, svcHostnames := serviceAddresses(svc, nodeAddresses)
+		addresses = append(addresses, svcAddresses...)
+		hostnames = append(hostnames, svcHostnames...)
+	}
+
+	if found {
 		var gwAddresses []gwapiv1.GatewayStatusAddress
+		seen := sets.New[string]()
 		for i := range addresses {
+			if seen.Has(addresses[i]) {
+				continue
+			}
+			seen.Insert(addresses[i])
 			addr := gwapiv1.GatewayStatusAddress{
 				Type:  ptr.To(gwapiv1.IPAddressType),
 				Value: addresses[i],
@@ -75,6 +90,10 @@ func UpdateGatewayStatusProgrammedCondition(gw *gwapiv1.Gateway, svc *corev1.Ser
 		}
 
 		for i := range hostnames {
+			if seen.Has(hostnames[i]) {
+				continue
+			}
+			seen.Insert(hostnames[i])
 			addr := gwapiv1.GatewayStatusAddress{
 				Type:  ptr.To(gwapiv1.HostnameAddressType),
 				Value: hostnames[i],
@@ -87,5 +106,47 @@ func UpdateGatewayStatusProgrammedCondition(gw *gwapiv1.Gateway, svc *corev1.Ser
 		gw.Status.Addresses = nil
 	}
 	// Update the programmed condition.
-	gw.Status.Conditions = MergeConditions(gw.Status.Conditions, computeGatewayProgrammedCondition(gw, deployment))
+	gw.Status.Conditions = MergeConditions(gw.Status.Conditions, computeGatewayProgrammedCondition(gw, envoyObj))
+}
+
+// serviceAddresses returns the IP addresses and the hostnames of svc, based on its type.
+func serviceAddresses(svc *corev1.Service, nodeAddresses []string) ([]string, []string) {
+	var addresses, hostnames []string
+	if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		for i := range svc.Status.LoadBalancer.Ingress {
+			switch {
+			case len(svc.Status.LoadBalancer.Ingress[i].IP) > 0:
+				addresses = append(addresses, svc.Status.LoadBalancer.Ingress[i].IP)
+			case len(svc.Status.LoadBalancer.Ingress[i].Hostname) > 0:
+				// Remove when the following supports the hostname address type:
+				// https://github.com/kubernetes-sigs/gateway-api/blob/v0.5.0/conformance/utils/kubernetes/helpers.go#L201-L207
+				if svc.Status.LoadBalancer.Ingress[i].Hostname == "localhost" {
+					addresses = append(addresses, "127.0.0.1")
+				}
+				hostnames = append(hostnames, svc.Status.LoadBalancer.Ingress[i].Hostname)
+			}
+		}
+	}
+
+	if svc.Spec.Type == corev1.ServiceTypeClusterIP {
+		for i := range svc.Spec.ClusterIPs {
+			if svc.Spec.ClusterIPs[i] != "" {
+				addresses = append(addresses, svc.Spec.ClusterIPs[i])
+			}
+		}
+	}
+
+	if svc.Spec.Type == corev1.ServiceTypeNodePort {
+		addresses = nodeAddresses
+	}
+
+	return addresses, hostnames
+}
+
+// UpdateGatewayStatusRejectedCondition updates the Programmed condition of the provided
+// Gateway to False, with the message of the Envoy proxies rejecting its xDS resources.
+func UpdateGatewayStatusRejectedCondition(gw *gwapiv1.Gateway, message string) {
+	gw.Status.Conditions = MergeConditions(gw.Status.Conditions,
+		newCondition(string(gwapiv1.GatewayConditionProgrammed), metav1.ConditionFalse,
+			string(gwapiv1.GatewayReasonInvalid), message, time.Now(), gw.Generation))
 }
@@ -121,8 +121,59 @@ func TestUpdateGatewayStatusProgrammedCondition(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			UpdateGatewayStatusProgrammedCondition(tt.args.gw, tt.args.svc, tt.args.deployment)
+			UpdateGatewayStatusProgrammedCondition(tt.args.gw, []*corev1.Service{tt.args.svc}, tt.args.deployment)
 			assert.True(t, reflect.DeepEqual(tt.args.addresses, tt.args.gw.Status.Addresses))
 		})
 	}
 }
+
+func TestUpdateGatewayStatusProgrammedConditionWithAdditionalServices(t *testing.T) {
+	gw := &gwapiv1.Gateway{
+		Spec: gwapiv1.GatewaySpec{
+			Addresses: []gwapiv1.GatewayAddress{{Value: "10.0.0.1"}},
+		},
+	}
+	svcs := []*corev1.Service{
+		{
+			Spec: corev1.ServiceSpec{
+				ClusterIPs:  []string{"10.96.0.1"},
+				ExternalIPs: []string{"10.0.0.1"},
+				Type:        corev1.ServiceTypeLoadBalancer,
+			},
+		},
+		{
+			Spec: corev1.ServiceSpec{
+				ClusterIPs: []string{"10.96.0.2"},
+				Type:       corev1.ServiceTypeLoadBalancer,
+			},
+			Status: corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{{Hostname: "internal.example.com"}},
+				},
+			},
+		},
+		{
+			Spec: corev1.ServiceSpec{
+				ClusterIPs: []string{"10.96.0.3"},
+				Type:       corev1.ServiceTypeClusterIP,
+			},
+		},
+	}
+
+	// The addresses of the Gateway spec are only reported for the Envoy service.
+	UpdateGatewayStatusProgrammedCondition(gw, svcs, nil)
+	assert.Equal(t, []gwapiv1.GatewayStatusAddress{
+		{
+			Type:  ptr.To(gwapiv1.IPAddressType),
+			Value: "10.0.0.1",
+		},
+		{
+			Type:  ptr.To(gwapiv1.IPAddressType),
+			Value: "10.96.0.3",
+		},
+		{
+			Type:  ptr.To(gwapiv1.HostnameAddressType),
+			Value: "internal.example.com",
+		},
+	}, gw.Status.Addresses)
+}
@@ -0,0 +1,20 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package status
+
+import "github.com/envoyproxy/gateway/internal/metrics"
+
+var (
+	statusUpdateDurationSeconds = metrics.NewHistogram("status_update_duration_seconds", "How long in seconds a status write to the API server takes.", []float64{0.001, 0.01, 0.1, 1, 5, 10})
+
+	statusUpdateTotal = metrics.NewCounter("status_update_total", "Total number of status writes to the API server.")
+
+	statusUpdateErrorsTotal = metrics.NewCounter("status_update_errors_total", "Total number of failed status writes to the API server.")
+
+	statusUpdateConflictsTotal = metrics.NewCounter("status_update_conflicts_total", "Total number of status writes rejected by the API server because of a conflict.")
+
+	kindLabel = metrics.NewLabel("kind")
+)
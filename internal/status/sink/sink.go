@@ -0,0 +1,87 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package sink
+
+import (
+	"context"
+	"strings"
+
+	"github.com/telepresenceio/watchable"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/message"
+)
+
+// Sink persists the statuses computed by Envoy Gateway for the resources it
+// manages, for providers that cannot write them back to the resources themselves.
+type Sink interface {
+	// Update records the latest status of the resource of the given kind.
+	Update(kind string, key types.NamespacedName, status any) error
+	// Delete removes the status of the resource of the given kind.
+	Delete(kind string, key types.NamespacedName) error
+}
+
+// Subscribe subscribes to the gateway API and policy status updates and
+// forwards them to the sinks, until ctx is done.
+func Subscribe(ctx context.Context, resources *message.ProviderResources, sinks ...Sink) {
+	go subscribe(ctx, gatewayapi.KindGateway, &resources.GatewayStatuses, sinks)
+	go subscribe(ctx, gatewayapi.KindHTTPRoute, &resources.HTTPRouteStatuses, sinks)
+	go subscribe(ctx, gatewayapi.KindGRPCRoute, &resources.GRPCRouteStatuses, sinks)
+	go subscribe(ctx, gatewayapi.KindTLSRoute, &resources.TLSRouteStatuses, sinks)
+	go subscribe(ctx, gatewayapi.KindTCPRoute, &resources.TCPRouteStatuses, sinks)
+	go subscribe(ctx, gatewayapi.KindUDPRoute, &resources.UDPRouteStatuses, sinks)
+	go subscribe(ctx, v1alpha1.KindClientTrafficPolicy, &resources.ClientTrafficPolicyStatuses, sinks)
+	go subscribe(ctx, v1alpha1.KindBackendTrafficPolicy, &resources.BackendTrafficPolicyStatuses, sinks)
+	go subscribe(ctx, v1alpha1.KindEnvoyPatchPolicy, &resources.EnvoyPatchPolicyStatuses, sinks)
+	go subscribe(ctx, v1alpha1.KindSecurityPolicy, &resources.SecurityPolicyStatuses, sinks)
+	go subscribe(ctx, gatewayapi.KindBackendTLSPolicy, &resources.BackendTLSPolicyStatuses, sinks)
+	go subscribe(ctx, v1alpha1.KindEnvoyExtensionPolicy, &resources.EnvoyExtensionPolicyStatuses, sinks)
+	go subscribeExtensionPolicies(ctx, &resources.ExtensionPolicyStatuses, sinks)
+}
+
+func subscribe[V any](ctx context.Context, kind string, statuses *watchable.Map[types.NamespacedName, V], sinks []Sink) {
+	message.HandleSubscription(
+		message.Metadata{Runner: string(v1alpha1.LogComponentProviderRunner), Message: strings.ToLower(kind) + "-status-sink"},
+		statuses.Subscribe(ctx),
+		func(update message.Update[types.NamespacedName, V], errChan chan error) {
+			for _, s := range sinks {
+				var err error
+				if update.Delete {
+					err = s.Delete(kind, update.Key)
+				} else {
+					err = s.Update(kind, update.Key, update.Value)
+				}
+				if err != nil {
+					errChan <- err
+				}
+			}
+		},
+	)
+}
+
+// subscribeExtensionPolicies forwards the statuses of the policies introduced by
+// an extension, whose kind is only known from the key of their status.
+func subscribeExtensionPolicies[V any](ctx context.Context, statuses *watchable.Map[message.NamespacedNameAndGVK, V], sinks []Sink) {
+	message.HandleSubscription(
+		message.Metadata{Runner: string(v1alpha1.LogComponentProviderRunner), Message: "extensionpolicy-status-sink"},
+		statuses.Subscribe(ctx),
+		func(update message.Update[message.NamespacedNameAndGVK, V], errChan chan error) {
+			for _, s := range sinks {
+				var err error
+				if update.Delete {
+					err = s.Delete(update.Key.Kind, update.Key.NamespacedName)
+				} else {
+					err = s.Update(update.Key.Kind, update.Key.NamespacedName, update.Value)
+				}
+				if err != nil {
+					errChan <- err
+				}
+			}
+		},
+	)
+}
@@ -0,0 +1,100 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/envoyproxy/gateway/internal/message"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resources := new(message.ProviderResources)
+	store := NewStore()
+	Subscribe(ctx, resources, store)
+
+	gtw := types.NamespacedName{Namespace: "default", Name: "eg"}
+	policy := types.NamespacedName{Namespace: "default", Name: "policy"}
+	resources.GatewayStatuses.Store(gtw, &gwapiv1.GatewayStatus{})
+	resources.SecurityPolicyStatuses.Store(policy, &gwapiv1a2.PolicyStatus{})
+
+	require.Eventually(t, func() bool {
+		return len(store.List("", "", "")) == 2
+	}, time.Second, 10*time.Millisecond)
+	require.Len(t, store.List("Gateway", "", ""), 1)
+	require.Len(t, store.List("", "default", "policy"), 1)
+
+	resources.GatewayStatuses.Delete(gtw)
+	require.Eventually(t, func() bool {
+		return len(store.List("Gateway", "", "")) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestStoreServeHTTP(t *testing.T) {
+	store := NewStore()
+	require.NoError(t, store.Update("Gateway", types.NamespacedName{Namespace: "default", Name: "b"}, &gwapiv1.GatewayStatus{}))
+	require.NoError(t, store.Update("Gateway", types.NamespacedName{Namespace: "default", Name: "a"}, &gwapiv1.GatewayStatus{}))
+	require.NoError(t, store.Update("HTTPRoute", types.NamespacedName{Namespace: "default", Name: "a"}, &gwapiv1.HTTPRouteStatus{}))
+
+	testCases := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name: "all",
+			want: []string{"Gateway/a", "Gateway/b", "HTTPRoute/a"},
+		},
+		{
+			name:  "by kind",
+			query: "?kind=Gateway",
+			want:  []string{"Gateway/a", "Gateway/b"},
+		},
+		{
+			name:  "by name",
+			query: "?namespace=default&name=a",
+			want:  []string{"Gateway/a", "HTTPRoute/a"},
+		},
+		{
+			name:  "no match",
+			query: "?namespace=other",
+			want:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			store.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/status"+tc.query, nil))
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var got []ResourceStatus
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			names := []string{}
+			for _, s := range got {
+				names = append(names, s.Kind+"/"+s.Name)
+			}
+			require.Equal(t, tc.want, names)
+		})
+	}
+
+	rec := httptest.NewRecorder()
+	store.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/status", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -0,0 +1,111 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package sink
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Store is a Sink that keeps the statuses in memory, and serves them as JSON
+// over HTTP. The statuses can be filtered with the "kind", "namespace" and
+// "name" query parameters.
+type Store struct {
+	mu       sync.RWMutex
+	statuses map[string]map[types.NamespacedName]any
+}
+
+// ResourceStatus is the status of a single resource, as served by the Store.
+type ResourceStatus struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Status    any    `json:"status"`
+}
+
+// NewStore returns a new, empty Store.
+func NewStore() *Store {
+	return &Store{
+		statuses: map[string]map[types.NamespacedName]any{},
+	}
+}
+
+// Update implements Sink.
+func (s *Store) Update(kind string, key types.NamespacedName, status any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.statuses[kind] == nil {
+		s.statuses[kind] = map[types.NamespacedName]any{}
+	}
+	s.statuses[kind][key] = status
+	return nil
+}
+
+// Delete implements Sink.
+func (s *Store) Delete(kind string, key types.NamespacedName) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.statuses[kind], key)
+	return nil
+}
+
+// List returns the statuses matching the filters, sorted by kind, namespace
+// and name. Empty filters match all the resources.
+func (s *Store) List(kind, namespace, name string) []ResourceStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	list := []ResourceStatus{}
+	for k, statuses := range s.statuses {
+		if kind != "" && k != kind {
+			continue
+		}
+		for key, status := range statuses {
+			if (namespace != "" && key.Namespace != namespace) || (name != "" && key.Name != name) {
+				continue
+			}
+			list = append(list, ResourceStatus{
+				Kind:      k,
+				Namespace: key.Namespace,
+				Name:      key.Name,
+				Status:    status,
+			})
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Kind != list[j].Kind {
+			return list[i].Kind < list[j].Kind
+		}
+		if list[i].Namespace != list[j].Namespace {
+			return list[i].Namespace < list[j].Namespace
+		}
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Store) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	list := s.List(query.Get("kind"), query.Get("namespace"), query.Get("name"))
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -15,17 +15,22 @@ package status
 
 import (
 	"context"
+	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
@@ -61,18 +66,23 @@ type UpdateHandler struct {
 	client        client.Client
 	sendUpdates   chan struct{}
 	updateChannel chan Update
+	// needLeaderElection is false when the Gateways are sharded, since every
+	// replica then writes the statuses of the resources of its shard.
+	needLeaderElection bool
 }
 
-func NewUpdateHandler(log logr.Logger, client client.Client) *UpdateHandler {
+func NewUpdateHandler(log logr.Logger, client client.Client, needLeaderElection bool) *UpdateHandler {
 	return &UpdateHandler{
-		log:           log,
-		client:        client,
-		sendUpdates:   make(chan struct{}),
-		updateChannel: make(chan Update, 100),
+		log:                log,
+		client:             client,
+		sendUpdates:        make(chan struct{}),
+		updateChannel:      make(chan Update, 100),
+		needLeaderElection: needLeaderElection,
 	}
 }
 
 func (u *UpdateHandler) apply(update Update) {
+	kind := kindOf(update.Resource)
 	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		obj := update.Resource
 
@@ -95,15 +105,31 @@ func (u *UpdateHandler) apply(update Update) {
 
 		newObj.SetUID(obj.GetUID())
 
-		return u.client.Status().Update(context.Background(), newObj)
+		startTime := time.Now()
+		err := u.client.Status().Update(context.Background(), newObj)
+		statusUpdateTotal.With(kindLabel.Value(kind)).Increment()
+		statusUpdateDurationSeconds.With(kindLabel.Value(kind)).Record(time.Since(startTime).Seconds())
+		if kerrors.IsConflict(err) {
+			statusUpdateConflictsTotal.With(kindLabel.Value(kind)).Increment()
+		}
+		return err
 	}); err != nil {
+		statusUpdateErrorsTotal.With(kindLabel.Value(kind)).Increment()
 		u.log.Error(err, "unable to update status", "name", update.NamespacedName.Name,
 			"namespace", update.NamespacedName.Namespace)
 	}
 }
 
+// kindOf returns the kind of the object whose status is updated.
+func kindOf(obj client.Object) string {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u.GetKind()
+	}
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
+
 func (u *UpdateHandler) NeedLeaderElection() bool {
-	return true
+	return u.needLeaderElection
 }
 
 // Start runs the goroutine to perform status writes.
@@ -171,6 +197,8 @@ func (u *UpdateWriter) Send(update Update) {
 //	ClientTrafficPolicy
 //	SecurityPolicy
 //	BackendTLSPolicy
+//	EnvoyExtensionPolicy
+//	Unstructured policies introduced by an extension
 func isStatusEqual(objA, objB interface{}) bool {
 	opts := cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")
 	switch a := objA.(type) {
@@ -240,8 +268,8 @@ func isStatusEqual(objA, objB interface{}) bool {
 				return true
 			}
 		}
-	case gwapiv1a2.BackendTLSPolicy:
-		if b, ok := objB.(*gwapiv1a2.BackendTLSPolicy); ok {
+	case gwapiv1a3.BackendTLSPolicy:
+		if b, ok := objB.(*gwapiv1a3.BackendTLSPolicy); ok {
 			if cmp.Equal(a.Status, b.Status, opts) {
 				return true
 			}
@@ -252,6 +280,26 @@ func isStatusEqual(objA, objB interface{}) bool {
 				return true
 			}
 		}
+	case *unstructured.Unstructured:
+		if b, ok := objB.(*unstructured.Unstructured); ok {
+			if cmp.Equal(unstructuredPolicyStatus(a), unstructuredPolicyStatus(b), opts) {
+				return true
+			}
+		}
 	}
 	return false
 }
+
+// unstructuredPolicyStatus returns the status of an unstructured policy introduced
+// by an extension, so that the LastTransitionTime of its conditions can be ignored.
+func unstructuredPolicyStatus(u *unstructured.Unstructured) *gwapiv1a2.PolicyStatus {
+	s, ok := u.Object["status"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	policyStatus := &gwapiv1a2.PolicyStatus{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(s, policyStatus); err != nil {
+		return nil
+	}
+	return policyStatus
+}
@@ -0,0 +1,49 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package status
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+)
+
+func TestKindOf(t *testing.T) {
+	policy := &unstructured.Unstructured{}
+	policy.SetKind("ExtensionPolicy")
+
+	tests := []struct {
+		name string
+		obj  client.Object
+		want string
+	}{
+		{
+			name: "gateway",
+			obj:  &gwapiv1.Gateway{},
+			want: "Gateway",
+		},
+		{
+			name: "envoy gateway policy",
+			obj:  &egv1a1.SecurityPolicy{},
+			want: "SecurityPolicy",
+		},
+		{
+			name: "extension policy",
+			obj:  policy,
+			want: "ExtensionPolicy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, kindOf(tt.obj))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package supervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// HealthzPath is the path the health of the runners is served on.
+	HealthzPath = "/healthz"
+	// ReadyzPath is the path the readiness of the runners is served on.
+	ReadyzPath = "/readyz"
+)
+
+// healthResponse is the response of the health and readiness endpoints.
+type healthResponse struct {
+	OK      bool           `json:"ok"`
+	Runners []RunnerStatus `json:"runners"`
+}
+
+// HealthzHandler serves the health of all the runners on HealthzPath, and of a
+// single runner on HealthzPath/<runner>. A runner is healthy unless it crashed
+// more than the maximum number of consecutive restarts.
+func (s *Supervisor) HealthzHandler() http.Handler {
+	return s.handler(HealthzPath, (*RunnerStatus).Healthy)
+}
+
+// ReadyzHandler serves the readiness of all the runners on ReadyzPath, and of
+// a single runner on ReadyzPath/<runner>. A runner is ready while it's running.
+func (s *Supervisor) ReadyzHandler() http.Handler {
+	return s.handler(ReadyzPath, (*RunnerStatus).Ready)
+}
+
+// Healthz returns an error if a runner is unhealthy. It's a health check of
+// the controller-runtime probes.
+func (s *Supervisor) Healthz(_ *http.Request) error {
+	return s.check((*RunnerStatus).Healthy, "unhealthy")
+}
+
+// Readyz returns an error if a runner isn't ready. It's a readiness check of
+// the controller-runtime probes.
+func (s *Supervisor) Readyz(_ *http.Request) error {
+	return s.check((*RunnerStatus).Ready, "not ready")
+}
+
+func (s *Supervisor) check(check func(*RunnerStatus) bool, reason string) error {
+	for _, status := range s.Statuses() {
+		if !check(&status) {
+			return fmt.Errorf("runner %s is %s: %s", status.Name, reason, status.State)
+		}
+	}
+	return nil
+}
+
+func (s *Supervisor) handler(path string, check func(*RunnerStatus) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		name := strings.Trim(strings.TrimPrefix(req.URL.Path, path), "/")
+		res := healthResponse{OK: true, Runners: []RunnerStatus{}}
+		for _, status := range s.Statuses() {
+			if name != "" && status.Name != name {
+				continue
+			}
+			res.OK = res.OK && check(&status)
+			res.Runners = append(res.Runners, status)
+		}
+		if name != "" && len(res.Runners) == 0 {
+			http.Error(w, "unknown runner "+name, http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !res.OK {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
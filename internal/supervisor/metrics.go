@@ -0,0 +1,18 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package supervisor
+
+import "github.com/envoyproxy/gateway/internal/metrics"
+
+var (
+	runnerPanicsTotal = metrics.NewCounter("runner_panics_total", "Total number of panics recovered in the runners.")
+
+	runnerRestartsTotal = metrics.NewCounter("runner_restarts_total", "Total number of restarts of the runners.")
+
+	runnerReady = metrics.NewGauge("runner_ready", "Whether the runner is running.")
+
+	runnerLabel = metrics.NewLabel("runner")
+)
@@ -0,0 +1,290 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+const (
+	// defaultMinBackoff is the delay before the first restart of a runner.
+	defaultMinBackoff = time.Second
+	// defaultMaxBackoff bounds the delay between the restarts of a runner.
+	defaultMaxBackoff = time.Minute
+	// defaultMaxRestarts is the number of consecutive restarts after which a
+	// runner is considered failed.
+	defaultMaxRestarts = 5
+	// defaultStableAfter is the time a restarted runner must run without
+	// crashing for its consecutive restarts to be reset.
+	defaultStableAfter = 5 * time.Minute
+)
+
+// Runner is a stage of the Envoy Gateway pipeline. Start starts the goroutines
+// of the runner, which run until ctx is done.
+type Runner interface {
+	Name() string
+	Start(ctx context.Context) error
+}
+
+// State is the state of a supervised runner.
+type State string
+
+const (
+	// StateRunning is the state of a runner which started.
+	StateRunning State = "Running"
+	// StateRestarting is the state of a runner which crashed, until it's
+	// restarted.
+	StateRestarting State = "Restarting"
+	// StateFailed is the state of a runner which crashed more than the maximum
+	// number of consecutive restarts, and isn't restarted anymore.
+	StateFailed State = "Failed"
+)
+
+// RunnerStatus is the status of a supervised runner.
+type RunnerStatus struct {
+	Name  string `json:"name"`
+	State State  `json:"state"`
+	// Restarts is the number of times the runner was restarted.
+	Restarts int `json:"restarts"`
+	// LastError is the last panic or start error of the runner.
+	LastError     string     `json:"lastError,omitempty"`
+	LastErrorTime *time.Time `json:"lastErrorTime,omitempty"`
+}
+
+// Ready returns whether the runner is running.
+func (s *RunnerStatus) Ready() bool {
+	return s.State == StateRunning
+}
+
+// Healthy returns whether the runner is running, or is being restarted.
+func (s *RunnerStatus) Healthy() bool {
+	return s.State != StateFailed
+}
+
+// Supervisor starts the runners, and restarts a runner when one of its
+// goroutines started with Go panics, with an exponential backoff.
+type Supervisor struct {
+	logger logging.Logger
+
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	maxRestarts int
+	stableAfter time.Duration
+
+	mu      sync.RWMutex
+	runners []*supervisedRunner
+}
+
+// New returns a Supervisor.
+func New(logger logging.Logger) *Supervisor {
+	return &Supervisor{
+		logger:      logger.WithName("supervisor"),
+		minBackoff:  defaultMinBackoff,
+		maxBackoff:  defaultMaxBackoff,
+		maxRestarts: defaultMaxRestarts,
+		stableAfter: defaultStableAfter,
+	}
+}
+
+type supervisedRunner struct {
+	runner     Runner
+	supervisor *Supervisor
+	// status is guarded by the mutex of the supervisor.
+	status RunnerStatus
+	// consecutive is the number of restarts since the runner last ran for
+	// the stable period.
+	consecutive int
+	// startedAt is the last time the runner was started.
+	startedAt time.Time
+}
+
+// Start starts the runner, and supervises it until ctx is done. It returns the
+// error of the first start of the runner, if any.
+func (s *Supervisor) Start(ctx context.Context, runner Runner) error {
+	sr := &supervisedRunner{
+		runner:     runner,
+		supervisor: s,
+		status:     RunnerStatus{Name: runner.Name(), State: StateRestarting},
+	}
+	r, err := sr.start(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	sr.status.State = StateRunning
+	s.runners = append(s.runners, sr)
+	s.mu.Unlock()
+	runnerReady.With(runnerLabel.Value(runner.Name())).Record(1)
+
+	go sr.supervise(ctx, r)
+	return nil
+}
+
+// run is a run of a runner, from its start until it crashes or is stopped.
+type run struct {
+	// name is the name of the runner.
+	name string
+	// crashed receives the first panic or failure of the goroutines of the run.
+	crashed chan error
+	cancel  context.CancelFunc
+}
+
+// start starts a new run of the runner.
+func (sr *supervisedRunner) start(ctx context.Context) (*run, error) {
+	r := &run{name: sr.runner.Name(), crashed: make(chan error, 1)}
+	runCtx, cancel := context.WithCancel(context.WithValue(ctx, runKey{}, r))
+	r.cancel = cancel
+	if err := sr.runner.Start(runCtx); err != nil {
+		cancel()
+		return nil, err
+	}
+	sr.startedAt = time.Now()
+	return r, nil
+}
+
+// supervise restarts the runner whenever it crashes, until ctx is done.
+func (sr *supervisedRunner) supervise(ctx context.Context, r *run) {
+	s := sr.supervisor
+	name := sr.runner.Name()
+	for {
+		select {
+		case <-ctx.Done():
+			r.cancel()
+			return
+		case err := <-r.crashed:
+			// Stop the other goroutines of the runner before restarting it
+			r.cancel()
+			s.logger.Error(err, "runner crashed", "runner", name)
+			if time.Since(sr.startedAt) >= s.stableAfter {
+				sr.consecutive = 0
+			}
+			sr.setError(err, StateRestarting)
+
+			if r = sr.restart(ctx); r == nil {
+				return
+			}
+		}
+	}
+}
+
+// restart starts a new run of the runner after a backoff, or returns nil if
+// the runner failed or ctx is done.
+func (sr *supervisedRunner) restart(ctx context.Context) *run {
+	s := sr.supervisor
+	name := sr.runner.Name()
+	for {
+		if sr.consecutive >= s.maxRestarts {
+			s.logger.Info("runner failed, giving up restarting it", "runner", name, "restarts", sr.consecutive)
+			sr.setState(StateFailed)
+			runnerReady.With(runnerLabel.Value(name)).Record(0)
+			return nil
+		}
+
+		backoff := s.minBackoff << sr.consecutive
+		if backoff > s.maxBackoff || backoff <= 0 {
+			backoff = s.maxBackoff
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+
+		sr.consecutive++
+		s.mu.Lock()
+		sr.status.Restarts++
+		s.mu.Unlock()
+		runnerRestartsTotal.With(runnerLabel.Value(name)).Increment()
+
+		r, err := sr.start(ctx)
+		if err != nil {
+			s.logger.Error(err, "failed to restart runner", "runner", name)
+			sr.setError(err, StateRestarting)
+			continue
+		}
+		s.logger.Info("restarted runner", "runner", name)
+		sr.setState(StateRunning)
+		return r
+	}
+}
+
+func (sr *supervisedRunner) setError(err error, state State) {
+	now := time.Now()
+	sr.supervisor.mu.Lock()
+	sr.status.LastError = err.Error()
+	sr.status.LastErrorTime = &now
+	sr.status.State = state
+	sr.supervisor.mu.Unlock()
+	runnerReady.With(runnerLabel.Value(sr.runner.Name())).Record(0)
+}
+
+func (sr *supervisedRunner) setState(state State) {
+	sr.supervisor.mu.Lock()
+	sr.status.State = state
+	sr.supervisor.mu.Unlock()
+	if state == StateRunning {
+		runnerReady.With(runnerLabel.Value(sr.runner.Name())).Record(1)
+	}
+}
+
+// Statuses returns the statuses of the runners, in the order they were started.
+func (s *Supervisor) Statuses() []RunnerStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	statuses := make([]RunnerStatus, 0, len(s.runners))
+	for _, sr := range s.runners {
+		statuses = append(statuses, sr.status)
+	}
+	return statuses
+}
+
+type runKey struct{}
+
+// Fail reports err to the supervisor of the runner ctx was passed to, which
+// restarts the runner as if one of its goroutines panicked. It's meant for the
+// goroutines which can't recover from an error, e.g. a server which stopped
+// serving. It panics if the runner isn't supervised.
+func Fail(ctx context.Context, err error) {
+	r, _ := ctx.Value(runKey{}).(*run)
+	if r == nil {
+		panic(err)
+	}
+	// Only the first failure of a run restarts the runner
+	select {
+	case r.crashed <- err:
+	default:
+	}
+}
+
+// Go runs f in a new goroutine. If f panics, the panic is recovered and
+// reported to the supervisor of the runner ctx was passed to, which restarts
+// the runner. The panic isn't recovered if the runner isn't supervised.
+func Go(ctx context.Context, f func()) {
+	r, _ := ctx.Value(runKey{}).(*run)
+	go func() {
+		if r != nil {
+			defer func() {
+				if p := recover(); p != nil {
+					err := fmt.Errorf("panic: %v\n%s", p, debug.Stack())
+					runnerPanicsTotal.With(runnerLabel.Value(r.name)).Increment()
+					// Only the first panic of a run restarts the runner
+					select {
+					case r.crashed <- err:
+					default:
+					}
+				}
+			}()
+		}
+		f()
+	}()
+}
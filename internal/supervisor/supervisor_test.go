@@ -0,0 +1,202 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package supervisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+// testRunner panics in a goroutine of its first runs.
+type testRunner struct {
+	name string
+	// panics is the number of runs which panic.
+	panics int32
+	// fails is the number of runs which report a failure, after the ones
+	// which panic.
+	fails  int32
+	starts atomic.Int32
+	// stopped counts the runs whose context was cancelled.
+	stopped atomic.Int32
+	// okStarts is the number of starts which succeed when startErr is set.
+	okStarts int32
+	startErr error
+}
+
+func (r *testRunner) Name() string {
+	return r.name
+}
+
+func (r *testRunner) Start(ctx context.Context) error {
+	n := r.starts.Add(1)
+	if n > r.okStarts && r.startErr != nil {
+		return r.startErr
+	}
+	Go(ctx, func() {
+		<-ctx.Done()
+		r.stopped.Add(1)
+	})
+	Go(ctx, func() {
+		if n <= r.panics {
+			panic("boom")
+		}
+		if n <= r.panics+r.fails {
+			Fail(ctx, errors.New("serve failed"))
+		}
+	})
+	return nil
+}
+
+func newTestSupervisor() *Supervisor {
+	s := New(logging.DefaultLogger(v1alpha1.LogLevelInfo))
+	s.minBackoff = time.Millisecond
+	s.maxBackoff = 10 * time.Millisecond
+	s.maxRestarts = 3
+	return s
+}
+
+func TestSupervisorRestart(t *testing.T) {
+	s := newTestSupervisor()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stable := &testRunner{name: "stable"}
+	crashing := &testRunner{name: "crashing", panics: 2}
+	require.NoError(t, s.Start(ctx, stable))
+	require.NoError(t, s.Start(ctx, crashing))
+
+	// The runner is restarted until it stops crashing
+	require.Eventually(t, func() bool {
+		return crashing.starts.Load() == 3 && s.Statuses()[1].State == StateRunning
+	}, time.Second, 5*time.Millisecond)
+	// The goroutines of the crashed runs are stopped
+	require.Eventually(t, func() bool {
+		return crashing.stopped.Load() == 2
+	}, time.Second, 5*time.Millisecond)
+
+	statuses := s.Statuses()
+	require.Len(t, statuses, 2)
+	require.Equal(t, RunnerStatus{Name: "stable", State: StateRunning}, statuses[0])
+	require.Equal(t, "crashing", statuses[1].Name)
+	require.Equal(t, 2, statuses[1].Restarts)
+	require.Contains(t, statuses[1].LastError, "panic: boom")
+	require.NotNil(t, statuses[1].LastErrorTime)
+	require.Equal(t, int32(1), stable.starts.Load())
+
+	// The runners are stopped with the context
+	cancel()
+	require.Eventually(t, func() bool {
+		return stable.stopped.Load() == 1 && crashing.stopped.Load() == 3
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestSupervisorFail(t *testing.T) {
+	s := newTestSupervisor()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The runner is restarted until it stops reporting failures
+	failing := &testRunner{name: "failing", fails: 2}
+	require.NoError(t, s.Start(ctx, failing))
+	require.Eventually(t, func() bool {
+		return failing.starts.Load() == 3 && s.Statuses()[0].State == StateRunning
+	}, time.Second, 5*time.Millisecond)
+	require.Equal(t, 2, s.Statuses()[0].Restarts)
+	require.Equal(t, "serve failed", s.Statuses()[0].LastError)
+
+	// Unsupervised runners panic
+	require.PanicsWithError(t, "serve failed", func() {
+		Fail(context.Background(), errors.New("serve failed"))
+	})
+}
+
+func TestSupervisorFailed(t *testing.T) {
+	s := newTestSupervisor()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The runner fails after the maximum number of consecutive restarts
+	crashing := &testRunner{name: "crashing", panics: 100}
+	require.NoError(t, s.Start(ctx, crashing))
+	require.Eventually(t, func() bool {
+		return s.Statuses()[0].State == StateFailed
+	}, time.Second, 5*time.Millisecond)
+	require.Equal(t, int32(4), crashing.starts.Load())
+
+	// The runner fails when it can't be restarted
+	failing := &testRunner{name: "failing", panics: 1, okStarts: 1, startErr: errors.New("no port")}
+	require.NoError(t, s.Start(ctx, failing))
+	require.Eventually(t, func() bool {
+		return s.Statuses()[1].State == StateFailed
+	}, time.Second, 5*time.Millisecond)
+	require.Equal(t, "no port", s.Statuses()[1].LastError)
+
+	// The first start error is returned
+	require.Error(t, s.Start(ctx, &testRunner{name: "broken", startErr: errors.New("no port")}))
+	require.Len(t, s.Statuses(), 2)
+}
+
+func TestHealthHandlers(t *testing.T) {
+	s := newTestSupervisor()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.Start(ctx, &testRunner{name: "stable"}))
+	require.NoError(t, s.Start(ctx, &testRunner{name: "crashing", panics: 100}))
+	require.Eventually(t, func() bool {
+		return s.Statuses()[1].State == StateFailed
+	}, time.Second, 5*time.Millisecond)
+
+	mux := http.NewServeMux()
+	mux.Handle(HealthzPath, s.HealthzHandler())
+	mux.Handle(HealthzPath+"/", s.HealthzHandler())
+	mux.Handle(ReadyzPath, s.ReadyzHandler())
+	mux.Handle(ReadyzPath+"/", s.ReadyzHandler())
+
+	testCases := []struct {
+		path        string
+		wantCode    int
+		wantRunners []string
+	}{
+		{path: "/healthz", wantCode: http.StatusServiceUnavailable, wantRunners: []string{"stable", "crashing"}},
+		{path: "/healthz/stable", wantCode: http.StatusOK, wantRunners: []string{"stable"}},
+		{path: "/healthz/crashing", wantCode: http.StatusServiceUnavailable, wantRunners: []string{"crashing"}},
+		{path: "/readyz/stable", wantCode: http.StatusOK, wantRunners: []string{"stable"}},
+		{path: "/readyz", wantCode: http.StatusServiceUnavailable, wantRunners: []string{"stable", "crashing"}},
+		{path: "/readyz/unknown", wantCode: http.StatusNotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			require.Equal(t, tc.wantCode, rec.Code)
+			if tc.wantRunners == nil {
+				return
+			}
+			res := healthResponse{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.Equal(t, tc.wantCode == http.StatusOK, res.OK)
+			var names []string
+			for _, status := range res.Runners {
+				names = append(names, status.Name)
+			}
+			require.Equal(t, tc.wantRunners, names)
+		})
+	}
+
+	require.EqualError(t, s.Healthz(nil), "runner crashing is unhealthy: Failed")
+	require.EqualError(t, s.Readyz(nil), "runner crashing is not ready: Failed")
+}
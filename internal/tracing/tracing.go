@@ -0,0 +1,100 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+const (
+	// instrumentationName is the name of the tracer of Envoy Gateway.
+	instrumentationName = "github.com/envoyproxy/gateway"
+	// serviceName is the service name of the traces of Envoy Gateway.
+	serviceName = "envoy-gateway"
+)
+
+var tracingLogger = logging.DefaultLogger(v1alpha1.LogLevelInfo).WithName("tracing")
+
+// Tracer returns the tracer of Envoy Gateway. Its spans are only exported
+// once tracing is initialized with sinks.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// End records err on the span if not nil, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Init sets the global tracer provider to export the traces of Envoy Gateway
+// to the configured sinks, if any. It returns a function flushing the pending
+// traces and shutting down the tracer provider.
+func Init(cfg *config.Server) (func(context.Context) error, error) {
+	tracing := cfg.EnvoyGateway.GetEnvoyGatewayTracing()
+	if tracing == nil || len(tracing.Sinks) == 0 {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(
+			sdktrace.TraceIDRatioBased(float64(tracing.GetSamplingRate()) / 100))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	}
+	for _, sink := range tracing.Sinks {
+		if sink.Type != v1alpha1.TraceSinkTypeOpenTelemetry || sink.OpenTelemetry == nil {
+			continue
+		}
+		exporter, err := newOTELExporter(sink.OpenTelemetry)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// newOTELExporter returns an OTLP exporter of the traces to the sink.
+func newOTELExporter(sink *v1alpha1.EnvoyGatewayOpenTelemetrySink) (sdktrace.SpanExporter, error) {
+	address := net.JoinHostPort(sink.Host, fmt.Sprint(sink.Port))
+	switch sink.Protocol {
+	case v1alpha1.HTTPProtocol:
+		tracingLogger.Info("initialized otel http trace push endpoint", "address", address)
+		return otlptracehttp.New(context.Background(),
+			otlptracehttp.WithEndpoint(address),
+			otlptracehttp.WithInsecure(),
+		)
+	case v1alpha1.GRPCProtocol:
+		tracingLogger.Info("initialized otel grpc trace push endpoint", "address", address)
+		return otlptracegrpc.New(context.Background(),
+			otlptracegrpc.WithEndpoint(address),
+			otlptracegrpc.WithInsecure(),
+		)
+	default:
+		return nil, fmt.Errorf("unsupported trace sink protocol %q", sink.Protocol)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+	"k8s.io/utils/ptr"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+)
+
+func TestInit(t *testing.T) {
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	testCases := []struct {
+		name         string
+		tracing      *v1alpha1.EnvoyGatewayTracing
+		wantProvider bool
+		wantErr      bool
+	}{
+		{
+			name: "tracing disabled",
+		},
+		{
+			name:    "no sinks",
+			tracing: &v1alpha1.EnvoyGatewayTracing{},
+		},
+		{
+			name: "grpc sink",
+			tracing: &v1alpha1.EnvoyGatewayTracing{
+				SamplingRate: ptr.To[uint32](50),
+				Sinks: []v1alpha1.EnvoyGatewayTraceSink{{
+					Type:          v1alpha1.TraceSinkTypeOpenTelemetry,
+					OpenTelemetry: &v1alpha1.EnvoyGatewayOpenTelemetrySink{Host: "localhost", Protocol: v1alpha1.GRPCProtocol, Port: 4317},
+				}},
+			},
+			wantProvider: true,
+		},
+		{
+			name: "http sink",
+			tracing: &v1alpha1.EnvoyGatewayTracing{
+				Sinks: []v1alpha1.EnvoyGatewayTraceSink{{
+					Type:          v1alpha1.TraceSinkTypeOpenTelemetry,
+					OpenTelemetry: &v1alpha1.EnvoyGatewayOpenTelemetrySink{Host: "localhost", Protocol: v1alpha1.HTTPProtocol, Port: 4318},
+				}},
+			},
+			wantProvider: true,
+		},
+		{
+			name: "unsupported protocol",
+			tracing: &v1alpha1.EnvoyGatewayTracing{
+				Sinks: []v1alpha1.EnvoyGatewayTraceSink{{
+					Type:          v1alpha1.TraceSinkTypeOpenTelemetry,
+					OpenTelemetry: &v1alpha1.EnvoyGatewayOpenTelemetrySink{Host: "localhost", Protocol: "udp", Port: 4317},
+				}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			otel.SetTracerProvider(noop.NewTracerProvider())
+			cfg, err := config.New()
+			require.NoError(t, err)
+			cfg.EnvoyGateway.Telemetry = &v1alpha1.EnvoyGatewayTelemetry{Tracing: tc.tracing}
+
+			shutdown, err := Init(cfg)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_, isSDK := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+			require.Equal(t, tc.wantProvider, isSDK)
+			require.NoError(t, shutdown(context.Background()))
+		})
+	}
+}
+
+func TestEnd(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, span := tracer.Start(context.Background(), "ok")
+	End(span, nil)
+	_, span = tracer.Start(context.Background(), "failed")
+	End(span, errors.New("boom"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, codes.Unset, spans[0].Status().Code)
+	require.Equal(t, codes.Error, spans[1].Status().Code)
+	require.Equal(t, "boom", spans[1].Status().Description)
+}
@@ -0,0 +1,433 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package wasm
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+const (
+	// DefaultCacheDir is the default directory the wasm modules are cached in.
+	DefaultCacheDir = "/var/lib/eg/wasm"
+
+	// defaultPullTimeout is the default timeout to pull a wasm module.
+	defaultPullTimeout = 30 * time.Second
+	// defaultMaxIdle is the default duration after which a module that has
+	// not been used is evicted from the cache.
+	defaultMaxIdle = 24 * time.Hour
+	// errorRetryInterval is the interval during which a failed pull is not
+	// retried, so that an unreachable registry does not slow down every
+	// translation.
+	errorRetryInterval = time.Minute
+	// latestRefreshInterval is the interval after which the images referenced
+	// by the "latest" tag are pulled again.
+	latestRefreshInterval = 5 * time.Minute
+	// moduleExt is the extension of the cached wasm module files.
+	moduleExt = ".wasm"
+)
+
+// ErrPullPending is returned by Get while the wasm module of the image is
+// being pulled.
+var ErrPullPending = errors.New("wasm module pull in progress")
+
+// Cache fetches the wasm modules of OCI images, and returns the URL they are
+// served at to the Envoy proxies.
+type Cache interface {
+	// Get returns the wasm module of the OCI image. If it is not cached yet,
+	// it is pulled in the background and ErrPullPending is returned. Images are
+	// pulled once, except for the images referenced by the "latest" tag which
+	// are pulled again periodically.
+	Get(image string, opts GetOptions) (*Module, error)
+	// URL returns the URL the wasm module with the checksum is served at to
+	// the Envoy proxies of the scope, e.g. the proxies of a Gateway. The
+	// module is not served to the proxies of the other scopes at this URL.
+	URL(checksum, scope string) string
+	// Pulled returns a channel receiving a value when a pull completes, so that
+	// the resources referencing the image are translated again.
+	Pulled() <-chan struct{}
+	// Pending returns whether modules are being pulled.
+	Pending() bool
+}
+
+// GetOptions are the options to get a wasm module.
+type GetOptions struct {
+	// PullSecret is the docker config holding the credentials of the registry,
+	// i.e. the ".dockerconfigjson" key of a "kubernetes.io/dockerconfigjson" Secret.
+	PullSecret []byte
+	// SHA256 is the expected hex encoded SHA256 checksum of the wasm module.
+	// It is not verified if empty.
+	SHA256 string
+}
+
+// Module is a wasm module served to the Envoy proxies.
+type Module struct {
+	// SHA256 is the hex encoded SHA256 checksum of the wasm module.
+	SHA256 string
+}
+
+// CacheOptions are the options of the LocalCache.
+type CacheOptions struct {
+	// Dir is the directory the wasm modules are cached in.
+	Dir string
+	// ServingURL is the base URL the Envoy proxies fetch the cached modules from.
+	ServingURL string
+	// URLKey is the key the URLs of the modules are signed with, so that the
+	// modules are only served to the scopes they are returned for. It must be
+	// shared by the replicas serving the modules at ServingURL. A random key
+	// is generated if it is empty.
+	URLKey []byte
+	// PullTimeout is the timeout to pull a wasm module.
+	PullTimeout time.Duration
+	// MaxIdle is the duration after which a module that has not been used is
+	// evicted from the cache.
+	MaxIdle time.Duration
+}
+
+// LocalCache is a Cache storing the wasm modules on disk. It serves them over
+// HTTP, so that the Envoy proxies do not need the registry credentials.
+type LocalCache struct {
+	opts   CacheOptions
+	client *http.Client
+	logger logging.Logger
+
+	mu      sync.Mutex
+	entries map[cacheKey]*entry
+	pulled  chan struct{}
+}
+
+var _ Cache = (*LocalCache)(nil)
+
+// cacheKey identifies a pulled image. The pull secret is part of the key so
+// that an image is never served to a resource which is not allowed to pull it.
+type cacheKey struct {
+	image string
+	// secret is the checksum of the pull secret.
+	secret string
+}
+
+type entry struct {
+	// checksum is the checksum of the wasm module, if it was pulled.
+	checksum string
+	// err is the error of the last pull, if it failed.
+	err error
+	// pulled is the time of the last pull.
+	pulled time.Time
+	// pulling is true while the module is being pulled.
+	pulling bool
+	// lastUsed is the last time the module was returned by Get.
+	lastUsed time.Time
+}
+
+// NewLocalCache returns a LocalCache with the provided options.
+func NewLocalCache(opts CacheOptions, logger logging.Logger) *LocalCache {
+	if opts.Dir == "" {
+		opts.Dir = DefaultCacheDir
+	}
+	if opts.PullTimeout == 0 {
+		opts.PullTimeout = defaultPullTimeout
+	}
+	if opts.MaxIdle == 0 {
+		opts.MaxIdle = defaultMaxIdle
+	}
+	if len(opts.URLKey) == 0 {
+		opts.URLKey = make([]byte, sha256.Size)
+		_, _ = rand.Read(opts.URLKey)
+	}
+
+	return &LocalCache{
+		opts:    opts,
+		client:  http.DefaultClient,
+		logger:  logger,
+		entries: map[cacheKey]*entry{},
+		pulled:  make(chan struct{}, 1),
+	}
+}
+
+// Start creates the cache directory, and evicts the modules that are no
+// longer used until ctx is done.
+func (c *LocalCache) Start(ctx context.Context) error {
+	if err := os.MkdirAll(c.opts.Dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create wasm cache directory %s: %w", c.opts.Dir, err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(c.opts.MaxIdle / 24)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.evict(time.Now())
+			}
+		}
+	}()
+	return nil
+}
+
+// Get implements Cache. The modules are pulled in the background, so that the
+// translation isn't blocked by the registries, and the module pulled previously
+// is served while it is pulled again.
+func (c *LocalCache) Get(image string, opts GetOptions) (*Module, error) {
+	ref, err := parseImageRef(image)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	key := cacheKey{image: ref.String(), secret: sha256Hex(opts.PullSecret)}
+	e, ok := c.entries[key]
+	if !ok {
+		e = &entry{}
+		c.entries[key] = e
+	}
+	e.lastUsed = now
+
+	cached := e.checksum != "" && c.exists(e.checksum)
+	if !e.pulling && ((e.err != nil && now.Sub(e.pulled) >= errorRetryInterval) || (e.err == nil && !cached) ||
+		(ref.tag == defaultTag && now.Sub(e.pulled) > latestRefreshInterval)) {
+		e.pulling = true
+		go c.pullInBackground(key, ref, opts.PullSecret)
+	}
+
+	switch {
+	case cached:
+	case e.err != nil:
+		// The last error is returned until the pull is retried successfully.
+		return nil, e.err
+	default:
+		return nil, ErrPullPending
+	}
+
+	if opts.SHA256 != "" && !strings.EqualFold(opts.SHA256, e.checksum) {
+		return nil, fmt.Errorf("checksum mismatch for image %s: expected %s, got %s", image, opts.SHA256, e.checksum)
+	}
+
+	return &Module{SHA256: e.checksum}, nil
+}
+
+// URL implements Cache. The modules are served at
+// "/<scope>/<signature>/<sha256>.wasm", where the signature is the HMAC of the
+// scope and of the checksum, so that the URL of a scope cannot be derived
+// from the URL of another one.
+func (c *LocalCache) URL(checksum, scope string) string {
+	return fmt.Sprintf("%s/%s/%s/%s%s", strings.TrimSuffix(c.opts.ServingURL, "/"),
+		url.PathEscape(scope), c.sign(checksum, scope), checksum, moduleExt)
+}
+
+func (c *LocalCache) sign(checksum, scope string) string {
+	mac := hmac.New(sha256.New, c.opts.URLKey)
+	mac.Write([]byte(scope + "/" + checksum))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Pulled implements Cache.
+func (c *LocalCache) Pulled() <-chan struct{} {
+	return c.pulled
+}
+
+// Pending implements Cache.
+func (c *LocalCache) Pending() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.entries {
+		if e.pulling {
+			return true
+		}
+	}
+	return false
+}
+
+// pullInBackground pulls the wasm module of the image without holding the lock
+// of the cache, and notifies the completion of the pull.
+func (c *LocalCache) pullInBackground(key cacheKey, ref *imageRef, pullSecret []byte) {
+	pulled := c.pull(ref, pullSecret)
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if !ok {
+		e = &entry{lastUsed: pulled.pulled}
+		c.entries[key] = e
+	}
+	switch {
+	case pulled.err == nil:
+		e.checksum, e.err = pulled.checksum, nil
+	case e.err == nil && e.checksum != "" && c.exists(e.checksum):
+		// Keep serving the module pulled previously.
+		c.logger.Error(pulled.err, "failed to refresh wasm module", "image", ref.String())
+	default:
+		e.checksum, e.err = "", pulled.err
+	}
+	e.pulled = pulled.pulled
+	e.pulling = false
+	c.mu.Unlock()
+
+	// The completion is notified once to the pending receiver.
+	select {
+	case c.pulled <- struct{}{}:
+	default:
+	}
+}
+
+// pull pulls the wasm module of the image, and writes it into the cache directory.
+func (c *LocalCache) pull(ref *imageRef, pullSecret []byte) *entry {
+	e := &entry{pulled: time.Now()}
+
+	creds, err := credentialsForRegistry(pullSecret, ref.registry)
+	if err != nil {
+		e.err = fmt.Errorf("invalid pull secret for image %s: %w", ref, err)
+		return e
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.opts.PullTimeout)
+	defer cancel()
+	client := &registryClient{client: c.client, ref: ref, creds: creds}
+	module, err := client.pull(ctx)
+	if err != nil {
+		e.err = err
+		return e
+	}
+
+	e.checksum = sha256Hex(module)
+	if err := c.write(e.checksum, module); err != nil {
+		e.err = err
+		return e
+	}
+
+	c.logger.Info("pulled wasm module", "image", ref.String(), "sha256", e.checksum)
+	return e
+}
+
+// write writes the module into the cache directory. The file is renamed into
+// place, so that a partially written module is never served.
+func (c *LocalCache) write(checksum string, module []byte) error {
+	path := c.path(checksum)
+	if c.exists(checksum) {
+		// Refresh the modification time, so that the file is not evicted.
+		now := time.Now()
+		return os.Chtimes(path, now, now)
+	}
+
+	tmp, err := os.CreateTemp(c.opts.Dir, checksum+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write wasm module: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(module); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write wasm module: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write wasm module: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write wasm module: %w", err)
+	}
+	return nil
+}
+
+// evict removes the entries that have not been used for MaxIdle, and the
+// module files that are no longer referenced by any entry.
+func (c *LocalCache) evict(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	used := map[string]bool{}
+	for key, e := range c.entries {
+		if now.Sub(e.lastUsed) > c.opts.MaxIdle && now.Sub(e.pulled) > c.opts.MaxIdle {
+			delete(c.entries, key)
+			continue
+		}
+		used[e.checksum] = true
+	}
+
+	files, err := os.ReadDir(c.opts.Dir)
+	if err != nil {
+		c.logger.Error(err, "failed to list wasm cache directory")
+		return
+	}
+	for _, f := range files {
+		checksum, ok := strings.CutSuffix(f.Name(), moduleExt)
+		if !ok || used[checksum] {
+			continue
+		}
+		// Modules cached by a previous run are kept until they become idle.
+		if info, err := f.Info(); err != nil || now.Sub(info.ModTime()) <= c.opts.MaxIdle {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.opts.Dir, f.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			c.logger.Error(err, "failed to evict wasm module", "sha256", checksum)
+			continue
+		}
+		c.logger.Info("evicted wasm module", "sha256", checksum)
+	}
+}
+
+// ServeHTTP implements http.Handler. It serves the cached modules at the URLs
+// returned by URL. The modules requested with an invalid signature are not
+// found, so that their existence is not disclosed either.
+func (c *LocalCache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.EscapedPath(), "/"), "/")
+	if len(parts) != 3 {
+		http.NotFound(w, r)
+		return
+	}
+	scope, err := url.PathUnescape(parts[0])
+	checksum, ok := strings.CutSuffix(parts[2], moduleExt)
+	if err != nil || !ok || !isSHA256(checksum) ||
+		!hmac.Equal([]byte(parts[1]), []byte(c.sign(checksum, scope))) {
+		http.NotFound(w, r)
+		return
+	}
+
+	f, err := os.Open(c.path(checksum))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/wasm")
+	http.ServeContent(w, r, checksum+moduleExt, info.ModTime(), f)
+}
+
+func (c *LocalCache) path(checksum string) string {
+	return filepath.Join(c.opts.Dir, checksum+moduleExt)
+}
+
+func (c *LocalCache) exists(checksum string) bool {
+	_, err := os.Stat(c.path(checksum))
+	return err == nil
+}
@@ -0,0 +1,407 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package wasm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/logging"
+)
+
+var testModule = append([]byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}, []byte("plugin")...)
+
+// fakeRegistry is a registry serving wasm images, which requires a bearer
+// token issued for the "user:pass" credentials.
+type fakeRegistry struct {
+	*httptest.Server
+	manifests map[string]ocispec.Descriptor
+	blobs     map[string][]byte
+	pulls     int
+	// blocked blocks the requests until it's closed, if set.
+	blocked chan struct{}
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	r := &fakeRegistry{manifests: map[string]ocispec.Descriptor{}, blobs: map[string][]byte{}}
+	r.Server = httptest.NewTLSServer(http.HandlerFunc(r.serveHTTP))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *fakeRegistry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if r.blocked != nil {
+		<-r.blocked
+	}
+	if req.URL.Path == "/token" {
+		if user, pass, ok := req.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if req.URL.Query().Get("scope") != "repository:org/plugin:pull" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"secret-token"}`))
+		return
+	}
+
+	if req.Header.Get("Authorization") != "Bearer secret-token" {
+		w.Header().Set("WWW-Authenticate",
+			fmt.Sprintf(`Bearer realm="%s/token",service="fake-registry"`, r.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if ref, ok := strings.CutPrefix(req.URL.Path, "/v2/org/plugin/manifests/"); ok {
+		if m, ok := r.manifests[ref]; ok {
+			r.pulls++
+			w.Header().Set("Content-Type", m.MediaType)
+			w.Header().Set("Docker-Content-Digest", m.Digest.String())
+			_, _ = w.Write(r.blobs[m.Digest.String()])
+			return
+		}
+	}
+	if digest, ok := strings.CutPrefix(req.URL.Path, "/v2/org/plugin/blobs/"); ok {
+		if b, ok := r.blobs[digest]; ok {
+			_, _ = w.Write(b)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+// push adds an image with a single layer to the registry, and returns the
+// digest of its manifest.
+func (r *fakeRegistry) push(t *testing.T, tag, mediaType string, layer []byte) string {
+	return r.pushImage(t, tag, mediaType, layer).Digest.String()
+}
+
+// pushImage adds an image with a single layer to the registry, and returns
+// the descriptor of its manifest.
+func (r *fakeRegistry) pushImage(t *testing.T, tag, mediaType string, layer []byte) ocispec.Descriptor {
+	return r.pushManifest(t, tag, ocispec.MediaTypeImageManifest, ocispec.Manifest{
+		MediaType: ocispec.MediaTypeImageManifest,
+		Layers:    []ocispec.Descriptor{r.pushBlob(mediaType, layer)},
+	})
+}
+
+// pushIndex adds a multi-arch image of the manifests to the registry.
+func (r *fakeRegistry) pushIndex(t *testing.T, tag string, manifests ...ocispec.Descriptor) {
+	r.pushManifest(t, tag, ocispec.MediaTypeImageIndex, ocispec.Index{
+		MediaType: ocispec.MediaTypeImageIndex,
+		Manifests: manifests,
+	})
+}
+
+func (r *fakeRegistry) pushBlob(mediaType string, blob []byte) ocispec.Descriptor {
+	desc := ocispec.Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.FromBytes(blob),
+		Size:      int64(len(blob)),
+	}
+	r.blobs[desc.Digest.String()] = blob
+	return desc
+}
+
+func (r *fakeRegistry) pushManifest(t *testing.T, tag, mediaType string, manifest any) ocispec.Descriptor {
+	m, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	desc := r.pushBlob(mediaType, m)
+	if tag != "" {
+		r.manifests[tag] = desc
+	}
+	r.manifests[desc.Digest.String()] = desc
+	return desc
+}
+
+func (r *fakeRegistry) image(ref string) string {
+	return "oci://" + r.Listener.Addr().String() + "/org/plugin" + ref
+}
+
+func newTestCache(t *testing.T, r *fakeRegistry) *LocalCache {
+	c := NewLocalCache(CacheOptions{
+		Dir:        t.TempDir(),
+		ServingURL: "https://envoy-gateway:18002",
+	}, logging.DefaultLogger(v1alpha1.LogLevelInfo))
+	c.client = r.Client()
+	return c
+}
+
+// get returns the module of the image once it's pulled.
+func get(t *testing.T, c *LocalCache, image string, opts GetOptions) (*Module, error) {
+	for {
+		module, err := c.Get(image, opts)
+		if !errors.Is(err, ErrPullPending) {
+			return module, err
+		}
+		select {
+		case <-c.Pulled():
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out pulling %s", image)
+		}
+	}
+}
+
+func pullSecret(registry, user, pass string) []byte {
+	auth := base64.StdEncoding.EncodeToString([]byte(user + ":" + pass))
+	return []byte(fmt.Sprintf(`{"auths":{%q:{"auth":%q}}}`, registry, auth))
+}
+
+func compatLayer(t *testing.T, module []byte) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "plugin.wasm", Mode: 0o600, Size: int64(len(module))}))
+	_, err := tw.Write(module)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestLocalCacheGet(t *testing.T) {
+	registry := newFakeRegistry(t)
+	digest := registry.push(t, "v1", mediaTypeWasmLayer, testModule)
+	registry.push(t, "compat", mediaTypeOCILayer, compatLayer(t, testModule))
+	registry.push(t, "invalid", mediaTypeWasmLayer, []byte("not wasm"))
+	wasmImage := registry.pushImage(t, "", mediaTypeWasmLayer, testModule)
+	wasmImage.Platform = &ocispec.Platform{OS: "wasip1", Architecture: platformWasm}
+	linuxImage := registry.pushImage(t, "", mediaTypeWasmLayer, []byte("not wasm"))
+	linuxImage.Platform = &ocispec.Platform{OS: "linux", Architecture: "riscv"}
+	registry.pushIndex(t, "multi-arch", linuxImage, wasmImage)
+	checksum := sha256Hex(testModule)
+	secret := pullSecret(registry.Listener.Addr().String(), "user", "pass")
+
+	testCases := []struct {
+		name    string
+		image   string
+		opts    GetOptions
+		wantErr string
+	}{
+		{
+			name:  "wasm layer",
+			image: registry.image(":v1"),
+			opts:  GetOptions{PullSecret: secret},
+		},
+		{
+			name:  "compat layer",
+			image: registry.image(":compat"),
+			opts:  GetOptions{PullSecret: secret},
+		},
+		{
+			name:  "digest with matching checksum",
+			image: registry.image("@" + digest),
+			opts:  GetOptions{PullSecret: secret, SHA256: checksum},
+		},
+		{
+			name:  "image index",
+			image: registry.image(":multi-arch"),
+			opts:  GetOptions{PullSecret: secret},
+		},
+		{
+			name:    "checksum mismatch",
+			image:   registry.image(":v1"),
+			opts:    GetOptions{PullSecret: secret, SHA256: strings.Repeat("0", 64)},
+			wantErr: "checksum mismatch",
+		},
+		{
+			name:    "missing credentials",
+			image:   registry.image(":v1"),
+			wantErr: "response status code 401",
+		},
+		{
+			name:    "invalid credentials",
+			image:   registry.image(":v1"),
+			opts:    GetOptions{PullSecret: pullSecret(registry.Listener.Addr().String(), "user", "wrong")},
+			wantErr: "response status code 401",
+		},
+		{
+			name:    "not found",
+			image:   registry.image(":v2"),
+			opts:    GetOptions{PullSecret: secret},
+			wantErr: "not found",
+		},
+		{
+			name:    "not a wasm module",
+			image:   registry.image(":invalid"),
+			opts:    GetOptions{PullSecret: secret},
+			wantErr: "not a wasm binary module",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestCache(t, registry)
+			module, err := get(t, c, tc.image, tc.opts)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &Module{SHA256: checksum}, module)
+
+			cached, err := os.ReadFile(filepath.Join(c.opts.Dir, checksum+".wasm"))
+			require.NoError(t, err)
+			require.Equal(t, testModule, cached)
+		})
+	}
+}
+
+func TestLocalCacheReusesPulledModules(t *testing.T) {
+	registry := newFakeRegistry(t)
+	registry.push(t, "v1", mediaTypeWasmLayer, testModule)
+	secret := pullSecret(registry.Listener.Addr().String(), "user", "pass")
+	c := newTestCache(t, registry)
+
+	_, err := get(t, c, registry.image(":v1"), GetOptions{PullSecret: secret})
+	require.NoError(t, err)
+	_, err = c.Get(registry.image(":v1"), GetOptions{PullSecret: secret})
+	require.NoError(t, err)
+	require.Equal(t, 1, registry.pulls)
+
+	// The image is pulled again with a different pull secret, so that the
+	// module is never served without the pull being authorized.
+	_, err = get(t, c, registry.image(":v1"), GetOptions{})
+	require.Error(t, err)
+
+	// Modules which are no longer used are evicted.
+	c.evict(time.Now().Add(2 * defaultMaxIdle))
+	require.Empty(t, c.entries)
+	files, err := os.ReadDir(c.opts.Dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestLocalCacheGetWhilePulling(t *testing.T) {
+	registry := newFakeRegistry(t)
+	registry.push(t, "v1", mediaTypeWasmLayer, testModule)
+	registry.blocked = make(chan struct{})
+	secret := pullSecret(registry.Listener.Addr().String(), "user", "pass")
+	c := newTestCache(t, registry)
+
+	// The pull doesn't block the callers, nor the other images.
+	_, err := c.Get(registry.image(":v1"), GetOptions{PullSecret: secret})
+	require.ErrorIs(t, err, ErrPullPending)
+	_, err = c.Get(registry.image(":v1"), GetOptions{PullSecret: secret})
+	require.ErrorIs(t, err, ErrPullPending)
+	_, err = c.Get("oci://", GetOptions{})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrPullPending)
+	require.True(t, c.Pending())
+
+	close(registry.blocked)
+	select {
+	case <-c.Pulled():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out pulling the image")
+	}
+	require.False(t, c.Pending())
+	module, err := c.Get(registry.image(":v1"), GetOptions{PullSecret: secret})
+	require.NoError(t, err)
+	require.Equal(t, sha256Hex(testModule), module.SHA256)
+	require.Equal(t, 1, registry.pulls)
+}
+
+func TestLocalCacheURL(t *testing.T) {
+	c := NewLocalCache(CacheOptions{
+		ServingURL: "https://envoy-gateway:18002",
+		URLKey:     []byte("key"),
+	}, logging.DefaultLogger(v1alpha1.LogLevelInfo))
+	checksum := sha256Hex(testModule)
+
+	u := c.URL(checksum, "envoy-gateway/gateway-1")
+	require.Equal(t, "https://envoy-gateway:18002/envoy-gateway%2Fgateway-1/"+
+		c.sign(checksum, "envoy-gateway/gateway-1")+"/"+checksum+".wasm", u)
+	require.NotEqual(t, u, c.URL(checksum, "envoy-gateway/gateway-2"))
+
+	// The replicas sharing the key return the same URLs.
+	other := NewLocalCache(CacheOptions{
+		ServingURL: "https://envoy-gateway:18002",
+		URLKey:     []byte("key"),
+	}, logging.DefaultLogger(v1alpha1.LogLevelInfo))
+	require.Equal(t, u, other.URL(checksum, "envoy-gateway/gateway-1"))
+}
+
+func TestLocalCacheServeHTTP(t *testing.T) {
+	c := NewLocalCache(CacheOptions{Dir: t.TempDir()}, logging.DefaultLogger(v1alpha1.LogLevelInfo))
+	checksum := sha256Hex(testModule)
+	require.NoError(t, c.write(checksum, testModule))
+	unknown := strings.Repeat("0", 64)
+
+	testCases := []struct {
+		name     string
+		method   string
+		path     string
+		wantCode int
+	}{
+		{
+			name:     "cached module",
+			method:   http.MethodGet,
+			path:     c.URL(checksum, "envoy-gateway/gateway-1"),
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "unknown module",
+			method:   http.MethodGet,
+			path:     c.URL(unknown, "envoy-gateway/gateway-1"),
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "unsigned module",
+			method:   http.MethodGet,
+			path:     "/" + checksum + ".wasm",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:   "signature of another scope",
+			method: http.MethodGet,
+			path: "/envoy-gateway%2Fgateway-2/" + c.sign(checksum, "envoy-gateway/gateway-1") +
+				"/" + checksum + ".wasm",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "invalid path",
+			method:   http.MethodGet,
+			path:     "/../" + checksum + ".wasm",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "invalid method",
+			method:   http.MethodPost,
+			path:     c.URL(checksum, "envoy-gateway/gateway-1"),
+			wantCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, nil))
+			require.Equal(t, tc.wantCode, rec.Code)
+			if tc.wantCode == http.StatusOK {
+				require.Equal(t, testModule, rec.Body.Bytes())
+			}
+		})
+	}
+}
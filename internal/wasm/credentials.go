@@ -0,0 +1,80 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package wasm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// credentials are the credentials used to authenticate to a registry.
+type credentials struct {
+	username string
+	password string
+}
+
+// dockerConfig is the content of a "kubernetes.io/dockerconfigjson" Secret.
+type dockerConfig struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+type dockerConfigAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// credentialsForRegistry returns the credentials for the registry from the
+// docker config, or nil if the docker config has no entry for the registry.
+func credentialsForRegistry(config []byte, registry string) (*credentials, error) {
+	if len(config) == 0 {
+		return nil, nil
+	}
+
+	var cfg dockerConfig
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid docker config: %w", err)
+	}
+
+	for server, auth := range cfg.Auths {
+		if normalizeRegistry(server) != normalizeRegistry(registry) {
+			continue
+		}
+
+		if auth.Auth == "" {
+			return &credentials{username: auth.Username, password: auth.Password}, nil
+		}
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("invalid auth for registry %s: %w", server, err)
+		}
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid auth for registry %s: missing password", server)
+		}
+		return &credentials{username: username, password: password}, nil
+	}
+
+	return nil, nil
+}
+
+// normalizeRegistry returns the host of a registry, as found in the docker
+// config entries, e.g. "https://index.docker.io/v1/" returns "index.docker.io".
+func normalizeRegistry(registry string) string {
+	if u, err := url.Parse(registry); err == nil && u.Host != "" {
+		registry = u.Host
+	}
+	registry, _, _ = strings.Cut(registry, "/")
+
+	switch registry {
+	case "docker.io", "registry-1.docker.io":
+		return dockerHubRegistry
+	}
+	return registry
+}
@@ -0,0 +1,97 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package wasm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// ociScheme is the optional scheme prefix of the OCI image references.
+	ociScheme = "oci://"
+	// dockerHubRegistry is the registry of the image references without an
+	// explicit registry host.
+	dockerHubRegistry = "index.docker.io"
+	// defaultTag is the tag of the image references without a tag or a digest.
+	defaultTag = "latest"
+)
+
+// imageRef is a parsed OCI image reference, e.g.
+// "oci://ghcr.io/org/plugin:v1.0.0" or "ghcr.io/org/plugin@sha256:<digest>".
+type imageRef struct {
+	// registry is the host, and optionally the port, of the registry.
+	registry string
+	// repository is the path of the repository within the registry.
+	repository string
+	// tag is the tag of the image. It is empty if digest is set.
+	tag string
+	// digest is the digest of the image manifest, e.g. "sha256:<hex>".
+	digest string
+}
+
+// parseImageRef parses an OCI image reference.
+func parseImageRef(image string) (*imageRef, error) {
+	ref := strings.TrimPrefix(image, ociScheme)
+	if ref == "" {
+		return nil, errors.New("empty image reference")
+	}
+	if strings.Contains(ref, "://") {
+		return nil, fmt.Errorf("invalid image reference %q: unsupported scheme", image)
+	}
+
+	r := &imageRef{}
+	if i := strings.Index(ref, "@"); i >= 0 {
+		r.digest = ref[i+1:]
+		ref = ref[:i]
+		if !strings.HasPrefix(r.digest, "sha256:") || !isSHA256(strings.TrimPrefix(r.digest, "sha256:")) {
+			return nil, fmt.Errorf("invalid image reference %q: unsupported digest %q", image, r.digest)
+		}
+	}
+	// The tag is after the last colon, unless the colon is part of the registry host.
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		if r.digest == "" {
+			r.tag = ref[i+1:]
+		}
+		ref = ref[:i]
+	}
+	if r.tag == "" && r.digest == "" {
+		r.tag = defaultTag
+	}
+
+	// The first component is the registry if it looks like a host.
+	r.registry, r.repository = dockerHubRegistry, ref
+	if i := strings.Index(ref, "/"); i >= 0 {
+		if host := ref[:i]; strings.ContainsAny(host, ".:") || host == "localhost" {
+			r.registry, r.repository = host, ref[i+1:]
+		}
+	}
+	if r.registry == dockerHubRegistry && !strings.Contains(r.repository, "/") {
+		r.repository = "library/" + r.repository
+	}
+	if r.repository == "" || strings.HasPrefix(r.repository, "/") || strings.HasSuffix(r.repository, "/") {
+		return nil, fmt.Errorf("invalid image reference %q: invalid repository", image)
+	}
+
+	return r, nil
+}
+
+// reference returns the tag or the digest of the image, as used in the
+// manifest URL.
+func (r *imageRef) reference() string {
+	if r.digest != "" {
+		return r.digest
+	}
+	return r.tag
+}
+
+func (r *imageRef) String() string {
+	if r.digest != "" {
+		return r.registry + "/" + r.repository + "@" + r.digest
+	}
+	return r.registry + "/" + r.repository + ":" + r.tag
+}
@@ -0,0 +1,93 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package wasm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImageRef(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+
+	testCases := []struct {
+		image   string
+		want    *imageRef
+		wantErr bool
+	}{
+		{
+			image: "oci://ghcr.io/org/plugin:v1.0.0",
+			want:  &imageRef{registry: "ghcr.io", repository: "org/plugin", tag: "v1.0.0"},
+		},
+		{
+			image: "ghcr.io/org/plugin",
+			want:  &imageRef{registry: "ghcr.io", repository: "org/plugin", tag: "latest"},
+		},
+		{
+			image: "localhost:5000/plugin@" + digest,
+			want:  &imageRef{registry: "localhost:5000", repository: "plugin", digest: digest},
+		},
+		{
+			image: "registry.example.com/org/plugin:v1@" + digest,
+			want:  &imageRef{registry: "registry.example.com", repository: "org/plugin", digest: digest},
+		},
+		{
+			image: "plugin:v1",
+			want:  &imageRef{registry: "index.docker.io", repository: "library/plugin", tag: "v1"},
+		},
+		{
+			image: "org/plugin",
+			want:  &imageRef{registry: "index.docker.io", repository: "org/plugin", tag: "latest"},
+		},
+		{
+			image:   "",
+			wantErr: true,
+		},
+		{
+			image:   "https://ghcr.io/org/plugin",
+			wantErr: true,
+		},
+		{
+			image:   "ghcr.io/org/plugin@sha256:invalid",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.image, func(t *testing.T) {
+			got, err := parseImageRef(tc.image)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCredentialsForRegistry(t *testing.T) {
+	config := []byte(`{"auths":{
+		"https://index.docker.io/v1/":{"auth":"dXNlcjpwYXNz"},
+		"ghcr.io":{"username":"ghcr-user","password":"ghcr-pass"}}}`)
+
+	creds, err := credentialsForRegistry(config, "index.docker.io")
+	require.NoError(t, err)
+	require.Equal(t, &credentials{username: "user", password: "pass"}, creds)
+
+	creds, err = credentialsForRegistry(config, "ghcr.io")
+	require.NoError(t, err)
+	require.Equal(t, &credentials{username: "ghcr-user", password: "ghcr-pass"}, creds)
+
+	creds, err = credentialsForRegistry(config, "quay.io")
+	require.NoError(t, err)
+	require.Nil(t, creds)
+
+	_, err = credentialsForRegistry([]byte("invalid"), "ghcr.io")
+	require.Error(t, err)
+}
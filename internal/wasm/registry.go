@@ -0,0 +1,247 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package wasm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"runtime"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+const (
+	// mediaTypeWasmLayer is the media type of the layer holding the wasm module
+	// in the OCI images following the Wasm artifact image specification.
+	mediaTypeWasmLayer = "application/vnd.module.wasm.content.layer.v1+wasm"
+	// mediaTypeOCILayer and mediaTypeDockerLayer are the media types of the
+	// layers of the compat images, holding the wasm module in a tarball.
+	mediaTypeOCILayer    = "application/vnd.oci.image.layer.v1.tar+gzip"
+	mediaTypeDockerLayer = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+
+	// mediaTypeDockerManifestList is the media type of the Docker multi-arch
+	// images, the Docker equivalent of the OCI image index.
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// platformWasm is the architecture of the wasm images of an image index.
+	platformWasm = "wasm"
+
+	// dockerHubAPIHost is the host serving the registry API of Docker Hub.
+	dockerHubAPIHost = "registry-1.docker.io"
+
+	// maxManifestSize is the maximum size of an image manifest or index.
+	maxManifestSize = 4 << 20
+	// maxModuleSize is the maximum size of a wasm module, or of the layer holding it.
+	maxModuleSize = 256 << 20
+)
+
+// wasmMagic is the magic number every wasm binary module starts with.
+var wasmMagic = []byte{0x00, 'a', 's', 'm'}
+
+// registryClient pulls wasm modules from an OCI registry.
+type registryClient struct {
+	client *http.Client
+	ref    *imageRef
+	creds  *credentials
+}
+
+// pull returns the wasm module of the image. If the image is multi-arch, the
+// module is pulled from the image selected by selectManifest. The digests of
+// the manifests and of the layer are verified.
+func (c *registryClient) pull(ctx context.Context) ([]byte, error) {
+	repo, err := c.repository()
+	if err != nil {
+		return nil, fmt.Errorf("invalid image %s: %w", c.ref, err)
+	}
+
+	desc, body, err := oras.FetchBytes(ctx, repo, c.ref.reference(),
+		oras.FetchBytesOptions{MaxBytes: maxManifestSize})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the manifest of %s: %w", c.ref, err)
+	}
+	if desc.MediaType == ocispec.MediaTypeImageIndex || desc.MediaType == mediaTypeDockerManifestList {
+		var index ocispec.Index
+		if err := json.Unmarshal(body, &index); err != nil {
+			return nil, fmt.Errorf("invalid image index of %s: %w", c.ref, err)
+		}
+		if desc, err = selectManifest(index.Manifests); err != nil {
+			return nil, fmt.Errorf("invalid image %s: %w", c.ref, err)
+		}
+		if desc.Size > maxManifestSize {
+			return nil, fmt.Errorf("invalid image %s: manifest exceeds the maximum size of %d bytes", c.ref, maxManifestSize)
+		}
+		if body, err = content.FetchAll(ctx, repo, desc); err != nil {
+			return nil, fmt.Errorf("failed to fetch the manifest %s of %s: %w", desc.Digest, c.ref, err)
+		}
+	}
+
+	var m ocispec.Manifest
+	if err := json.Unmarshal(body, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest of %s: %w", c.ref, err)
+	}
+	layer, err := wasmLayer(m.Layers)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image %s: %w", c.ref, err)
+	}
+	if layer.Size > maxModuleSize {
+		return nil, fmt.Errorf("invalid image %s: layer exceeds the maximum size of %d bytes", c.ref, maxModuleSize)
+	}
+
+	blob, err := content.FetchAll(ctx, repo.Blobs(), layer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the layer %s of %s: %w", layer.Digest, c.ref, err)
+	}
+
+	module := blob
+	if layer.MediaType != mediaTypeWasmLayer {
+		if module, err = extractModule(blob); err != nil {
+			return nil, fmt.Errorf("invalid layer of %s: %w", c.ref, err)
+		}
+	}
+	if !bytes.HasPrefix(module, wasmMagic) {
+		return nil, fmt.Errorf("invalid image %s: not a wasm binary module", c.ref)
+	}
+
+	return module, nil
+}
+
+// repository returns the remote repository of the image, authenticating to
+// the registry with the credentials, if any.
+func (c *registryClient) repository() (*remote.Repository, error) {
+	host := c.ref.registry
+	if host == dockerHubRegistry {
+		host = dockerHubAPIHost
+	}
+	repo, err := remote.NewRepository(host + "/" + c.ref.repository)
+	if err != nil {
+		return nil, err
+	}
+	repo.MaxMetadataBytes = maxManifestSize
+
+	client := &auth.Client{
+		Client: c.client,
+		Cache:  auth.NewCache(),
+	}
+	if c.creds != nil {
+		client.Credential = auth.StaticCredential(host, auth.Credential{
+			Username: c.creds.username,
+			Password: c.creds.password,
+		})
+	}
+	repo.Client = client
+	return repo, nil
+}
+
+// selectManifest returns the manifest of an image index holding the wasm
+// module: the manifest of the wasm platform, else the manifest of the platform
+// Envoy Gateway runs on, else the only manifest of the index.
+func selectManifest(manifests []ocispec.Descriptor) (ocispec.Descriptor, error) {
+	for _, m := range manifests {
+		if m.Platform != nil && m.Platform.Architecture == platformWasm {
+			return m, nil
+		}
+	}
+	for _, m := range manifests {
+		if m.Platform != nil && m.Platform.OS == "linux" && m.Platform.Architecture == runtime.GOARCH {
+			return m, nil
+		}
+	}
+	if len(manifests) == 1 {
+		return manifests[0], nil
+	}
+	return ocispec.Descriptor{}, errors.New("no manifest found for the wasm platform")
+}
+
+// wasmLayer returns the layer of the manifest holding the wasm module: the
+// wasm layer of the Wasm artifact images, or the single layer of the compat
+// images.
+func wasmLayer(layers []ocispec.Descriptor) (ocispec.Descriptor, error) {
+	for _, l := range layers {
+		if l.MediaType == mediaTypeWasmLayer {
+			return l, nil
+		}
+	}
+	if len(layers) == 1 &&
+		(layers[0].MediaType == mediaTypeOCILayer || layers[0].MediaType == mediaTypeDockerLayer) {
+		return layers[0], nil
+	}
+	return ocispec.Descriptor{}, errors.New("no wasm layer found")
+}
+
+// extractModule returns the wasm module from the gzipped tarball of a compat
+// image layer. The module is the "plugin.wasm" file, or the only ".wasm" file.
+func extractModule(layer []byte) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(layer))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	var modules [][]byte
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg || path.Ext(hdr.Name) != ".wasm" {
+			continue
+		}
+		module, err := readAll(tr, maxModuleSize)
+		if err != nil {
+			return nil, err
+		}
+		if path.Base(hdr.Name) == "plugin.wasm" {
+			return module, nil
+		}
+		modules = append(modules, module)
+	}
+
+	if len(modules) != 1 {
+		return nil, fmt.Errorf("expected a single wasm file in the layer, found %d", len(modules))
+	}
+	return modules[0], nil
+}
+
+// readAll reads at most maxSize bytes, and fails if there are more.
+func readAll(r io.Reader, maxSize int64) ([]byte, error) {
+	b, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) > maxSize {
+		return nil, fmt.Errorf("content exceeds the maximum size of %d bytes", maxSize)
+	}
+	return b, nil
+}
+
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// isSHA256 returns true if the checksum is a hex encoded SHA256 checksum.
+func isSHA256(checksum string) bool {
+	b, err := hex.DecodeString(checksum)
+	return err == nil && len(b) == sha256.Size
+}
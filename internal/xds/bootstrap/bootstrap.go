@@ -33,10 +33,20 @@ const (
 
 	// DefaultXdsServerPort is the default listening port of the xds-server.
 	DefaultXdsServerPort = 18000
+	// DefaultWasmServerPort is the default listening port of the wasm HTTP server,
+	// which serves the wasm modules pulled from OCI images by Envoy Gateway.
+	DefaultWasmServerPort = 18002
 
 	envoyReadinessAddress = "0.0.0.0"
 	EnvoyReadinessPort    = 19001
 	EnvoyReadinessPath    = "/ready"
+
+	// defaultSdsTrustedCAPath is the default path of the SDS file holding the
+	// CA trusted to validate the xDS server certificate.
+	defaultSdsTrustedCAPath = "/sds/xds-trusted-ca.json"
+	// defaultSdsCertificatePath is the default path of the SDS file holding the
+	// client certificate presented to the xDS server.
+	defaultSdsCertificatePath = "/sds/xds-certificate.json"
 )
 
 //go:embed bootstrap.yaml.tpl
@@ -56,6 +66,9 @@ type bootstrapConfig struct {
 type bootstrapParameters struct {
 	// XdsServer defines the configuration of the XDS server.
 	XdsServer xdsServerParameters
+	// WasmServer defines the configuration of the Wasm HTTP server, if the
+	// proxies fetch wasm modules from it.
+	WasmServer *wasmServerParameters
 	// AdminServer defines the configuration of the Envoy admin interface.
 	AdminServer adminServerParameters
 	// ReadyServer defines the configuration for health check ready listener
@@ -71,6 +84,10 @@ type bootstrapParameters struct {
 	StatsMatcher *StatsMatcherParameters
 	// OverloadManager defines the configuration of the Envoy overload manager.
 	OverloadManager overloadManagerParameters
+	// SdsCertificatePath is the path of the SDS file holding the xDS client certificate.
+	SdsCertificatePath string
+	// SdsTrustedCAPath is the path of the SDS file holding the xDS trusted CA.
+	SdsTrustedCAPath string
 }
 
 type xdsServerParameters struct {
@@ -80,6 +97,13 @@ type xdsServerParameters struct {
 	Port int32
 }
 
+type wasmServerParameters struct {
+	// Address is the address of the Wasm HTTP server of Envoy Gateway.
+	Address string
+	// Port is the port of the Wasm HTTP server of Envoy Gateway.
+	Port int32
+}
+
 type metricSink struct {
 	// Address is the address of the XDS Server that Envoy is managed by.
 	Address string
@@ -119,6 +143,27 @@ type overloadManagerParameters struct {
 type RenderBootsrapConfigOptions struct {
 	ProxyMetrics     *egv1a1.ProxyMetrics
 	MaxHeapSizeBytes uint64
+	// XdsServerHost overrides the host of the xDS and Wasm servers, which defaults
+	// to the Envoy Gateway Kubernetes service.
+	XdsServerHost *string
+	// WasmServer adds the cluster fetching the wasm modules of OCI images from
+	// the Wasm server of Envoy Gateway.
+	WasmServer bool
+	// AdminServerPort overrides the port of the Envoy admin interface.
+	AdminServerPort *int32
+	// ReadyServerPort overrides the port of the readiness and stats listener.
+	ReadyServerPort *int32
+	// SdsConfig overrides the paths of the SDS files used to connect to the xDS server.
+	SdsConfig *SdsConfigPath
+}
+
+// SdsConfigPath defines the paths of the SDS resource files used to establish
+// the mTLS connection to the xDS server.
+type SdsConfigPath struct {
+	// Certificate is the path of the SDS file holding the client certificate.
+	Certificate string
+	// TrustedCA is the path of the SDS file holding the trusted CA.
+	TrustedCA string
 }
 
 // render the stringified bootstrap config in yaml format.
@@ -207,8 +252,10 @@ func GetRenderedBootstrapConfig(opts *RenderBootsrapConfigOptions) (string, erro
 				Port:          EnvoyReadinessPort,
 				ReadinessPath: EnvoyReadinessPath,
 			},
-			EnablePrometheus: enablePrometheus,
-			OtelMetricSinks:  metricSinks,
+			EnablePrometheus:   enablePrometheus,
+			OtelMetricSinks:    metricSinks,
+			SdsCertificatePath: defaultSdsCertificatePath,
+			SdsTrustedCAPath:   defaultSdsTrustedCAPath,
 		},
 	}
 	if opts != nil && opts.ProxyMetrics != nil && opts.ProxyMetrics.Matches != nil {
@@ -217,6 +264,26 @@ func GetRenderedBootstrapConfig(opts *RenderBootsrapConfigOptions) (string, erro
 
 	if opts != nil {
 		cfg.parameters.OverloadManager.MaxHeapSizeBytes = opts.MaxHeapSizeBytes
+
+		if opts.XdsServerHost != nil {
+			cfg.parameters.XdsServer.Address = *opts.XdsServerHost
+		}
+		if opts.WasmServer {
+			cfg.parameters.WasmServer = &wasmServerParameters{
+				Address: cfg.parameters.XdsServer.Address,
+				Port:    DefaultWasmServerPort,
+			}
+		}
+		if opts.AdminServerPort != nil {
+			cfg.parameters.AdminServer.Port = *opts.AdminServerPort
+		}
+		if opts.ReadyServerPort != nil {
+			cfg.parameters.ReadyServer.Port = *opts.ReadyServerPort
+		}
+		if opts.SdsConfig != nil {
+			cfg.parameters.SdsCertificatePath = opts.SdsConfig.Certificate
+			cfg.parameters.SdsTrustedCAPath = opts.SdsConfig.TrustedCA
+		}
 	}
 
 	if err := cfg.render(); err != nil {
@@ -168,14 +168,49 @@ static_resources:
           - name: xds_certificate
             sds_config:
               path_config_source:
-                path: "/sds/xds-certificate.json"
+                path: "{{ .SdsCertificatePath }}"
               resource_api_version: V3
           validation_context_sds_secret_config:
             name: xds_trusted_ca
             sds_config:
               path_config_source:
-                path: "/sds/xds-trusted-ca.json"
+                path: "{{ .SdsTrustedCAPath }}"
               resource_api_version: V3
+{{- if .WasmServer }}
+  - connect_timeout: 10s
+    load_assignment:
+      cluster_name: wasm_cluster
+      endpoints:
+      - load_balancing_weight: 1
+        lb_endpoints:
+        - load_balancing_weight: 1
+          endpoint:
+            address:
+              socket_address:
+                address: {{ .WasmServer.Address }}
+                port_value: {{ .WasmServer.Port }}
+    name: wasm_cluster
+    type: STRICT_DNS
+    transport_socket:
+      name: envoy.transport_sockets.tls
+      typed_config:
+        "@type": type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext
+        common_tls_context:
+          tls_params:
+            tls_maximum_protocol_version: TLSv1_3
+          tls_certificate_sds_secret_configs:
+          - name: xds_certificate
+            sds_config:
+              path_config_source:
+                path: "{{ .SdsCertificatePath }}"
+              resource_api_version: V3
+          validation_context_sds_secret_config:
+            name: xds_trusted_ca
+            sds_config:
+              path_config_source:
+                path: "{{ .SdsTrustedCAPath }}"
+              resource_api_version: V3
+{{- end }}
 overload_manager:
   refresh_interval: 0.25s
   resource_monitors:
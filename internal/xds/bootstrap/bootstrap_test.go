@@ -95,6 +95,19 @@ func TestGetRenderedBootstrapConfig(t *testing.T) {
 				MaxHeapSizeBytes: 1073741824,
 			},
 		},
+		{
+			name: "host-infra",
+			opts: &RenderBootsrapConfigOptions{
+				XdsServerHost:   ptr.To("127.0.0.1"),
+				WasmServer:      true,
+				AdminServerPort: ptr.To(int32(20000)),
+				ReadyServerPort: ptr.To(int32(20001)),
+				SdsConfig: &SdsConfigPath{
+					Certificate: "/home/envoy-gateway/sds/xds-certificate.json",
+					TrustedCA:   "/home/envoy-gateway/sds/xds-trusted-ca.json",
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,28 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package cache
+
+import "github.com/envoyproxy/gateway/internal/metrics"
+
+var (
+	xdsSnapshotVersion = metrics.NewGauge("xds_snapshot_version", "Current version of the xDS snapshot of an IR key.")
+
+	xdsSnapshotUpdateTotal = metrics.NewCounter("xds_snapshot_update_total", "Total number of xDS snapshot updates.")
+
+	xdsSnapshotUpdateErrorsTotal = metrics.NewCounter("xds_snapshot_update_errors_total", "Total number of failed xDS snapshot updates.")
+
+	xdsSnapshotPersistErrorsTotal = metrics.NewCounter("xds_snapshot_persist_errors_total", "Total number of xDS snapshots which failed to be persisted.")
+
+	xdsConnectedProxies = metrics.NewGauge("xds_connected_proxies", "Current number of Envoy proxies connected to the xDS server.")
+
+	xdsPushTotal = metrics.NewCounter("xds_push_total", "Total number of xDS responses sent to Envoy proxies.")
+
+	xdsNACKTotal = metrics.NewCounter("xds_nack_total", "Total number of xDS responses rejected by Envoy proxies.")
+
+	irKeyLabel = metrics.NewLabel("ir_key")
+
+	typeURLLabel = metrics.NewLabel("type_url")
+)
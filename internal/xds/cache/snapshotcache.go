@@ -24,9 +24,15 @@ import (
 	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	serverv3 "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/tracing"
 	"github.com/envoyproxy/gateway/internal/xds/types"
 )
 
@@ -44,7 +50,16 @@ var Hash = cachev3.IDHash{}
 type SnapshotCacheWithCallbacks interface {
 	cachev3.SnapshotCache
 	serverv3.Callbacks
-	GenerateNewSnapshot(string, types.XdsResources) error
+	GenerateNewSnapshot(context.Context, string, types.XdsResources) error
+	// LastSnapshots returns the last snapshot generated for each ir key.
+	LastSnapshots() map[string]*cachev3.Snapshot
+	// Persist restores the snapshots persisted in the store, which are served
+	// until new snapshots are generated for their ir keys, and persists the
+	// snapshots generated from now on to the store.
+	Persist(store SnapshotStore) error
+	// DropRestoredSnapshots drops the snapshots restored from the store for the
+	// ir keys other than irKeys, which no longer exist.
+	DropRestoredSnapshots(irKeys []string)
 }
 
 type snapshotMap map[string]*cachev3.Snapshot
@@ -56,16 +71,24 @@ type snapshotCache struct {
 	streamIDNodeInfo nodeInfoMap
 	snapshotVersion  int64
 	lastSnapshot     snapshotMap
-	log              *zap.SugaredLogger
-	mu               sync.Mutex
+	nacks            *message.XdsNACKs
+	store            SnapshotStore
+	// restored holds the ir keys of the snapshots restored from the store, for
+	// which no snapshot was generated since.
+	restored sets.Set[string]
+	log      *zap.SugaredLogger
+	mu       sync.Mutex
 }
 
 // GenerateNewSnapshot takes a table of resources (the output from the IR->xDS
-// translator) and updates the snapshot version.
-func (s *snapshotCache) GenerateNewSnapshot(irKey string, resources types.XdsResources) error {
+// translator) and updates the snapshot version. Setting the snapshot of each
+// node is traced as a child span of ctx.
+func (s *snapshotCache) GenerateNewSnapshot(ctx context.Context, irKey string, resources types.XdsResources) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	xdsSnapshotUpdateTotal.With(irKeyLabel.Value(irKey)).Increment()
+
 	version := s.newSnapshotVersion()
 
 	// Create a snapshot with all xDS resources.
@@ -74,15 +97,26 @@ func (s *snapshotCache) GenerateNewSnapshot(irKey string, resources types.XdsRes
 		resources,
 	)
 	if err != nil {
+		xdsSnapshotUpdateErrorsTotal.With(irKeyLabel.Value(irKey)).Increment()
 		return err
 	}
 
+	// The snapshot is persisted before being served, so that the versions
+	// served by a restarted Envoy Gateway never go backwards.
+	s.persist(irKey, resources)
+
 	s.lastSnapshot[irKey] = snapshot
+	s.restored.Delete(irKey)
+	xdsSnapshotVersion.With(irKeyLabel.Value(irKey)).Record(float64(s.snapshotVersion))
 
 	for _, node := range s.getNodeIDs(irKey) {
 		s.log.Debugf("Generating a snapshot with Node %s", node)
-		err := s.SetSnapshot(context.TODO(), node, snapshot)
+		nodeCtx, span := tracing.Tracer().Start(ctx, "xds.SnapshotCache.SetSnapshot",
+			trace.WithAttributes(attribute.String("node_id", node), attribute.String("snapshot_version", version)))
+		err := s.SetSnapshot(nodeCtx, node, snapshot)
+		tracing.End(span, err)
 		if err != nil {
+			xdsSnapshotUpdateErrorsTotal.With(irKeyLabel.Value(irKey)).Increment()
 			return err
 		}
 	}
@@ -90,6 +124,86 @@ func (s *snapshotCache) GenerateNewSnapshot(irKey string, resources types.XdsRes
 	return nil
 }
 
+// persist persists the resources of the ir key with the current snapshot version,
+// or deletes the persisted resources if nil. The failures are only logged, since
+// the snapshot can still be served.
+func (s *snapshotCache) persist(irKey string, resources types.XdsResources) {
+	if s.store == nil {
+		return
+	}
+	var snapshot *PersistedSnapshot
+	if resources != nil {
+		snapshot = &PersistedSnapshot{Version: s.snapshotVersion, Resources: resources}
+	}
+	if err := s.store.Save(irKey, snapshot, s.snapshotVersion); err != nil {
+		xdsSnapshotPersistErrorsTotal.With(irKeyLabel.Value(irKey)).Increment()
+		s.log.Errorf("Failed to persist the snapshot of ir key %s: %v", irKey, err)
+	}
+}
+
+// Persist restores the snapshots persisted in the store, and persists the
+// snapshots generated from now on to the store. The snapshot version resumes
+// from the last persisted version.
+func (s *snapshotCache) Persist(store SnapshotStore) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store = store
+	snapshots, version, err := store.Load()
+	if err != nil {
+		return err
+	}
+	if version > s.snapshotVersion {
+		s.snapshotVersion = version
+	}
+	for irKey, persisted := range snapshots {
+		// Don't override a snapshot generated since the cache was created.
+		if s.lastSnapshot[irKey] != nil {
+			continue
+		}
+		snapshot, err := cachev3.NewSnapshot(strconv.FormatInt(persisted.Version, 10), persisted.Resources)
+		if err != nil {
+			return fmt.Errorf("failed to restore the snapshot of ir key %s: %w", irKey, err)
+		}
+		s.lastSnapshot[irKey] = snapshot
+		s.restored.Insert(irKey)
+		xdsSnapshotVersion.With(irKeyLabel.Value(irKey)).Record(float64(persisted.Version))
+	}
+	return nil
+}
+
+// DropRestoredSnapshots drops the snapshots restored from the store for the ir
+// keys other than irKeys, from both the cache and the store, along with the
+// snapshots of their nodes.
+func (s *snapshotCache) DropRestoredSnapshots(irKeys []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stale := s.restored.Difference(sets.New(irKeys...))
+	for irKey := range stale {
+		s.log.Infof("Dropping the restored snapshot of ir key %s", irKey)
+		for _, node := range s.getNodeIDs(irKey) {
+			s.ClearSnapshot(node)
+		}
+		delete(s.lastSnapshot, irKey)
+		s.restored.Delete(irKey)
+		s.persist(irKey, nil)
+		xdsSnapshotVersion.With(irKeyLabel.Value(irKey)).Record(0)
+	}
+}
+
+// LastSnapshots returns the last snapshot generated for each ir key.
+func (s *snapshotCache) LastSnapshots() map[string]*cachev3.Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshots := make(map[string]*cachev3.Snapshot, len(s.lastSnapshot))
+	for irKey, snapshot := range s.lastSnapshot {
+		snapshots[irKey] = snapshot
+	}
+	return snapshots
+}
+
 // newSnapshotVersion increments the current snapshotVersion
 // and returns as a string.
 func (s *snapshotCache) newSnapshotVersion() string {
@@ -106,14 +220,18 @@ func (s *snapshotCache) newSnapshotVersion() string {
 // NewSnapshotCache gives you a fresh SnapshotCache.
 // It needs a logger that supports the go-control-plane
 // required interface (Debugf, Infof, Warnf, and Errorf).
-func NewSnapshotCache(ads bool, logger logging.Logger) SnapshotCacheWithCallbacks {
+// The xDS responses rejected by the Envoy proxies are published
+// to nacks, if set.
+func NewSnapshotCache(ads bool, logger logging.Logger, nacks *message.XdsNACKs) SnapshotCacheWithCallbacks {
 	// Set up the nasty wrapper hack.
 	wrappedLogger := logger.Sugar()
 	return &snapshotCache{
 		SnapshotCache:    cachev3.NewSnapshotCache(ads, &Hash, wrappedLogger),
 		log:              wrappedLogger,
 		lastSnapshot:     make(snapshotMap),
+		restored:         sets.New[string](),
 		streamIDNodeInfo: make(nodeInfoMap),
+		nacks:            nacks,
 	}
 }
 
@@ -130,6 +248,57 @@ func (s *snapshotCache) getNodeIDs(irKey string) []string {
 	return nodeIDs
 }
 
+// recordConnectedProxies records the number of nodes connected
+// for the ir key.
+func (s *snapshotCache) recordConnectedProxies(irKey string) {
+	xdsConnectedProxies.With(irKeyLabel.Value(irKey)).Record(float64(len(s.getNodeIDs(irKey))))
+}
+
+// removeStream forgets the node of a closed stream.
+func (s *snapshotCache) removeStream(streamID int64) {
+	node := s.streamIDNodeInfo[streamID]
+	delete(s.streamIDNodeInfo, streamID)
+	if node != nil {
+		s.recordConnectedProxies(node.Cluster)
+		// The rejection can no longer be resolved by the node.
+		s.clearNACK(node.Cluster, node.Id, "")
+	}
+}
+
+// handleNACK publishes the response rejected by the node, or clears the
+// rejection previously published for the node if the response is acknowledged.
+func (s *snapshotCache) handleNACK(irKey, nodeID, typeURL, responseNonce string, resourceNames []string, errorDetail *rpcstatus.Status) {
+	if s.nacks == nil {
+		return
+	}
+	if errorDetail == nil {
+		// A request without response nonce isn't the acknowledgement of a response.
+		if responseNonce != "" {
+			s.clearNACK(irKey, nodeID, typeURL)
+		}
+		return
+	}
+	s.nacks.Store(irKey, &types.XdsNACK{
+		IRKey:         irKey,
+		NodeID:        nodeID,
+		TypeURL:       typeURL,
+		ResponseNonce: responseNonce,
+		ResourceNames: resourceNames,
+		Message:       errorDetail.Message,
+	})
+}
+
+// clearNACK clears the rejection published for the ir key if it was
+// reported by the node for the type URL, or for any type URL if empty.
+func (s *snapshotCache) clearNACK(irKey, nodeID, typeURL string) {
+	if s.nacks == nil {
+		return
+	}
+	if nack, ok := s.nacks.Load(irKey); ok && nack.NodeID == nodeID && (typeURL == "" || nack.TypeURL == typeURL) {
+		s.nacks.Delete(irKey)
+	}
+}
+
 // OnStreamOpen and the other OnStream* functions implement the callbacks for the
 // state-of-the-world stream types.
 func (s *snapshotCache) OnStreamOpen(_ context.Context, streamID int64, _ string) error {
@@ -146,7 +315,7 @@ func (s *snapshotCache) OnStreamClosed(streamID int64, _ *corev3.Node) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	delete(s.streamIDNodeInfo, streamID)
+	s.removeStream(streamID)
 }
 
 func (s *snapshotCache) OnStreamRequest(streamID int64, req *discoveryv3.DiscoveryRequest) error {
@@ -164,10 +333,19 @@ func (s *snapshotCache) OnStreamRequest(streamID int64, req *discoveryv3.Discove
 		}
 		s.log.Debugf("First discovery request on stream %d, got nodeID %s", streamID, req.Node.Id)
 		s.streamIDNodeInfo[streamID] = req.Node
+		s.recordConnectedProxies(req.Node.Cluster)
 	}
 	nodeID := s.streamIDNodeInfo[streamID].Id
 	cluster := s.streamIDNodeInfo[streamID].Cluster
 
+	// A request carrying error details rejects the last response sent on the stream.
+	// The metrics are labeled by ir key, as the node IDs are unbounded.
+	if req.ErrorDetail != nil {
+		xdsNACKTotal.With(irKeyLabel.Value(cluster), typeURLLabel.Value(req.GetTypeUrl())).Increment()
+		s.log.Warnf("Node %s of ir key %s rejected the %s response with nonce %s: %s",
+			nodeID, cluster, req.GetTypeUrl(), req.ResponseNonce, req.ErrorDetail.GetMessage())
+	}
+
 	var nodeVersion string
 
 	var errorCode int32
@@ -197,10 +375,10 @@ func (s *snapshotCache) OnStreamRequest(streamID int64, req *discoveryv3.Discove
 
 	if status := req.ErrorDetail; status != nil {
 		// if Envoy rejected the last update log the details here.
-		// TODO(youngnick): Handle NACK properly
 		errorCode = status.Code
 		errorMessage = status.Message
 	}
+	s.handleNACK(cluster, nodeID, req.GetTypeUrl(), req.ResponseNonce, req.ResourceNames, req.ErrorDetail)
 
 	s.log.Debugf("handling v3 xDS resource request, version_info %s, response_nonce %s, nodeID %s, node_version %s, resource_names %v, type_url %s, errorCode %d, errorMessage %s",
 		req.VersionInfo, req.ResponseNonce,
@@ -210,13 +388,14 @@ func (s *snapshotCache) OnStreamRequest(streamID int64, req *discoveryv3.Discove
 	return nil
 }
 
-func (s *snapshotCache) OnStreamResponse(_ context.Context, streamID int64, _ *discoveryv3.DiscoveryRequest, _ *discoveryv3.DiscoveryResponse) {
+func (s *snapshotCache) OnStreamResponse(_ context.Context, streamID int64, _ *discoveryv3.DiscoveryRequest, resp *discoveryv3.DiscoveryResponse) {
 	// No mutex lock required here because no writing to the cache.
 	node := s.streamIDNodeInfo[streamID]
 	if node == nil {
 		s.log.Errorf("Tried to send a response to a node we haven't seen yet on stream %d", streamID)
 	} else {
 		s.log.Debugf("Sending Response on stream %d to node %s", streamID, node.Id)
+		xdsPushTotal.With(irKeyLabel.Value(node.Cluster), typeURLLabel.Value(resp.GetTypeUrl())).Increment()
 	}
 }
 
@@ -238,7 +417,7 @@ func (s *snapshotCache) OnDeltaStreamClosed(streamID int64, _ *corev3.Node) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	delete(s.streamIDNodeInfo, streamID)
+	s.removeStream(streamID)
 }
 
 func (s *snapshotCache) OnStreamDeltaRequest(streamID int64, req *discoveryv3.DeltaDiscoveryRequest) error {
@@ -261,10 +440,19 @@ func (s *snapshotCache) OnStreamDeltaRequest(streamID int64, req *discoveryv3.De
 		}
 		s.log.Debugf("First incremental discovery request on stream %d, got nodeID %s", streamID, req.Node.Id)
 		s.streamIDNodeInfo[streamID] = req.Node
+		s.recordConnectedProxies(req.Node.Cluster)
 	}
 	nodeID := s.streamIDNodeInfo[streamID].Id
 	cluster := s.streamIDNodeInfo[streamID].Cluster
 
+	// A request carrying error details rejects the last response sent on the stream.
+	// The metrics are labeled by ir key, as the node IDs are unbounded.
+	if req.ErrorDetail != nil {
+		xdsNACKTotal.With(irKeyLabel.Value(cluster), typeURLLabel.Value(req.GetTypeUrl())).Increment()
+		s.log.Warnf("Node %s of ir key %s rejected the %s response with nonce %s: %s",
+			nodeID, cluster, req.GetTypeUrl(), req.ResponseNonce, req.ErrorDetail.GetMessage())
+	}
+
 	// If no snapshot has been written into the snapshotCache yet, we can't do anything, so don't mess with
 	// this request. go-control-plane will respond with an empty response, then send an update when a
 	// snapshot is generated.
@@ -290,10 +478,10 @@ func (s *snapshotCache) OnStreamDeltaRequest(streamID int64, req *discoveryv3.De
 		req.ResponseNonce, nodeID, nodeVersion)
 	if status := req.ErrorDetail; status != nil {
 		// if Envoy rejected the last update log the details here.
-		// TODO(youngnick): Handle NACK properly
 		errorCode = status.Code
 		errorMessage = status.Message
 	}
+	s.handleNACK(cluster, nodeID, req.GetTypeUrl(), req.ResponseNonce, req.ResourceNamesSubscribe, req.ErrorDetail)
 	s.log.Debugf("handling v3 xDS resource request, response_nonce %s, nodeID %s, node_version %s, resource_names_subscribe %v, resource_names_unsubscribe %v, type_url %s, errorCode %d, errorMessage %s",
 		req.ResponseNonce,
 		nodeID, nodeVersion,
@@ -304,13 +492,14 @@ func (s *snapshotCache) OnStreamDeltaRequest(streamID int64, req *discoveryv3.De
 	return nil
 }
 
-func (s *snapshotCache) OnStreamDeltaResponse(streamID int64, _ *discoveryv3.DeltaDiscoveryRequest, _ *discoveryv3.DeltaDiscoveryResponse) {
+func (s *snapshotCache) OnStreamDeltaResponse(streamID int64, _ *discoveryv3.DeltaDiscoveryRequest, resp *discoveryv3.DeltaDiscoveryResponse) {
 	// No mutex lock required here because no writing to the cache.
 	node := s.streamIDNodeInfo[streamID]
 	if node == nil {
 		s.log.Errorf("Tried to send a response to a node we haven't seen yet on stream %d", streamID)
 	} else {
 		s.log.Debugf("Sending Incremental Response on stream %d to node %s", streamID, node.Id)
+		xdsPushTotal.With(irKeyLabel.Value(node.Cluster), typeURLLabel.Value(resp.GetTypeUrl())).Increment()
 	}
 }
 
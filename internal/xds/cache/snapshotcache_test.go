@@ -0,0 +1,137 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	cachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/require"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/logging"
+	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func TestSnapshotCacheNACK(t *testing.T) {
+	nacks := new(message.XdsNACKs)
+	c := NewSnapshotCache(true, logging.DefaultLogger(v1alpha1.LogLevelInfo), nacks)
+	require.NoError(t, c.GenerateNewSnapshot(context.Background(), "default/eg", types.XdsResources{}))
+
+	node := &corev3.Node{Id: "envoy-default-eg", Cluster: "default/eg"}
+	request := func(typeURL, nonce, errMsg string) *discoveryv3.DiscoveryRequest {
+		req := &discoveryv3.DiscoveryRequest{Node: node, TypeUrl: typeURL, ResponseNonce: nonce}
+		if errMsg != "" {
+			req.ErrorDetail = &rpcstatus.Status{Message: errMsg}
+		}
+		return req
+	}
+
+	require.NoError(t, c.OnStreamOpen(context.Background(), 1, ""))
+	require.NoError(t, c.OnStreamRequest(1, request(resourcev3.ListenerType, "", "")))
+	require.Equal(t, 0, nacks.Len())
+
+	// The rejected response is published
+	require.NoError(t, c.OnStreamRequest(1, request(resourcev3.ListenerType, "1", "invalid listener")))
+	nack, ok := nacks.Load("default/eg")
+	require.True(t, ok)
+	require.Equal(t, &types.XdsNACK{
+		IRKey:         "default/eg",
+		NodeID:        "envoy-default-eg",
+		TypeURL:       resourcev3.ListenerType,
+		ResponseNonce: "1",
+		Message:       "invalid listener",
+	}, nack)
+
+	// Acknowledging another type of resources doesn't clear it
+	require.NoError(t, c.OnStreamRequest(1, request(resourcev3.ClusterType, "2", "")))
+	require.Equal(t, 1, nacks.Len())
+
+	// Acknowledging the rejected type of resources clears it
+	require.NoError(t, c.OnStreamRequest(1, request(resourcev3.ListenerType, "3", "")))
+	require.Equal(t, 0, nacks.Len())
+
+	// Closing the stream of the node clears it
+	require.NoError(t, c.OnStreamRequest(1, request(resourcev3.ListenerType, "4", "invalid listener")))
+	require.Equal(t, 1, nacks.Len())
+	c.OnStreamClosed(1, node)
+	require.Equal(t, 0, nacks.Len())
+}
+
+func TestSnapshotCachePersist(t *testing.T) {
+	store := NewFileSnapshotStore(t.TempDir())
+	logger := logging.DefaultLogger(v1alpha1.LogLevelInfo)
+	resources := types.XdsResources{
+		resourcev3.ListenerType: []cachetypes.Resource{&listenerv3.Listener{Name: "default/eg/http"}},
+	}
+
+	c := NewSnapshotCache(true, logger, nil)
+	require.NoError(t, c.Persist(store))
+	require.NoError(t, c.GenerateNewSnapshot(context.Background(), "default/eg", resources))
+	require.NoError(t, c.GenerateNewSnapshot(context.Background(), "default/other", types.XdsResources{}))
+	require.NoError(t, c.GenerateNewSnapshot(context.Background(), "default/other", nil))
+
+	// The persisted snapshot is served by a new cache, with the same version
+	restarted := NewSnapshotCache(true, logger, nil)
+	require.NoError(t, restarted.Persist(store))
+	snapshots := restarted.LastSnapshots()
+	require.Len(t, snapshots, 1)
+	require.Equal(t, "1", snapshots["default/eg"].GetVersion(resourcev3.ListenerType))
+	require.Contains(t, snapshots["default/eg"].GetResources(resourcev3.ListenerType), "default/eg/http")
+
+	node := &corev3.Node{Id: "envoy-default-eg", Cluster: "default/eg"}
+	require.NoError(t, restarted.OnStreamOpen(context.Background(), 1, ""))
+	require.NoError(t, restarted.OnStreamRequest(1, &discoveryv3.DiscoveryRequest{Node: node, TypeUrl: resourcev3.ListenerType}))
+	snapshot, err := restarted.GetSnapshot(node.Id)
+	require.NoError(t, err)
+	require.Equal(t, "1", snapshot.GetVersion(resourcev3.ListenerType))
+
+	// The versions keep increasing from the last version of the previous cache
+	require.NoError(t, restarted.GenerateNewSnapshot(context.Background(), "default/eg", resources))
+	snapshot, err = restarted.GetSnapshot(node.Id)
+	require.NoError(t, err)
+	require.Equal(t, "4", snapshot.GetVersion(resourcev3.ListenerType))
+}
+
+func TestSnapshotCacheDropRestoredSnapshots(t *testing.T) {
+	store := NewFileSnapshotStore(t.TempDir())
+	logger := logging.DefaultLogger(v1alpha1.LogLevelInfo)
+	resources := types.XdsResources{
+		resourcev3.ListenerType: []cachetypes.Resource{&listenerv3.Listener{Name: "default/eg/http"}},
+	}
+
+	c := NewSnapshotCache(true, logger, nil)
+	require.NoError(t, c.Persist(store))
+	for _, irKey := range []string{"default/eg", "default/stale", "default/regenerated"} {
+		require.NoError(t, c.GenerateNewSnapshot(context.Background(), irKey, resources))
+	}
+
+	restarted := NewSnapshotCache(true, logger, nil)
+	require.NoError(t, restarted.Persist(store))
+	require.Len(t, restarted.LastSnapshots(), 3)
+
+	// The snapshots generated since the restart are kept, even if their ir key
+	// is no longer published.
+	require.NoError(t, restarted.GenerateNewSnapshot(context.Background(), "default/regenerated", resources))
+	restarted.DropRestoredSnapshots([]string{"default/eg"})
+	snapshots := restarted.LastSnapshots()
+	require.Len(t, snapshots, 2)
+	require.Contains(t, snapshots, "default/eg")
+	require.Contains(t, snapshots, "default/regenerated")
+
+	// The dropped snapshot is no longer persisted either.
+	persisted, _, err := store.Load()
+	require.NoError(t, err)
+	require.Len(t, persisted, 2)
+	require.NotContains(t, persisted, "default/stale")
+}
@@ -0,0 +1,194 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	xdstypes "github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+// PersistedSnapshot holds the xDS resources of a snapshot and its version.
+type PersistedSnapshot struct {
+	Version   int64
+	Resources xdstypes.XdsResources
+}
+
+// SnapshotStore persists the xDS snapshots, so that they're served as soon
+// as Envoy Gateway restarts.
+type SnapshotStore interface {
+	// Load returns the persisted snapshots by ir key, and the last version
+	// generated by the snapshot cache.
+	Load() (map[string]*PersistedSnapshot, int64, error)
+	// Save persists the snapshot of the ir key, or deletes it if nil, along
+	// with the last version generated by the snapshot cache.
+	Save(irKey string, snapshot *PersistedSnapshot, version int64) error
+}
+
+const (
+	// snapshotFileExt is the extension of the files holding the snapshots.
+	snapshotFileExt = ".json"
+	// versionFilename is the name of the file holding the last version.
+	versionFilename = "version"
+)
+
+// snapshotFile is the content of the file holding the snapshot of an ir key.
+type snapshotFile struct {
+	IRKey   string `json:"irKey"`
+	Version int64  `json:"version"`
+	// Resources are the xDS resources encoded in the protobuf wire format by
+	// type URL.
+	Resources map[string][][]byte `json:"resources"`
+}
+
+// fileSnapshotStore persists the snapshot of each ir key to a file of a
+// directory, named after the ir key.
+type fileSnapshotStore struct {
+	dir string
+}
+
+// NewFileSnapshotStore returns a SnapshotStore persisting the snapshots to
+// files in dir.
+func NewFileSnapshotStore(dir string) SnapshotStore {
+	return &fileSnapshotStore{dir: dir}
+}
+
+func (f *fileSnapshotStore) Load() (map[string]*PersistedSnapshot, int64, error) {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+
+	var version int64
+	snapshots := make(map[string]*PersistedSnapshot)
+	for _, entry := range entries {
+		name := entry.Name()
+		switch {
+		case name == versionFilename:
+			data, err := os.ReadFile(filepath.Join(f.dir, name))
+			if err != nil {
+				return nil, 0, err
+			}
+			if version, err = strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64); err != nil {
+				return nil, 0, fmt.Errorf("invalid snapshot version: %w", err)
+			}
+		case strings.HasSuffix(name, snapshotFileExt):
+			irKey, snapshot, err := f.loadSnapshot(filepath.Join(f.dir, name))
+			if err != nil {
+				return nil, 0, fmt.Errorf("failed to load snapshot %s: %w", name, err)
+			}
+			snapshots[irKey] = snapshot
+		}
+	}
+
+	// The version is saved after the snapshot, so it may lag behind the
+	// version of a snapshot if saving it failed.
+	for _, snapshot := range snapshots {
+		version = max(version, snapshot.Version)
+	}
+	return snapshots, version, nil
+}
+
+func (f *fileSnapshotStore) loadSnapshot(path string) (string, *PersistedSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, err
+	}
+	file := new(snapshotFile)
+	if err := json.Unmarshal(data, file); err != nil {
+		return "", nil, err
+	}
+
+	resources := make(xdstypes.XdsResources, len(file.Resources))
+	for typeURL, encoded := range file.Resources {
+		mt, err := protoregistry.GlobalTypes.FindMessageByURL(typeURL)
+		if err != nil {
+			return "", nil, err
+		}
+		list := make([]types.Resource, 0, len(encoded))
+		for _, b := range encoded {
+			msg := mt.New().Interface()
+			if err := proto.Unmarshal(b, msg); err != nil {
+				return "", nil, err
+			}
+			list = append(list, msg)
+		}
+		resources[typeURL] = list
+	}
+	return file.IRKey, &PersistedSnapshot{Version: file.Version, Resources: resources}, nil
+}
+
+func (f *fileSnapshotStore) Save(irKey string, snapshot *PersistedSnapshot, version int64) error {
+	if err := os.MkdirAll(f.dir, 0o750); err != nil {
+		return err
+	}
+
+	path := filepath.Join(f.dir, url.PathEscape(irKey)+snapshotFileExt)
+	if snapshot == nil {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	} else {
+		file := &snapshotFile{
+			IRKey:     irKey,
+			Version:   snapshot.Version,
+			Resources: make(map[string][][]byte, len(snapshot.Resources)),
+		}
+		for typeURL, list := range snapshot.Resources {
+			encoded := make([][]byte, 0, len(list))
+			for _, resource := range list {
+				b, err := proto.MarshalOptions{Deterministic: true}.Marshal(resource)
+				if err != nil {
+					return err
+				}
+				encoded = append(encoded, b)
+			}
+			file.Resources[typeURL] = encoded
+		}
+		data, err := json.Marshal(file)
+		if err != nil {
+			return err
+		}
+		if err := writeFileAtomically(path, data); err != nil {
+			return err
+		}
+	}
+
+	return writeFileAtomically(filepath.Join(f.dir, versionFilename), []byte(strconv.FormatInt(version, 10)))
+}
+
+// writeFileAtomically writes the data to a temporary file renamed to path,
+// so that path is never left partially written.
+func writeFileAtomically(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,71 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	xdstypes "github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func TestFileSnapshotStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "xds")
+	store := NewFileSnapshotStore(dir)
+
+	// Nothing is loaded before the directory is created
+	snapshots, version, err := store.Load()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+	require.Zero(t, version)
+
+	gw1 := &PersistedSnapshot{
+		Version: 1,
+		Resources: xdstypes.XdsResources{
+			resourcev3.ListenerType: []types.Resource{&listenerv3.Listener{Name: "default/gateway-1/http"}},
+			resourcev3.ClusterType:  []types.Resource{&clusterv3.Cluster{Name: "httproute/default/route/rule/0"}},
+		},
+	}
+	gw2 := &PersistedSnapshot{Version: 2, Resources: xdstypes.XdsResources{}}
+	require.NoError(t, store.Save("default/gateway-1", gw1, 1))
+	require.NoError(t, store.Save("default/gateway-2", gw2, 2))
+
+	snapshots, version, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), version)
+	require.Len(t, snapshots, 2)
+	require.Empty(t, snapshots["default/gateway-2"].Resources)
+	require.Equal(t, int64(1), snapshots["default/gateway-1"].Version)
+	require.Empty(t, cmp.Diff(gw1.Resources, snapshots["default/gateway-1"].Resources, protocmp.Transform()))
+
+	// Deleting a snapshot still persists the version
+	require.NoError(t, store.Save("default/gateway-2", nil, 3))
+	snapshots, version, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), version)
+	require.Len(t, snapshots, 1)
+	require.Contains(t, snapshots, "default/gateway-1")
+
+	// The version of a snapshot is loaded if the version wasn't saved after it
+	require.NoError(t, os.WriteFile(filepath.Join(dir, versionFilename), []byte("0"), 0o600))
+	_, version, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+
+	// A corrupted snapshot fails the load
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "corrupted.json"), []byte("{"), 0o600))
+	_, _, err = store.Load()
+	require.Error(t, err)
+}
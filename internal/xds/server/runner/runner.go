@@ -10,12 +10,16 @@ import (
 	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"strconv"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/keepalive"
 
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/service/cluster/v3"
@@ -25,13 +29,17 @@ import (
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/service/route/v3"
 	runtimev3 "github.com/envoyproxy/go-control-plane/envoy/service/runtime/v3"
 	secretv3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	serverv3 "github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/infrastructure/host"
 	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/supervisor"
+	"github.com/envoyproxy/gateway/internal/tracing"
 	"github.com/envoyproxy/gateway/internal/xds/bootstrap"
 	"github.com/envoyproxy/gateway/internal/xds/cache"
 	xdstypes "github.com/envoyproxy/gateway/internal/xds/types"
@@ -53,9 +61,18 @@ const (
 
 type Config struct {
 	config.Server
-	Xds   *message.Xds
-	grpc  *grpc.Server
-	cache cache.SnapshotCacheWithCallbacks
+	Xds *message.Xds
+	// XdsIRKeys holds the keys of the xds IRs published by the last translation
+	// of the provider resources, for the persisted snapshots of the other ir keys
+	// to be dropped, if set.
+	XdsIRKeys *message.XdsIRKeys
+	// XdsNACKs receives the xDS responses rejected by the Envoy proxies, if set.
+	XdsNACKs *message.XdsNACKs
+	// WasmHandler serves the wasm modules pulled by Envoy Gateway to the
+	// Envoy proxies, if set.
+	WasmHandler http.Handler
+	grpc        *grpc.Server
+	cache       cache.SnapshotCacheWithCallbacks
 }
 
 type Runner struct {
@@ -63,7 +80,18 @@ type Runner struct {
 }
 
 func New(cfg *Config) *Runner {
-	return &Runner{Config: *cfg}
+	r := &Runner{Config: *cfg}
+	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
+	return r
+}
+
+// XdsSnapshots returns the last xDS snapshot generated for each IR key,
+// or nil if the runner hasn't been started.
+func (r *Runner) XdsSnapshots() map[string]*cachev3.Snapshot {
+	if r.cache == nil {
+		return nil
+	}
+	return r.cache.LastSnapshots()
 }
 
 func (r *Runner) Name() string {
@@ -72,37 +100,85 @@ func (r *Runner) Name() string {
 
 // Start starts the xds-server runner
 func (r *Runner) Start(ctx context.Context) (err error) {
-	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
 
 	// Set up the gRPC server and register the xDS handler.
 	// Create SnapshotCache before start subscribeAndTranslate,
 	// prevent panics in case cache is nil.
-	cfg := r.tlsConfig(xdsTLSCertFilename, xdsTLSKeyFilename, xdsTLSCaFilename)
-	r.grpc = grpc.NewServer(grpc.Creds(credentials.NewTLS(cfg)), grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+	certFile, keyFile, caFile := xdsTLSCertFilename, xdsTLSKeyFilename, xdsTLSCaFilename
+	if r.EnvoyGateway.Provider.IsRunningOnHost() {
+		// The certificates are generated by the host infrastructure provider.
+		paths, err := host.GetPaths(r.EnvoyGateway.Provider.Custom.Infrastructure.Host)
+		if err != nil {
+			return err
+		}
+		certFile = paths.TLSCertPath(host.EnvoyGatewayComponent)
+		keyFile = paths.TLSKeyPath(host.EnvoyGatewayComponent)
+		caFile = paths.TLSCaPath(host.EnvoyGatewayComponent)
+	}
+	cfg := r.tlsConfig(certFile, keyFile, caFile)
+
+	// Listen before starting the servers, so that a failure, e.g. when the port
+	// isn't released yet after a restart, is reported to the supervisor, which
+	// retries starting the runner and reports it as not ready meanwhile.
+	xdsListener, err := listen(bootstrap.DefaultXdsServerPort)
+	if err != nil {
+		return err
+	}
+	var wasmListener net.Listener
+	if r.WasmHandler != nil {
+		if wasmListener, err = listen(bootstrap.DefaultWasmServerPort); err != nil {
+			xdsListener.Close()
+			return err
+		}
+	}
+
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(cfg)), grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
 		MinTime:             15 * time.Second,
 		PermitWithoutStream: true,
 	}))
+	r.grpc = grpcServer
 
-	r.cache = cache.NewSnapshotCache(true, r.Logger)
-	registerServer(serverv3.NewServer(ctx, r.cache, r.cache), r.grpc)
+	r.cache = cache.NewSnapshotCache(true, r.Logger, r.XdsNACKs)
+	if xdsServer := r.EnvoyGateway.XdsServer; xdsServer != nil && xdsServer.SnapshotPersistence != nil {
+		// Serve the last persisted snapshots until the resources are translated again.
+		dir := xdsServer.SnapshotPersistence.File.Path
+		if err := r.cache.Persist(cache.NewFileSnapshotStore(dir)); err != nil {
+			r.Logger.Error(err, "failed to restore the persisted xds snapshots", "path", dir)
+		} else {
+			r.Logger.Info("restored the persisted xds snapshots", "path", dir, "ir-keys", len(r.cache.LastSnapshots()))
+		}
+	}
+	registerServer(serverv3.NewServer(ctx, r.cache, r.cache), grpcServer)
 
-	// Start and listen xDS gRPC Server.
-	go r.serveXdsServer(ctx)
+	// Start the xDS gRPC Server.
+	supervisor.Go(ctx, func() { r.serveXdsServer(ctx, grpcServer, xdsListener) })
+
+	// Start the wasm HTTP Server, which authenticates the Envoy proxies with
+	// the same certificates as the xDS gRPC Server.
+	if wasmListener != nil {
+		supervisor.Go(ctx, func() { r.serveWasmServer(ctx, tls.NewListener(wasmListener, cfg)) })
+	}
 
 	// Start message Subscription.
-	go r.subscribeAndTranslate(ctx)
+	supervisor.Go(ctx, func() { r.subscribeAndTranslate(ctx) })
+	if r.XdsIRKeys != nil {
+		supervisor.Go(ctx, func() { r.subscribeAndDropRestoredSnapshots(ctx) })
+	}
 	r.Logger.Info("started")
 	return
 }
 
-func (r *Runner) serveXdsServer(ctx context.Context) {
-	addr := net.JoinHostPort(XdsServerAddress, strconv.Itoa(bootstrap.DefaultXdsServerPort))
+// listen listens on the port of the xds-server address.
+func listen(port int) (net.Listener, error) {
+	addr := net.JoinHostPort(XdsServerAddress, strconv.Itoa(port))
 	l, err := net.Listen("tcp", addr)
 	if err != nil {
-		r.Logger.Error(err, "failed to listen on address", "address", addr)
-		return
+		return nil, fmt.Errorf("failed to listen on address %s: %w", addr, err)
 	}
+	return l, nil
+}
 
+func (r *Runner) serveXdsServer(ctx context.Context, srv *grpc.Server, l net.Listener) {
 	go func() {
 		<-ctx.Done()
 		r.Logger.Info("grpc server shutting down")
@@ -110,11 +186,32 @@ func (r *Runner) serveXdsServer(ctx context.Context) {
 		// has long-lived hanging xDS requests. There's no
 		// mechanism to make those pending requests fail,
 		// so we forcibly terminate the TCP sessions.
-		r.grpc.Stop()
+		srv.Stop()
+	}()
+
+	// Serve only returns an error if the server wasn't stopped, in which case
+	// the runner is restarted.
+	if err := srv.Serve(l); err != nil {
+		supervisor.Fail(ctx, fmt.Errorf("failed to serve the grpc based xds server: %w", err))
+	}
+}
+
+func (r *Runner) serveWasmServer(ctx context.Context, l net.Listener) {
+	srv := &http.Server{
+		Handler:           r.WasmHandler,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		r.Logger.Info("wasm server shutting down")
+		if err := srv.Close(); err != nil {
+			r.Logger.Error(err, "failed to close wasm server")
+		}
 	}()
 
-	if err = r.grpc.Serve(l); err != nil {
-		r.Logger.Error(err, "failed to start grpc based xds server")
+	if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		supervisor.Fail(ctx, fmt.Errorf("failed to serve the wasm server: %w", err))
 	}
 }
 
@@ -139,16 +236,20 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 			val := update.Value
 
 			r.Logger.Info("received an update")
+			// Continue the trace of the xds translation
+			traceCtx, span := tracing.Tracer().Start(r.Xds.Traces.Extract(ctx, key), "xds.SnapshotCache.GenerateNewSnapshot",
+				trace.WithAttributes(attribute.String("ir_key", key)))
 			var err error
+			defer func() { tracing.End(span, err) }()
 			if update.Delete {
-				err = r.cache.GenerateNewSnapshot(key, nil)
+				err = r.cache.GenerateNewSnapshot(traceCtx, key, nil)
 			} else if val != nil && val.XdsResources != nil {
 				if r.cache == nil {
 					r.Logger.Error(err, "failed to init snapshot cache")
 					errChan <- err
 				} else {
 					// Update snapshot cache
-					err = r.cache.GenerateNewSnapshot(key, val.XdsResources)
+					err = r.cache.GenerateNewSnapshot(traceCtx, key, val.XdsResources)
 				}
 			}
 			if err != nil {
@@ -161,6 +262,20 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 	r.Logger.Info("subscriber shutting down")
 }
 
+// subscribeAndDropRestoredSnapshots drops the persisted snapshots restored for
+// the ir keys which are no longer published once the provider resources are
+// translated.
+func (r *Runner) subscribeAndDropRestoredSnapshots(ctx context.Context) {
+	message.HandleSubscription(message.Metadata{Runner: string(v1alpha1.LogComponentXdsServerRunner), Message: "xds-ir-keys"}, r.XdsIRKeys.Subscribe(ctx),
+		func(update message.Update[string, message.IRKeys], errChan chan error) {
+			if update.Delete {
+				return
+			}
+			r.cache.DropRestoredSnapshots(update.Value)
+		},
+	)
+}
+
 func (r *Runner) tlsConfig(cert, key, ca string) *tls.Config {
 	loadConfig := func() (*tls.Config, error) {
 		cert, err := tls.LoadX509KeyPair(cert, key)
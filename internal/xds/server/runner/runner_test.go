@@ -189,7 +189,7 @@ func peekError(conn net.Conn) error {
 	return nil
 }
 
-func TestServeXdsServerListenFailed(t *testing.T) {
+func TestStartListenFailed(t *testing.T) {
 	// Occupy the address to make listening failed
 	addr := net.JoinHostPort(XdsServerAddress, strconv.Itoa(bootstrap.DefaultXdsServerPort))
 	l, err := net.Listen("tcp", addr)
@@ -200,7 +200,7 @@ func TestServeXdsServerListenFailed(t *testing.T) {
 	r := New(&Config{
 		Server: *cfg,
 	})
-	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
-	// Don't crash in this function
-	r.serveXdsServer(context.Background())
+	// The failure is reported to the supervisor, which retries starting the runner
+	err = r.Start(context.Background())
+	require.ErrorContains(t, err, "failed to listen on address")
 }
@@ -27,6 +27,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/utils/ptr"
 
+	extensionTypes "github.com/envoyproxy/gateway/internal/extension/types"
 	"github.com/envoyproxy/gateway/internal/ir"
 )
 
@@ -49,6 +50,10 @@ type xdsClusterArgs struct {
 	http1Settings  *ir.HTTP1Settings
 	timeout        *ir.Timeout
 	tcpkeepalive   *ir.TCPKeepalive
+	// route and extensionManager are only set for the clusters of HTTP routes,
+	// which can be modified by an extension.
+	route            *ir.HTTPRoute
+	extensionManager *extensionTypes.Manager
 }
 
 type EndpointType int
@@ -27,9 +27,86 @@ import (
 	"github.com/envoyproxy/gateway/internal/xds/types"
 )
 
+func processExtensionPreTranslationHooks(xdsIR *ir.Xds, em *extensionTypes.Manager) (*ir.Xds, error) {
+	// Do nothing unless there is an extension manager
+	if em == nil {
+		return xdsIR, nil
+	}
+
+	// Check if an extension wants to modify the IR before it is translated into xDS resources
+	extManager := *em
+	extTranslationHookClient := extManager.GetPreXDSHookClient(v1alpha1.XDSTranslation)
+	extListenerHookClient := extManager.GetPreXDSHookClient(v1alpha1.XDSHTTPListener)
+	extRouteHookClient := extManager.GetPreXDSHookClient(v1alpha1.XDSRoute)
+	if extTranslationHookClient == nil && extListenerHookClient == nil && extRouteHookClient == nil {
+		return xdsIR, nil
+	}
+
+	// The IR is shared with the other consumers of the xDS IR message, so it must
+	// not be modified in place.
+	xdsIR = xdsIR.DeepCopy()
+
+	// The hooks are executed in a best-effort manner, so the unmodified IR is
+	// kept when an extension fails to modify it.
+	var errs error
+	if extTranslationHookClient != nil {
+		modifiedIR, err := extTranslationHookClient.PreTranslateModifyHook(xdsIR)
+		switch {
+		case err != nil:
+			errs = errors.Join(errs, err)
+		case modifiedIR != nil:
+			if err := modifiedIR.Validate(); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("invalid xds ir returned by the extension: %w", err))
+			} else {
+				xdsIR = modifiedIR
+			}
+		}
+	}
+
+	for listenerIdx, httpListener := range xdsIR.HTTP {
+		if extListenerHookClient != nil {
+			modifiedListener, err := extListenerHookClient.PreHTTPListenerModifyHook(httpListener)
+			switch {
+			case err != nil:
+				errs = errors.Join(errs, err)
+			case modifiedListener != nil:
+				if err := modifiedListener.Validate(); err != nil {
+					errs = errors.Join(errs, fmt.Errorf("invalid listener %s returned by the extension: %w", httpListener.Name, err))
+				} else {
+					xdsIR.HTTP[listenerIdx] = modifiedListener
+					httpListener = modifiedListener
+				}
+			}
+		}
+
+		if extRouteHookClient == nil {
+			continue
+		}
+		for routeIdx, route := range httpListener.Routes {
+			// Only the routes with extension filters are sent to the extension
+			if len(route.ExtensionRefs) == 0 {
+				continue
+			}
+			modifiedRoute, err := extRouteHookClient.PreRouteModifyHook(route, unstructuredObjects(route.ExtensionRefs))
+			switch {
+			case err != nil:
+				errs = errors.Join(errs, err)
+			case modifiedRoute != nil:
+				if err := modifiedRoute.Validate(); err != nil {
+					errs = errors.Join(errs, fmt.Errorf("invalid route %s returned by the extension: %w", route.Name, err))
+				} else {
+					httpListener.Routes[routeIdx] = modifiedRoute
+				}
+			}
+		}
+	}
+
+	return xdsIR, errs
+}
+
 func processExtensionPostRouteHook(route *routev3.Route, vHost *routev3.VirtualHost, irRoute *ir.HTTPRoute, em *extensionTypes.Manager) error {
-	// Do nothing unless there is an extension manager and the ir.HTTPRoute has extension filters
-	if em == nil || len(irRoute.ExtensionRefs) == 0 {
+	// Do nothing unless there is an extension manager and the ir.HTTPRoute has extension filters or policies
+	if em == nil || (len(irRoute.ExtensionRefs) == 0 && len(irRoute.ExtensionPolicies) == 0) {
 		return nil
 	}
 
@@ -39,14 +116,11 @@ func processExtensionPostRouteHook(route *routev3.Route, vHost *routev3.VirtualH
 	if extRouteHookClient == nil {
 		return nil
 	}
-	unstructuredResources := make([]*unstructured.Unstructured, len(irRoute.ExtensionRefs))
-	for refIdx, ref := range irRoute.ExtensionRefs {
-		unstructuredResources[refIdx] = ref.Object
-	}
 	modifiedRoute, err := extRouteHookClient.PostRouteModifyHook(
 		route,
 		vHost.Domains,
-		unstructuredResources,
+		unstructuredObjects(irRoute.ExtensionRefs),
+		unstructuredObjects(irRoute.ExtensionPolicies),
 	)
 	if err != nil {
 		// Maybe logging the error is better here, but this only happens when an extension is in-use
@@ -63,7 +137,44 @@ func processExtensionPostRouteHook(route *routev3.Route, vHost *routev3.VirtualH
 	return nil
 }
 
-func processExtensionPostVHostHook(vHost *routev3.VirtualHost, em *extensionTypes.Manager) error {
+func processExtensionPostClusterHook(cluster *clusterv3.Cluster, irRoute *ir.HTTPRoute, settings []*ir.DestinationSetting, em *extensionTypes.Manager) error {
+	// Do nothing unless there is an extension manager and the cluster was generated for an ir.HTTPRoute with extension filters
+	if em == nil || irRoute == nil || len(irRoute.ExtensionRefs) == 0 {
+		return nil
+	}
+
+	// Check if an extension want to modify the cluster that was just configured/created
+	extManager := *em
+	extClusterHookClient := extManager.GetPostXDSHookClient(v1alpha1.XDSCluster)
+	if extClusterHookClient == nil {
+		return nil
+	}
+	var backendRefs []*ir.BackendRef
+	for _, setting := range settings {
+		if setting.BackendRef != nil {
+			backendRefs = append(backendRefs, setting.BackendRef)
+		}
+	}
+	modifiedCluster, err := extClusterHookClient.PostClusterModifyHook(
+		cluster,
+		irRoute.Name,
+		backendRefs,
+		unstructuredObjects(irRoute.ExtensionRefs),
+	)
+	if err != nil {
+		return err
+	}
+
+	// If the extension returned a modified Cluster, then copy its to the one that was passed in as a reference
+	if modifiedCluster != nil {
+		if err = deepCopyPtr(modifiedCluster, cluster); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func processExtensionPostVHostHook(vHost *routev3.VirtualHost, httpListener *ir.HTTPListener, em *extensionTypes.Manager) error {
 	// Do nothing unless there is an extension manager
 	if em == nil {
 		return nil
@@ -75,7 +186,7 @@ func processExtensionPostVHostHook(vHost *routev3.VirtualHost, em *extensionType
 	if extVHHookClient == nil {
 		return nil
 	}
-	modifiedVH, err := extVHHookClient.PostVirtualHostModifyHook(vHost)
+	modifiedVH, err := extVHHookClient.PostVirtualHostModifyHook(vHost, unstructuredObjects(httpListener.ExtensionPolicies))
 	if err != nil {
 		// Maybe logging the error is better here, but this only happens when an extension is in-use
 		// so if modification fails then we should probably treat that as a serious problem.
@@ -92,7 +203,7 @@ func processExtensionPostVHostHook(vHost *routev3.VirtualHost, em *extensionType
 	return nil
 }
 
-func processExtensionPostListenerHook(tCtx *types.ResourceVersionTable, xdsListener *listenerv3.Listener, em *extensionTypes.Manager) error {
+func processExtensionPostListenerHook(tCtx *types.ResourceVersionTable, xdsListener *listenerv3.Listener, httpListener *ir.HTTPListener, em *extensionTypes.Manager) error {
 	// Do nothing unless there is an extension manager
 	if em == nil {
 		return nil
@@ -102,7 +213,7 @@ func processExtensionPostListenerHook(tCtx *types.ResourceVersionTable, xdsListe
 	extManager := *em
 	extListenerHookClient := extManager.GetPostXDSHookClient(v1alpha1.XDSHTTPListener)
 	if extListenerHookClient != nil {
-		modifiedListener, err := extListenerHookClient.PostHTTPListenerModifyHook(xdsListener)
+		modifiedListener, err := extListenerHookClient.PostHTTPListenerModifyHook(xdsListener, unstructuredObjects(httpListener.ExtensionPolicies))
 		if err != nil {
 			return err
 		} else if modifiedListener != nil {
@@ -194,3 +305,12 @@ func deepCopyPtr(src interface{}, dest interface{}) error {
 	}
 	return nil
 }
+
+// unstructuredObjects returns the objects of the unstructured resources introduced by an extension.
+func unstructuredObjects(refs []*ir.UnstructuredRef) []*unstructured.Unstructured {
+	objects := make([]*unstructured.Unstructured, len(refs))
+	for refIdx, ref := range refs {
+		objects[refIdx] = ref.Object
+	}
+	return objects
+}
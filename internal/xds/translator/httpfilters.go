@@ -105,10 +105,12 @@ func newOrderedHTTPFilter(filter *hcmv3.HttpFilter) *OrderedHTTPFilter {
 		order = 6
 	case filter.Name == extProcFilter:
 		order = 7
-	case filter.Name == localRateLimitFilter:
+	case isFilterType(filter, wasmFilter):
 		order = 8
-	case filter.Name == wellknown.HTTPRateLimit:
+	case filter.Name == localRateLimitFilter:
 		order = 9
+	case filter.Name == wellknown.HTTPRateLimit:
+		order = 10
 	case filter.Name == wellknown.Router:
 		order = 100
 	}
@@ -143,7 +145,9 @@ func sortHTTPFilters(filters []*hcmv3.HttpFilter) []*hcmv3.HttpFilter {
 	for i := 0; i < len(filters); i++ {
 		orderedFilters[i] = newOrderedHTTPFilter(filters[i])
 	}
-	sort.Sort(orderedFilters)
+	// Filters of the same type keep their relative order, e.g. the order in
+	// which the Wasm extensions are defined in a policy.
+	sort.Stable(orderedFilters)
 
 	for i := 0; i < len(filters); i++ {
 		filters[i] = orderedFilters[i].filter
@@ -0,0 +1,22 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package runner
+
+import "github.com/envoyproxy/gateway/internal/metrics"
+
+var (
+	xdsTranslationDurationSeconds = metrics.NewHistogram("xds_translation_duration_seconds", "How long in seconds a translation of an xDS IR into xDS resources takes.", []float64{0.001, 0.01, 0.1, 1, 5, 10})
+
+	xdsTranslationTotal = metrics.NewCounter("xds_translation_total", "Total number of translations of xDS IRs into xDS resources.")
+
+	xdsTranslationErrorsTotal = metrics.NewCounter("xds_translation_errors_total", "Total number of failed translations of xDS IRs into xDS resources.")
+
+	xdsResources = metrics.NewGauge("xds_resources", "Current number of xDS resources translated from an xDS IR.")
+
+	irKeyLabel = metrics.NewLabel("ir_key")
+
+	typeURLLabel = metrics.NewLabel("type_url")
+)
@@ -0,0 +1,158 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package runner
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/status"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func (r *Runner) subscribeAndResolveXdsNACKs(ctx context.Context) {
+	// Subscribe to the xDS responses rejected by the Envoy proxies
+	message.HandleSubscription(message.Metadata{Runner: string(v1alpha1.LogComponentXdsTranslatorRunner), Message: "xds-nack"}, r.XdsNACKs.Subscribe(ctx),
+		func(update message.Update[string, *types.XdsNACK], errChan chan error) {
+			var nack *types.XdsNACK
+			if !update.Delete {
+				// The rejection can't be reported if the xDS IR has been deleted since.
+				if xdsIR, ok := r.XdsIR.Load(update.Key); ok {
+					nack = resolveXdsNACK(update.Value, xdsIR)
+				}
+			}
+			r.publishXdsNACK(update.Key, nack)
+		},
+	)
+	r.Logger.Info("xds nack subscriber shutting down")
+}
+
+// publishXdsNACK publishes the rejection of the xDS resources of the IR key for
+// the Gateways and EnvoyPatchPolicies it was resolved to, and withdraws the
+// rejection previously published for the IR key, if nack is nil.
+func (r *Runner) publishXdsNACK(irKey string, nack *types.XdsNACK) {
+	gateways := sets.New[ktypes.NamespacedName]()
+	policies := sets.New[ktypes.NamespacedName]()
+	if nack != nil {
+		gateways.Insert(nack.Gateways...)
+		policies.Insert(nack.EnvoyPatchPolicies...)
+	}
+
+	r.envoyPatchPolicyStatusesMu.Lock()
+	defer r.envoyPatchPolicyStatusesMu.Unlock()
+
+	// Withdraw the rejection previously published for the IR key
+	for key, prev := range r.ProviderResources.GatewayXdsNACKs.LoadAll() {
+		if prev.IRKey != irKey {
+			continue
+		}
+		if !gateways.Has(key) {
+			r.ProviderResources.GatewayXdsNACKs.Delete(key)
+		}
+		for _, p := range prev.EnvoyPatchPolicies {
+			if policies.Has(p) {
+				continue
+			}
+			if s, ok := r.ProviderResources.EnvoyPatchPolicyStatuses.Load(p); ok && status.ClearRejectedForEnvoyPatchPolicy(s) {
+				r.ProviderResources.EnvoyPatchPolicyStatuses.Store(p, s)
+			}
+		}
+	}
+
+	if nack == nil {
+		return
+	}
+
+	r.Logger.Info("xds resources rejected by envoy", "ir-key", irKey, "node", nack.NodeID,
+		"type", nack.TypeURL, "message", nack.Message)
+	for _, gw := range nack.Gateways {
+		r.ProviderResources.GatewayXdsNACKs.Store(gw, nack)
+	}
+	for _, p := range nack.EnvoyPatchPolicies {
+		if s, ok := r.ProviderResources.EnvoyPatchPolicyStatuses.Load(p); ok {
+			status.SetRejectedForEnvoyPatchPolicy(s, nack.ConditionMessage())
+			r.ProviderResources.EnvoyPatchPolicyStatuses.Store(p, s)
+		}
+	}
+}
+
+// resolveXdsNACK maps the rejected xDS resources back to the Gateways of the xDS IR,
+// and to the HTTPRoutes and EnvoyPatchPolicies whose resources are mentioned by the
+// error message of the Envoy proxy.
+func resolveXdsNACK(nack *types.XdsNACK, xdsIR *ir.Xds) *types.XdsNACK {
+	nack = nack.DeepCopy()
+
+	var listeners []string
+	for _, l := range xdsIR.HTTP {
+		listeners = append(listeners, l.Name)
+	}
+	for _, l := range xdsIR.TCP {
+		listeners = append(listeners, l.Name)
+	}
+	for _, l := range xdsIR.UDP {
+		listeners = append(listeners, l.Name)
+	}
+	gateways := sets.New[ktypes.NamespacedName]()
+	for _, l := range listeners {
+		// The IR listener names are prefixed with the namespace and name of their Gateway
+		if parts := strings.Split(l, "/"); len(parts) >= 3 {
+			gateways.Insert(ktypes.NamespacedName{Namespace: parts[0], Name: parts[1]})
+		}
+	}
+	nack.Gateways = sortedNamespacedNames(gateways)
+
+	routes := sets.New[ktypes.NamespacedName]()
+	for _, l := range xdsIR.HTTP {
+		for _, route := range l.Routes {
+			names := []string{route.Name}
+			// The route destination names are used as cluster names
+			if route.Destination != nil {
+				names = append(names, route.Destination.Name)
+			}
+			for _, name := range names {
+				if name == "" || !strings.Contains(nack.Message, name) {
+					continue
+				}
+				// The IR route names are prefixed with the kind, namespace and name of their route
+				if parts := strings.Split(route.Name, "/"); len(parts) >= 3 && parts[0] == "httproute" {
+					routes.Insert(ktypes.NamespacedName{Namespace: parts[1], Name: parts[2]})
+				}
+			}
+		}
+	}
+	nack.HTTPRoutes = sortedNamespacedNames(routes)
+
+	policies := sets.New[ktypes.NamespacedName]()
+	for _, epp := range xdsIR.EnvoyPatchPolicies {
+		for _, patch := range epp.JSONPatches {
+			if patch.Type == nack.TypeURL && patch.Name != "" && strings.Contains(nack.Message, patch.Name) {
+				policies.Insert(ktypes.NamespacedName{Namespace: epp.Namespace, Name: epp.Name})
+				break
+			}
+		}
+	}
+	nack.EnvoyPatchPolicies = sortedNamespacedNames(policies)
+
+	return nack
+}
+
+func sortedNamespacedNames(s sets.Set[ktypes.NamespacedName]) []ktypes.NamespacedName {
+	if s.Len() == 0 {
+		return nil
+	}
+	res := s.UnsortedList()
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].String() < res[j].String()
+	})
+	return res
+}
@@ -8,7 +8,11 @@ package runner
 import (
 	"context"
 	"reflect"
+	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	ktypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/envoyproxy/gateway/api/v1alpha1"
@@ -17,23 +21,34 @@ import (
 	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/ratelimit"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/supervisor"
+	"github.com/envoyproxy/gateway/internal/tracing"
 	"github.com/envoyproxy/gateway/internal/xds/translator"
+	"github.com/envoyproxy/gateway/internal/xds/types"
 )
 
 type Config struct {
 	config.Server
 	XdsIR             *message.XdsIR
 	Xds               *message.Xds
+	XdsNACKs          *message.XdsNACKs
 	ExtensionManager  extension.Manager
 	ProviderResources *message.ProviderResources
 }
 
 type Runner struct {
 	Config
+
+	// envoyPatchPolicyStatusesMu serializes the updates of the EnvoyPatchPolicy
+	// statuses by the translation and by the resolution of the xDS NACKs, which
+	// both read, modify and write the statuses.
+	envoyPatchPolicyStatusesMu sync.Mutex
 }
 
 func New(cfg *Config) *Runner {
-	return &Runner{Config: *cfg}
+	r := &Runner{Config: *cfg}
+	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
+	return r
 }
 
 func (r *Runner) Name() string {
@@ -42,8 +57,10 @@ func (r *Runner) Name() string {
 
 // Start starts the xds-translator runner
 func (r *Runner) Start(ctx context.Context) (err error) {
-	r.Logger = r.Logger.WithName(r.Name()).WithValues("runner", r.Name())
-	go r.subscribeAndTranslate(ctx)
+	supervisor.Go(ctx, func() { r.subscribeAndTranslate(ctx) })
+	if r.XdsNACKs != nil {
+		supervisor.Go(ctx, func() { r.subscribeAndResolveXdsNACKs(ctx) })
+	}
 	r.Logger.Info("started")
 	return
 }
@@ -55,16 +72,28 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 			r.Logger.Info("received an update")
 			key := update.Key
 			val := update.Value
+			// Continue the trace of the gateway-api translation
+			traceCtx := r.XdsIR.Traces.Extract(ctx, key)
 
 			if update.Delete {
+				if result, ok := r.Xds.Load(key); ok {
+					resetXdsResources(key, result)
+				}
 				r.Xds.Delete(key)
+				if r.XdsNACKs != nil {
+					r.publishXdsNACK(key, nil)
+				}
 			} else {
 				// Translate to xds resources
 				t := &translator.Translator{}
+				traceCtx, span := tracing.Tracer().Start(traceCtx, "xds.Translator.Translate",
+					trace.WithAttributes(attribute.String("ir_key", key)))
 
 				// Set the extension manager if an extension is loaded
+				// The hook calls are traced as part of the translation.
 				if r.ExtensionManager != nil {
-					t.ExtensionManager = &r.ExtensionManager
+					var em extension.Manager = &tracedExtensionManager{Manager: r.ExtensionManager, ctx: traceCtx}
+					t.ExtensionManager = &em
 				}
 
 				// Set the rate limit service URL if global rate limiting is enabled.
@@ -78,9 +107,14 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 					}
 				}
 
+				startTranslationTime := time.Now()
 				result, err := t.Translate(val)
+				xdsTranslationTotal.With(irKeyLabel.Value(key)).Increment()
+				xdsTranslationDurationSeconds.With(irKeyLabel.Value(key)).Record(time.Since(startTranslationTime).Seconds())
+				tracing.End(span, err)
 				if err != nil {
 					r.Logger.Error(err, "failed to translate xds ir")
+					xdsTranslationErrorsTotal.With(irKeyLabel.Value(key)).Increment()
 					errChan <- err
 				}
 
@@ -91,6 +125,9 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 					return
 				}
 
+				r.envoyPatchPolicyStatusesMu.Lock()
+				defer r.envoyPatchPolicyStatusesMu.Unlock()
+
 				// Get all status keys from watchable and save them in the map statusesToDelete.
 				// Iterating through result.EnvoyPatchPolicyStatuses, any valid keys will be removed from statusesToDelete.
 				// Remaining keys will be deleted from watchable before we exit this function.
@@ -117,6 +154,11 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 				result.EnvoyPatchPolicyStatuses = nil
 
 				// Publish
+				if prev, ok := r.Xds.Load(key); ok {
+					resetXdsResources(key, prev)
+				}
+				recordXdsResources(key, result)
+				r.Xds.Traces.Inject(traceCtx, key)
 				r.Xds.Store(key, result)
 
 				// Delete all the deletable status keys
@@ -128,3 +170,17 @@ func (r *Runner) subscribeAndTranslate(ctx context.Context) {
 	)
 	r.Logger.Info("subscriber shutting down")
 }
+
+// recordXdsResources records the number of xDS resources of each type translated from the xDS IR.
+func recordXdsResources(key string, result *types.ResourceVersionTable) {
+	for typeURL, resources := range result.XdsResources {
+		xdsResources.With(irKeyLabel.Value(key), typeURLLabel.Value(typeURL)).Record(float64(len(resources)))
+	}
+}
+
+// resetXdsResources resets the number of xDS resources recorded for the previous translation of the xDS IR.
+func resetXdsResources(key string, result *types.ResourceVersionTable) {
+	for typeURL := range result.XdsResources {
+		xdsResources.With(irKeyLabel.Value(key), typeURLLabel.Value(typeURL)).Record(0)
+	}
+}
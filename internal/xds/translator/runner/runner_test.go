@@ -15,12 +15,24 @@ import (
 	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/envoygateway/config"
 	"github.com/envoyproxy/gateway/internal/extension/types"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/message"
+	"github.com/envoyproxy/gateway/internal/tracing"
+	xdstypes "github.com/envoyproxy/gateway/internal/xds/types"
 )
 
 func TestRunner(t *testing.T) {
@@ -167,6 +179,213 @@ func TestRunner_withExtensionManager(t *testing.T) {
 	}, time.Second*5, time.Millisecond*50)
 }
 
+func TestRunnerTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	// Setup
+	xdsIR := new(message.XdsIR)
+	xds := new(message.Xds)
+	pResource := new(message.ProviderResources)
+
+	cfg, err := config.New()
+	require.NoError(t, err)
+	r := New(&Config{
+		Server:            *cfg,
+		ProviderResources: pResource,
+		XdsIR:             xdsIR,
+		Xds:               xds,
+		ExtensionManager:  &extManagerMock{},
+	})
+
+	ctx := context.Background()
+	// Start
+	err = r.Start(ctx)
+	require.NoError(t, err)
+
+	// The update of the xds IR is part of the trace of the gateway-api translation
+	traceCtx, parent := tracing.Tracer().Start(ctx, "gatewayapi.Translator.Translate")
+	parent.End()
+	xdsIR.Traces.Inject(traceCtx, "test")
+	xdsIR.Store("test", &ir.Xds{
+		HTTP: []*ir.HTTPListener{
+			{
+				Name:      "test",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"example.com"},
+				Routes: []*ir.HTTPRoute{
+					{
+						Name: "test-route",
+						Destination: &ir.RouteDestination{
+							Name: "test-dest",
+							Settings: []*ir.DestinationSetting{
+								{
+									Endpoints: []*ir.DestinationEndpoint{{Host: "10.11.12.13", Port: 8080}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.Eventually(t, func() bool {
+		return len(xds.LoadAll()) == 1
+	}, time.Second*5, time.Millisecond*50)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	translation := spans["xds.Translator.Translate"]
+	require.NotNil(t, translation)
+	require.Equal(t, parent.SpanContext().TraceID(), translation.SpanContext().TraceID())
+	require.Equal(t, parent.SpanContext().SpanID(), translation.Parent().SpanID())
+
+	// The failed extension hook call is a child span of the translation
+	hook := spans["extension.PostHTTPListenerModifyHook"]
+	require.NotNil(t, hook)
+	require.Equal(t, translation.SpanContext().SpanID(), hook.Parent().SpanID())
+	require.Equal(t, codes.Error, hook.Status().Code)
+
+	// The xds resources carry the trace to the xds server
+	sc := trace.SpanContextFromContext(xds.Traces.Extract(ctx, "test"))
+	require.Equal(t, translation.SpanContext().SpanID(), sc.SpanID())
+}
+
+func TestRunnerXdsNACK(t *testing.T) {
+	// Setup
+	xdsIR := new(message.XdsIR)
+	xds := new(message.Xds)
+	xdsNACKs := new(message.XdsNACKs)
+	pResource := new(message.ProviderResources)
+	cfg, err := config.New()
+	require.NoError(t, err)
+	r := New(&Config{
+		Server:            *cfg,
+		ProviderResources: pResource,
+		XdsIR:             xdsIR,
+		Xds:               xds,
+		XdsNACKs:          xdsNACKs,
+	})
+
+	ctx := context.Background()
+	// Start
+	err = r.Start(ctx)
+	require.NoError(t, err)
+
+	path := "example"
+	res := ir.Xds{
+		HTTP: []*ir.HTTPListener{
+			{
+				Name:      "default/eg/http",
+				Address:   "0.0.0.0",
+				Port:      80,
+				Hostnames: []string{"*"},
+				Routes: []*ir.HTTPRoute{
+					{
+						Name:     "httproute/default/backend/rule/0/match/0/*",
+						Hostname: "*",
+						PathMatch: &ir.StringMatch{
+							Exact: &path,
+						},
+						Destination: &ir.RouteDestination{
+							Name: "httproute/default/backend/rule/0",
+							Settings: []*ir.DestinationSetting{
+								{
+									Endpoints: []*ir.DestinationEndpoint{
+										{
+											Host: "10.11.12.13",
+											Port: 8080,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		EnvoyPatchPolicies: []*ir.EnvoyPatchPolicy{
+			{
+				EnvoyPatchPolicyStatus: ir.EnvoyPatchPolicyStatus{
+					Name:      "patch",
+					Namespace: "default",
+					Status: &gwv1a2.PolicyStatus{
+						Ancestors: []gwv1a2.PolicyAncestorStatus{
+							{
+								AncestorRef: gwv1a2.ParentReference{Name: "eg"},
+							},
+						},
+					},
+				},
+				JSONPatches: []*ir.JSONPatchConfig{
+					{
+						Type: resourcev3.ListenerType,
+						Name: "default/eg/http",
+						Operation: ir.JSONPatchOperation{
+							Op:    "add",
+							Path:  "/per_connection_buffer_limit_bytes",
+							Value: &apiextensionsv1.JSON{Raw: []byte("1024")},
+						},
+					},
+				},
+			},
+		},
+	}
+	xdsIR.Store("default/eg", &res)
+	require.Eventually(t, func() bool {
+		_, ok := pResource.EnvoyPatchPolicyStatuses.Load(ktypes.NamespacedName{Namespace: "default", Name: "patch"})
+		return ok
+	}, time.Second*5, time.Millisecond*50)
+
+	// The NACK is resolved to the Gateway, HTTPRoute and EnvoyPatchPolicy
+	xdsNACKs.Store("default/eg", &xdstypes.XdsNACK{
+		IRKey:   "default/eg",
+		NodeID:  "envoy-default-eg",
+		TypeURL: resourcev3.ListenerType,
+		Message: "Error adding/updating listener(s) default/eg/http: invalid cluster httproute/default/backend/rule/0",
+	})
+	gateway := ktypes.NamespacedName{Namespace: "default", Name: "eg"}
+	require.Eventually(t, func() bool {
+		_, ok := pResource.GatewayXdsNACKs.Load(gateway)
+		return ok
+	}, time.Second*5, time.Millisecond*50)
+	nack, _ := pResource.GatewayXdsNACKs.Load(gateway)
+	require.Equal(t, []ktypes.NamespacedName{gateway}, nack.Gateways)
+	require.Equal(t, []ktypes.NamespacedName{{Namespace: "default", Name: "backend"}}, nack.HTTPRoutes)
+	require.Equal(t, []ktypes.NamespacedName{{Namespace: "default", Name: "patch"}}, nack.EnvoyPatchPolicies)
+	require.Equal(t, "Envoy proxy envoy-default-eg rejected the envoy.config.listener.v3.Listener resources: "+
+		"Error adding/updating listener(s) default/eg/http: invalid cluster httproute/default/backend/rule/0. "+
+		"The rejected resources were generated by HTTPRoute default/backend, EnvoyPatchPolicy default/patch.", nack.ConditionMessage())
+	require.Eventually(t, func() bool {
+		return programmedReason(pResource, "patch") == string(v1alpha1.PolicyReasonRejected)
+	}, time.Second*5, time.Millisecond*50)
+
+	// The rejection is withdrawn once the NACK is cleared
+	xdsNACKs.Delete("default/eg")
+	require.Eventually(t, func() bool {
+		return pResource.GatewayXdsNACKs.Len() == 0 &&
+			programmedReason(pResource, "patch") == string(v1alpha1.PolicyReasonProgrammed)
+	}, time.Second*5, time.Millisecond*50)
+}
+
+// programmedReason returns the reason of the Programmed condition of the EnvoyPatchPolicy.
+func programmedReason(pResource *message.ProviderResources, name string) string {
+	s, ok := pResource.EnvoyPatchPolicyStatuses.Load(ktypes.NamespacedName{Namespace: "default", Name: name})
+	if !ok || len(s.Ancestors) == 0 {
+		return ""
+	}
+	for _, c := range s.Ancestors[0].Conditions {
+		if c.Type == string(v1alpha1.PolicyConditionProgrammed) {
+			return c.Reason
+		}
+	}
+	return ""
+}
+
 type extManagerMock struct {
 	types.Manager
 }
@@ -179,10 +398,14 @@ func (m *extManagerMock) GetPostXDSHookClient(xdsHookType v1alpha1.XDSTranslator
 	return nil
 }
 
+func (m *extManagerMock) GetPreXDSHookClient(xdsHookType v1alpha1.XDSTranslatorHook) types.XDSHookClient {
+	return nil
+}
+
 type xdsHookClientMock struct {
 	types.XDSHookClient
 }
 
-func (c *xdsHookClientMock) PostHTTPListenerModifyHook(*listenerv3.Listener) (*listenerv3.Listener, error) {
+func (c *xdsHookClientMock) PostHTTPListenerModifyHook(*listenerv3.Listener, []*unstructured.Unstructured) (*listenerv3.Listener, error) {
 	return nil, fmt.Errorf("assuming a network error during the call")
 }
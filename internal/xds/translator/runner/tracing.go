@@ -0,0 +1,104 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package runner
+
+import (
+	"context"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/envoyproxy/gateway/api/v1alpha1"
+	extension "github.com/envoyproxy/gateway/internal/extension/types"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/tracing"
+)
+
+// tracedExtensionManager returns the hook clients of the extension manager
+// tracing the hook calls as child spans of the xds translation.
+type tracedExtensionManager struct {
+	extension.Manager
+	ctx context.Context
+}
+
+func (m *tracedExtensionManager) GetPreXDSHookClient(xdsHookType v1alpha1.XDSTranslatorHook) extension.XDSHookClient {
+	if client := m.Manager.GetPreXDSHookClient(xdsHookType); client != nil {
+		return &tracedXDSHookClient{client: client, ctx: m.ctx}
+	}
+	return nil
+}
+
+func (m *tracedExtensionManager) GetPostXDSHookClient(xdsHookType v1alpha1.XDSTranslatorHook) extension.XDSHookClient {
+	if client := m.Manager.GetPostXDSHookClient(xdsHookType); client != nil {
+		return &tracedXDSHookClient{client: client, ctx: m.ctx}
+	}
+	return nil
+}
+
+// tracedXDSHookClient traces the calls of the hooks of the client.
+type tracedXDSHookClient struct {
+	client extension.XDSHookClient
+	ctx    context.Context
+}
+
+func (c *tracedXDSHookClient) PostRouteModifyHook(route *route.Route, routeHostnames []string, extensionResources, extensionPolicies []*unstructured.Unstructured) (*route.Route, error) {
+	_, span := tracing.Tracer().Start(c.ctx, "extension.PostRouteModifyHook")
+	modified, err := c.client.PostRouteModifyHook(route, routeHostnames, extensionResources, extensionPolicies)
+	tracing.End(span, err)
+	return modified, err
+}
+
+func (c *tracedXDSHookClient) PostVirtualHostModifyHook(vh *route.VirtualHost, extensionPolicies []*unstructured.Unstructured) (*route.VirtualHost, error) {
+	_, span := tracing.Tracer().Start(c.ctx, "extension.PostVirtualHostModifyHook")
+	modified, err := c.client.PostVirtualHostModifyHook(vh, extensionPolicies)
+	tracing.End(span, err)
+	return modified, err
+}
+
+func (c *tracedXDSHookClient) PostHTTPListenerModifyHook(l *listener.Listener, extensionPolicies []*unstructured.Unstructured) (*listener.Listener, error) {
+	_, span := tracing.Tracer().Start(c.ctx, "extension.PostHTTPListenerModifyHook")
+	modified, err := c.client.PostHTTPListenerModifyHook(l, extensionPolicies)
+	tracing.End(span, err)
+	return modified, err
+}
+
+func (c *tracedXDSHookClient) PostTranslateModifyHook(clusters []*cluster.Cluster, secrets []*tls.Secret) ([]*cluster.Cluster, []*tls.Secret, error) {
+	_, span := tracing.Tracer().Start(c.ctx, "extension.PostTranslateModifyHook")
+	modifiedClusters, modifiedSecrets, err := c.client.PostTranslateModifyHook(clusters, secrets)
+	tracing.End(span, err)
+	return modifiedClusters, modifiedSecrets, err
+}
+
+func (c *tracedXDSHookClient) PostClusterModifyHook(cluster *cluster.Cluster, routeName string, backendRefs []*ir.BackendRef, extensionResources []*unstructured.Unstructured) (*cluster.Cluster, error) {
+	_, span := tracing.Tracer().Start(c.ctx, "extension.PostClusterModifyHook")
+	modified, err := c.client.PostClusterModifyHook(cluster, routeName, backendRefs, extensionResources)
+	tracing.End(span, err)
+	return modified, err
+}
+
+func (c *tracedXDSHookClient) PreTranslateModifyHook(xdsIR *ir.Xds) (*ir.Xds, error) {
+	_, span := tracing.Tracer().Start(c.ctx, "extension.PreTranslateModifyHook")
+	modified, err := c.client.PreTranslateModifyHook(xdsIR)
+	tracing.End(span, err)
+	return modified, err
+}
+
+func (c *tracedXDSHookClient) PreHTTPListenerModifyHook(l *ir.HTTPListener) (*ir.HTTPListener, error) {
+	_, span := tracing.Tracer().Start(c.ctx, "extension.PreHTTPListenerModifyHook")
+	modified, err := c.client.PreHTTPListenerModifyHook(l)
+	tracing.End(span, err)
+	return modified, err
+}
+
+func (c *tracedXDSHookClient) PreRouteModifyHook(route *ir.HTTPRoute, extensionResources []*unstructured.Unstructured) (*ir.HTTPRoute, error) {
+	_, span := tracing.Tracer().Start(c.ctx, "extension.PreRouteModifyHook")
+	modified, err := c.client.PreRouteModifyHook(route, extensionResources)
+	tracing.End(span, err)
+	return modified, err
+}
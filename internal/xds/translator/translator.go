@@ -81,6 +81,14 @@ func (t *Translator) Translate(ir *ir.Xds) (*types.ResourceVersionTable, error)
 	// to fail the entire xDS translation to panic users, but instead, we want
 	// to collect all errors and reflect them in the status of the CRDs.
 	var errs error
+
+	// Check if an extension wants to modify the IR before it is translated
+	// If no extension exists (or it doesn't subscribe to the pre hooks) then this is a quick no-op
+	ir, err := processExtensionPreTranslationHooks(ir, t.ExtensionManager)
+	if err != nil {
+		errs = errors.Join(errs, err)
+	}
+
 	if err := t.processHTTPListenerXdsTranslation(
 		tCtx, ir.HTTP, ir.AccessLog, ir.Tracing, ir.Metrics); err != nil {
 		errs = errors.Join(errs, err)
@@ -284,7 +292,7 @@ func (t *Translator) processHTTPListenerXdsTranslation(
 			vHost.Routes = append(vHost.Routes, xdsRoute)
 
 			if httpRoute.Destination != nil {
-				if err = processXdsCluster(tCtx, httpRoute, httpListener.HTTP1); err != nil {
+				if err = processXdsCluster(tCtx, httpRoute, httpListener.HTTP1, t.ExtensionManager); err != nil {
 					errs = errors.Join(errs, err)
 				}
 			}
@@ -292,10 +300,12 @@ func (t *Translator) processHTTPListenerXdsTranslation(
 			if httpRoute.Mirrors != nil {
 				for _, mirrorDest := range httpRoute.Mirrors {
 					if err := addXdsCluster(tCtx, &xdsClusterArgs{
-						name:         mirrorDest.Name,
-						settings:     mirrorDest.Settings,
-						tSocket:      nil,
-						endpointType: EndpointTypeStatic,
+						name:             mirrorDest.Name,
+						settings:         mirrorDest.Settings,
+						tSocket:          nil,
+						endpointType:     EndpointTypeStatic,
+						route:            httpRoute,
+						extensionManager: t.ExtensionManager,
 					}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 						errs = errors.Join(errs, err)
 					}
@@ -306,7 +316,7 @@ func (t *Translator) processHTTPListenerXdsTranslation(
 		for _, vHost := range vHostsList {
 			// Check if an extension want to modify the Virtual Host we just generated
 			// If no extension exists (or it doesn't subscribe to this hook) then this is a quick no-op.
-			if err := processExtensionPostVHostHook(vHost, t.ExtensionManager); err != nil {
+			if err := processExtensionPostVHostHook(vHost, httpListener, t.ExtensionManager); err != nil {
 				errs = errors.Join(errs, err)
 			}
 		}
@@ -327,7 +337,7 @@ func (t *Translator) processHTTPListenerXdsTranslation(
 
 		// Check if an extension want to modify the listener that was just configured/created
 		// If no extension exists (or it doesn't subscribe to this hook) then this is a quick no-op
-		if err := processExtensionPostListenerHook(tCtx, xdsListener, t.ExtensionManager); err != nil {
+		if err := processExtensionPostListenerHook(tCtx, xdsListener, httpListener, t.ExtensionManager); err != nil {
 			errs = errors.Join(errs, err)
 		}
 	}
@@ -563,19 +573,21 @@ func findXdsEndpoint(tCtx *types.ResourceVersionTable, name string) *endpointv3.
 }
 
 // processXdsCluster processes a xds cluster by its endpoint address type.
-func processXdsCluster(tCtx *types.ResourceVersionTable, httpRoute *ir.HTTPRoute, http1Settings *ir.HTTP1Settings) error {
+func processXdsCluster(tCtx *types.ResourceVersionTable, httpRoute *ir.HTTPRoute, http1Settings *ir.HTTP1Settings, em *extensionTypes.Manager) error {
 	if err := addXdsCluster(tCtx, &xdsClusterArgs{
-		name:           httpRoute.Destination.Name,
-		settings:       httpRoute.Destination.Settings,
-		tSocket:        nil,
-		endpointType:   buildEndpointType(httpRoute.Destination.Settings),
-		loadBalancer:   httpRoute.LoadBalancer,
-		proxyProtocol:  httpRoute.ProxyProtocol,
-		circuitBreaker: httpRoute.CircuitBreaker,
-		healthCheck:    httpRoute.HealthCheck,
-		http1Settings:  http1Settings,
-		timeout:        httpRoute.Timeout,
-		tcpkeepalive:   httpRoute.TCPKeepalive,
+		name:             httpRoute.Destination.Name,
+		settings:         httpRoute.Destination.Settings,
+		tSocket:          nil,
+		endpointType:     buildEndpointType(httpRoute.Destination.Settings),
+		loadBalancer:     httpRoute.LoadBalancer,
+		proxyProtocol:    httpRoute.ProxyProtocol,
+		circuitBreaker:   httpRoute.CircuitBreaker,
+		healthCheck:      httpRoute.HealthCheck,
+		http1Settings:    http1Settings,
+		timeout:          httpRoute.Timeout,
+		tcpkeepalive:     httpRoute.TCPKeepalive,
+		route:            httpRoute,
+		extensionManager: em,
 	}); err != nil && !errors.Is(err, ErrXdsClusterExists) {
 		return err
 	}
@@ -661,10 +673,17 @@ func addXdsCluster(tCtx *types.ResourceVersionTable, args *xdsClusterArgs) error
 	} else {
 		xdsCluster.LoadAssignment = xdsEndpoints
 	}
+
+	// Check if an extension want to modify the cluster we just generated
+	// If no extension exists (or it doesn't subscribe to this hook) then this is a quick no-op.
+	// The cluster is added even if the extension fails to modify it, so that the route
+	// doesn't point to a missing cluster.
+	hookErr := processExtensionPostClusterHook(xdsCluster, args.route, args.settings, args.extensionManager)
+
 	if err := tCtx.AddXdsResource(resourcev3.ClusterType, xdsCluster); err != nil {
 		return err
 	}
-	return nil
+	return hookErr
 }
 
 const (
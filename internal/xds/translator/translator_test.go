@@ -310,6 +310,9 @@ func TestTranslateXds(t *testing.T) {
 		{
 			name: "ext-proc",
 		},
+		{
+			name: "wasm",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -496,6 +499,11 @@ func TestTranslateXdsWithExtension(t *testing.T) {
 			requireSecrets: true,
 			err:            "",
 		},
+		{
+			name:           "http-route-extension-policy",
+			requireSecrets: true,
+			err:            "",
+		},
 		{
 			name:           "http-route-extension-route-error",
 			requireSecrets: true,
@@ -511,6 +519,31 @@ func TestTranslateXdsWithExtension(t *testing.T) {
 			requireSecrets: true,
 			err:            "extension post xds listener hook error",
 		},
+		{
+			name:           "http-route-extension-cluster-error",
+			requireSecrets: true,
+			err:            "cluster hook resource error",
+		},
+		{
+			name:           "http-route-extension-pre-hooks",
+			requireSecrets: true,
+			err:            "",
+		},
+		{
+			name:           "http-route-extension-pre-translation-error",
+			requireSecrets: true,
+			err:            "extension pre translation hook error",
+		},
+		{
+			name:           "http-route-extension-pre-listener-error",
+			requireSecrets: true,
+			err:            "extension pre xds listener hook error",
+		},
+		{
+			name:           "http-route-extension-pre-route-error",
+			requireSecrets: true,
+			err:            "pre route hook resource error",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -534,11 +567,17 @@ func TestTranslateXdsWithExtension(t *testing.T) {
 				},
 				Hooks: &v1alpha1.ExtensionHooks{
 					XDSTranslator: &v1alpha1.XDSTranslatorHooks{
+						Pre: []v1alpha1.XDSTranslatorHook{
+							v1alpha1.XDSRoute,
+							v1alpha1.XDSHTTPListener,
+							v1alpha1.XDSTranslation,
+						},
 						Post: []v1alpha1.XDSTranslatorHook{
 							v1alpha1.XDSRoute,
 							v1alpha1.XDSVirtualHost,
 							v1alpha1.XDSHTTPListener,
 							v1alpha1.XDSTranslation,
+							v1alpha1.XDSCluster,
 						},
 					},
 				},
@@ -546,7 +585,10 @@ func TestTranslateXdsWithExtension(t *testing.T) {
 			extMgr := testutils.NewManager(ext)
 			tr.ExtensionManager = &extMgr
 
+			// The pre hooks must not modify the IR passed to the translator
+			original := ir.DeepCopy()
 			tCtx, err := tr.Translate(ir)
+			require.Equal(t, original, ir)
 
 			if tc.err != "" {
 				require.EqualError(t, err, tc.err)
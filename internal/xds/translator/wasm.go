@@ -0,0 +1,251 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	wasmfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	wasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const (
+	wasmFilter = "envoy.filters.http.wasm"
+	// wasmRuntimeV8 is the Wasm runtime used for all the Wasm extensions.
+	wasmRuntimeV8 = "envoy.wasm.runtime.v8"
+	// wasmHTTPServerCluster is the cluster, defined in the bootstrap, used to
+	// fetch the wasm code served by the Envoy Gateway wasm HTTP server.
+	wasmHTTPServerCluster = "wasm_cluster"
+)
+
+func init() {
+	registerHTTPFilter(&wasm{})
+}
+
+type wasm struct {
+}
+
+var _ httpFilter = &wasm{}
+
+// patchHCM builds and appends the wasm Filters to the HTTP Connection Manager
+// if applicable, and it does not already exist.
+// Note: this method creates a wasm filter for each route that contains a Wasm config.
+// The filter is disabled by default. It is enabled on the route level.
+func (*wasm) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		if !routeContainsWasm(route) {
+			continue
+		}
+
+		for _, w := range route.Wasms {
+			if hcmContainsFilter(mgr, wasmFilterName(w)) {
+				continue
+			}
+
+			filter, err := buildHCMWasmFilter(w)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+
+			mgr.HttpFilters = append(mgr.HttpFilters, filter)
+		}
+	}
+
+	return errs
+}
+
+// buildHCMWasmFilter returns a wasm HTTP filter from the provided IR Wasm.
+func buildHCMWasmFilter(w ir.Wasm) (*hcmv3.HttpFilter, error) {
+	wasmProto, err := wasmConfig(w)
+	if err != nil {
+		return nil, err
+	}
+	if err := wasmProto.ValidateAll(); err != nil {
+		return nil, err
+	}
+
+	wasmAny, err := anypb.New(wasmProto)
+	if err != nil {
+		return nil, err
+	}
+
+	// All wasm filters for all Routes are aggregated on HCM and disabled by default
+	// Per-route config is used to enable the relevant filters on appropriate routes
+	return &hcmv3.HttpFilter{
+		Name:     wasmFilterName(w),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: wasmAny,
+		},
+	}, nil
+}
+
+func wasmFilterName(w ir.Wasm) string {
+	return perRouteFilterName(wasmFilter, w.Name)
+}
+
+func wasmConfig(w ir.Wasm) (*wasmfilterv3.Wasm, error) {
+	if w.Code == nil {
+		return nil, errors.New("wasm code is nil")
+	}
+
+	codeClusterName, err := wasmCodeClusterName(w.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	// The configuration is passed to the Wasm extension as a JSON string.
+	var configuration *anypb.Any
+	if w.Config != nil {
+		if configuration, err = anypb.New(wrapperspb.String(string(w.Config.Raw))); err != nil {
+			return nil, err
+		}
+	}
+
+	return &wasmfilterv3.Wasm{
+		Config: &wasmv3.PluginConfig{
+			Name: w.WasmName,
+			Vm: &wasmv3.PluginConfig_VmConfig{
+				VmConfig: &wasmv3.VmConfig{
+					VmId:    w.Name,
+					Runtime: wasmRuntimeV8,
+					Code: &corev3.AsyncDataSource{
+						Specifier: &corev3.AsyncDataSource_Remote{
+							Remote: &corev3.RemoteDataSource{
+								HttpUri: &corev3.HttpUri{
+									Uri: w.Code.URL,
+									HttpUpstreamType: &corev3.HttpUri_Cluster{
+										Cluster: codeClusterName,
+									},
+									Timeout: &durationpb.Duration{
+										Seconds: defaultExtServiceRequestTimeout,
+									},
+								},
+								Sha256: w.Code.SHA256,
+							},
+						},
+					},
+				},
+			},
+			Configuration: configuration,
+			FailOpen:      w.FailOpen,
+		},
+	}, nil
+}
+
+// wasmCodeClusterName returns the name of the cluster used to fetch the wasm code.
+func wasmCodeClusterName(code *ir.HTTPWasmCode) (string, error) {
+	// The wasm code of OCI images is served by Envoy Gateway.
+	if code.OriginalURL != "" {
+		return wasmHTTPServerCluster, nil
+	}
+
+	codeCluster, err := url2Cluster(code.URL)
+	if err != nil {
+		return "", err
+	}
+	return codeCluster.name, nil
+}
+
+// routeContainsWasm returns true if Wasms exists for the provided route.
+func routeContainsWasm(irRoute *ir.HTTPRoute) bool {
+	if irRoute == nil {
+		return false
+	}
+
+	return len(irRoute.Wasms) > 0
+}
+
+// patchResources creates the clusters used to fetch the wasm code from the
+// provided routes, if needed.
+func (*wasm) patchResources(tCtx *types.ResourceVersionTable,
+	routes []*ir.HTTPRoute) error {
+	if tCtx == nil || tCtx.XdsResources == nil {
+		return errors.New("xds resource table is nil")
+	}
+
+	var errs error
+	for _, route := range routes {
+		if !routeContainsWasm(route) {
+			continue
+		}
+
+		for _, w := range route.Wasms {
+			// The cluster of the Envoy Gateway wasm HTTP server is defined in the bootstrap.
+			if w.Code == nil || w.Code.OriginalURL != "" {
+				continue
+			}
+
+			codeCluster, err := url2Cluster(w.Code.URL)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+
+			clusterArgs := &xdsClusterArgs{
+				name: codeCluster.name,
+				settings: []*ir.DestinationSetting{{
+					Weight:    ptr.To[uint32](1),
+					Endpoints: []*ir.DestinationEndpoint{ir.NewDestEndpoint(codeCluster.hostname, codeCluster.port)},
+				}},
+				endpointType: codeCluster.endpointType,
+			}
+			if codeCluster.tls {
+				tSocket, err := buildXdsUpstreamTLSSocket(codeCluster.hostname)
+				if err != nil {
+					errs = errors.Join(errs, err)
+					continue
+				}
+				clusterArgs.tSocket = tSocket
+			}
+
+			if err = addXdsCluster(tCtx, clusterArgs); err != nil && !errors.Is(err, ErrXdsClusterExists) {
+				errs = errors.Join(errs, err)
+			}
+		}
+	}
+
+	return errs
+}
+
+// patchRoute patches the provided route with the wasm config if applicable.
+// Note: this method enables the corresponding wasm filter for the provided route.
+func (*wasm) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+
+	for _, w := range irRoute.Wasms {
+		if err := enableFilterOnRoute(route, wasmFilterName(w)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	ktypes "k8s.io/apimachinery/pkg/types"
+)
+
+// XdsNACK holds the details of the last xDS response rejected by an Envoy proxy.
+type XdsNACK struct {
+	// IRKey is the key of the xDS IR the rejected resources were translated from.
+	IRKey string
+	// NodeID is the ID of the Envoy proxy which rejected the response.
+	NodeID string
+	// TypeURL is the type URL of the rejected resources.
+	TypeURL string
+	// ResponseNonce is the nonce of the rejected response.
+	ResponseNonce string
+	// ResourceNames are the names of the resources requested by the Envoy proxy.
+	ResourceNames []string
+	// Message is the error message reported by the Envoy proxy.
+	Message string
+	// Gateways are the Gateways serving the rejected resources.
+	Gateways []ktypes.NamespacedName
+	// HTTPRoutes are the HTTPRoutes which generated the rejected resources, when
+	// the error message of the Envoy proxy mentions their resources.
+	HTTPRoutes []ktypes.NamespacedName
+	// EnvoyPatchPolicies are the EnvoyPatchPolicies which patched the rejected
+	// resources, when the error message of the Envoy proxy mentions them.
+	EnvoyPatchPolicies []ktypes.NamespacedName
+}
+
+// DeepCopyInto copies the contents into the output object.
+func (n *XdsNACK) DeepCopyInto(out *XdsNACK) {
+	*out = *n
+	if n.ResourceNames != nil {
+		out.ResourceNames = make([]string, len(n.ResourceNames))
+		copy(out.ResourceNames, n.ResourceNames)
+	}
+	if n.Gateways != nil {
+		out.Gateways = make([]ktypes.NamespacedName, len(n.Gateways))
+		copy(out.Gateways, n.Gateways)
+	}
+	if n.HTTPRoutes != nil {
+		out.HTTPRoutes = make([]ktypes.NamespacedName, len(n.HTTPRoutes))
+		copy(out.HTTPRoutes, n.HTTPRoutes)
+	}
+	if n.EnvoyPatchPolicies != nil {
+		out.EnvoyPatchPolicies = make([]ktypes.NamespacedName, len(n.EnvoyPatchPolicies))
+		copy(out.EnvoyPatchPolicies, n.EnvoyPatchPolicies)
+	}
+}
+
+// DeepCopy generates a deep copy of the XdsNACK object.
+func (n *XdsNACK) DeepCopy() *XdsNACK {
+	if n == nil {
+		return nil
+	}
+	out := new(XdsNACK)
+	n.DeepCopyInto(out)
+	return out
+}
+
+// ConditionMessage returns the message of the status conditions reporting the rejection.
+func (n *XdsNACK) ConditionMessage() string {
+	msg := fmt.Sprintf("Envoy proxy %s rejected the %s resources: %s", n.NodeID, resourceTypeName(n.TypeURL), n.Message)
+	var generatedBy []string
+	for _, r := range n.HTTPRoutes {
+		generatedBy = append(generatedBy, "HTTPRoute "+r.String())
+	}
+	for _, p := range n.EnvoyPatchPolicies {
+		generatedBy = append(generatedBy, "EnvoyPatchPolicy "+p.String())
+	}
+	if len(generatedBy) > 0 {
+		msg += ". The rejected resources were generated by " + strings.Join(generatedBy, ", ")
+	}
+	return msg + "."
+}
+
+// resourceTypeName returns the short name of the resource type of a type URL,
+// e.g. envoy.config.listener.v3.Listener.
+func resourceTypeName(typeURL string) string {
+	return typeURL[strings.LastIndex(typeURL, "/")+1:]
+}
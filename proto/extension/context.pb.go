@@ -37,6 +37,8 @@ type PostRouteExtensionContext struct {
 	ExtensionResources []*ExtensionResource `protobuf:"bytes,1,rep,name=extension_resources,json=extensionResources,proto3" json:"extension_resources,omitempty"`
 	// hostnames are the fully qualified domain names attached to the HTTPRoute
 	Hostnames []string `protobuf:"bytes,2,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
+	// Policies introduced by the extension that target the HTTPRoute/GRPCRoute
+	ExtensionPolicies []*ExtensionResource `protobuf:"bytes,3,rep,name=extension_policies,json=extensionPolicies,proto3" json:"extension_policies,omitempty"`
 }
 
 func (x *PostRouteExtensionContext) Reset() {
@@ -85,13 +87,22 @@ func (x *PostRouteExtensionContext) GetHostnames() []string {
 	return nil
 }
 
-// Empty for now but we can add fields to the context as use-cases are discovered without
-// breaking any clients that use the API
+func (x *PostRouteExtensionContext) GetExtensionPolicies() []*ExtensionResource {
+	if x != nil {
+		return x.ExtensionPolicies
+	}
+	return nil
+}
+
+// PostVirtualHostExtensionContext provides the policies introduced by an extension and watched by Envoy Gateway
 // additional context information can be added to this message as more use-cases are discovered
 type PostVirtualHostExtensionContext struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Policies introduced by the extension that target the Gateway or the Listener of the VirtualHost
+	ExtensionPolicies []*ExtensionResource `protobuf:"bytes,1,rep,name=extension_policies,json=extensionPolicies,proto3" json:"extension_policies,omitempty"`
 }
 
 func (x *PostVirtualHostExtensionContext) Reset() {
@@ -126,13 +137,22 @@ func (*PostVirtualHostExtensionContext) Descriptor() ([]byte, []int) {
 	return file_proto_extension_context_proto_rawDescGZIP(), []int{1}
 }
 
-// Empty for now but we can add fields to the context as use-cases are discovered without
-// breaking any clients that use the API
+func (x *PostVirtualHostExtensionContext) GetExtensionPolicies() []*ExtensionResource {
+	if x != nil {
+		return x.ExtensionPolicies
+	}
+	return nil
+}
+
+// PostHTTPListenerExtensionContext provides the policies introduced by an extension and watched by Envoy Gateway
 // additional context information can be added to this message as more use-cases are discovered
 type PostHTTPListenerExtensionContext struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Policies introduced by the extension that target the Gateway or the Listener
+	ExtensionPolicies []*ExtensionResource `protobuf:"bytes,1,rep,name=extension_policies,json=extensionPolicies,proto3" json:"extension_policies,omitempty"`
 }
 
 func (x *PostHTTPListenerExtensionContext) Reset() {
@@ -167,6 +187,13 @@ func (*PostHTTPListenerExtensionContext) Descriptor() ([]byte, []int) {
 	return file_proto_extension_context_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *PostHTTPListenerExtensionContext) GetExtensionPolicies() []*ExtensionResource {
+	if x != nil {
+		return x.ExtensionPolicies
+	}
+	return nil
+}
+
 // Empty for now but we can add fields to the context as use-cases are discovered without
 // breaking any clients that use the API
 // additional context information can be added to this message as more use-cases are discovered
@@ -208,6 +235,207 @@ func (*PostTranslateExtensionContext) Descriptor() ([]byte, []int) {
 	return file_proto_extension_context_proto_rawDescGZIP(), []int{3}
 }
 
+// PostClusterExtensionContext provides the route and the backends a cluster was generated for, along with the resources
+// introduced by an extension and watched by Envoy Gateway
+// additional context information can be added to this message as more use-cases are discovered
+type PostClusterExtensionContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// route_name is the name of the route the cluster was generated for
+	RouteName string `protobuf:"bytes,1,opt,name=route_name,json=routeName,proto3" json:"route_name,omitempty"`
+	// backend_refs are the backends of the route the cluster was generated for
+	BackendRefs []*BackendRef `protobuf:"bytes,2,rep,name=backend_refs,json=backendRefs,proto3" json:"backend_refs,omitempty"`
+	// Resources introduced by the extension that were used as extensionRefs in an HTTPRoute/GRPCRoute
+	ExtensionResources []*ExtensionResource `protobuf:"bytes,3,rep,name=extension_resources,json=extensionResources,proto3" json:"extension_resources,omitempty"`
+}
+
+func (x *PostClusterExtensionContext) Reset() {
+	*x = PostClusterExtensionContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_context_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostClusterExtensionContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostClusterExtensionContext) ProtoMessage() {}
+
+func (x *PostClusterExtensionContext) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_context_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostClusterExtensionContext.ProtoReflect.Descriptor instead.
+func (*PostClusterExtensionContext) Descriptor() ([]byte, []int) {
+	return file_proto_extension_context_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PostClusterExtensionContext) GetRouteName() string {
+	if x != nil {
+		return x.RouteName
+	}
+	return ""
+}
+
+func (x *PostClusterExtensionContext) GetBackendRefs() []*BackendRef {
+	if x != nil {
+		return x.BackendRefs
+	}
+	return nil
+}
+
+func (x *PostClusterExtensionContext) GetExtensionResources() []*ExtensionResource {
+	if x != nil {
+		return x.ExtensionResources
+	}
+	return nil
+}
+
+// PreRouteExtensionContext provides resources introduced by an extension and watched by Envoy Gateway
+// additional context information can be added to this message as more use-cases are discovered
+type PreRouteExtensionContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resources introduced by the extension that were used as extensionRefs in an HTTPRoute/GRPCRoute
+	ExtensionResources []*ExtensionResource `protobuf:"bytes,1,rep,name=extension_resources,json=extensionResources,proto3" json:"extension_resources,omitempty"`
+}
+
+func (x *PreRouteExtensionContext) Reset() {
+	*x = PreRouteExtensionContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_context_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRouteExtensionContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRouteExtensionContext) ProtoMessage() {}
+
+func (x *PreRouteExtensionContext) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_context_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRouteExtensionContext.ProtoReflect.Descriptor instead.
+func (*PreRouteExtensionContext) Descriptor() ([]byte, []int) {
+	return file_proto_extension_context_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PreRouteExtensionContext) GetExtensionResources() []*ExtensionResource {
+	if x != nil {
+		return x.ExtensionResources
+	}
+	return nil
+}
+
+// Empty for now but we can add fields to the context as use-cases are discovered without
+// breaking any clients that use the API
+// additional context information can be added to this message as more use-cases are discovered
+type PreHTTPListenerExtensionContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PreHTTPListenerExtensionContext) Reset() {
+	*x = PreHTTPListenerExtensionContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_context_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreHTTPListenerExtensionContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreHTTPListenerExtensionContext) ProtoMessage() {}
+
+func (x *PreHTTPListenerExtensionContext) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_context_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreHTTPListenerExtensionContext.ProtoReflect.Descriptor instead.
+func (*PreHTTPListenerExtensionContext) Descriptor() ([]byte, []int) {
+	return file_proto_extension_context_proto_rawDescGZIP(), []int{6}
+}
+
+// Empty for now but we can add fields to the context as use-cases are discovered without
+// breaking any clients that use the API
+// additional context information can be added to this message as more use-cases are discovered
+type PreTranslateExtensionContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PreTranslateExtensionContext) Reset() {
+	*x = PreTranslateExtensionContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_context_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreTranslateExtensionContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreTranslateExtensionContext) ProtoMessage() {}
+
+func (x *PreTranslateExtensionContext) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_context_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreTranslateExtensionContext.ProtoReflect.Descriptor instead.
+func (*PreTranslateExtensionContext) Descriptor() ([]byte, []int) {
+	return file_proto_extension_context_proto_rawDescGZIP(), []int{7}
+}
+
 // ExtensionResource stores the data for a K8s API object referenced in an HTTPRouteFilter
 // extensionRef. It is constructed from an unstructured.Unstructured marshalled to JSON. An extension
 // can marshal the bytes from this resource back into an unstructured.Unstructured and then
@@ -223,7 +451,7 @@ type ExtensionResource struct {
 func (x *ExtensionResource) Reset() {
 	*x = ExtensionResource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_extension_context_proto_msgTypes[4]
+		mi := &file_proto_extension_context_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -236,7 +464,7 @@ func (x *ExtensionResource) String() string {
 func (*ExtensionResource) ProtoMessage() {}
 
 func (x *ExtensionResource) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_extension_context_proto_msgTypes[4]
+	mi := &file_proto_extension_context_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +477,7 @@ func (x *ExtensionResource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionResource.ProtoReflect.Descriptor instead.
 func (*ExtensionResource) Descriptor() ([]byte, []int) {
-	return file_proto_extension_context_proto_rawDescGZIP(), []int{4}
+	return file_proto_extension_context_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ExtensionResource) GetUnstructuredBytes() []byte {
@@ -259,13 +487,93 @@ func (x *ExtensionResource) GetUnstructuredBytes() []byte {
 	return nil
 }
 
+// BackendRef identifies a backend resource referenced by a route
+type BackendRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group     string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind      string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Port      uint32 `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *BackendRef) Reset() {
+	*x = BackendRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_context_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendRef) ProtoMessage() {}
+
+func (x *BackendRef) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_context_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendRef.ProtoReflect.Descriptor instead.
+func (*BackendRef) Descriptor() ([]byte, []int) {
+	return file_proto_extension_context_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BackendRef) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *BackendRef) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BackendRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BackendRef) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BackendRef) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
 var File_proto_extension_context_proto protoreflect.FileDescriptor
 
 var file_proto_extension_context_proto_rawDesc = []byte{
 	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
 	0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x16, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x19, 0x50, 0x6f, 0x73, 0x74,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xef, 0x01, 0x0a, 0x19, 0x50, 0x6f, 0x73, 0x74,
 	0x52, 0x6f, 0x75, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
 	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x5a, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
 	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
@@ -274,20 +582,70 @@ var file_proto_extension_context_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x12, 0x65,
 	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
 	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22,
-	0x21, 0x0a, 0x1f, 0x50, 0x6f, 0x73, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f,
-	0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x22, 0x22, 0x0a, 0x20, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x42, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12,
-	0x75, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x75, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x11, 0x5a, 0x0f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x58, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x1f, 0x50, 0x6f, 0x73,
+	0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x58, 0x0a, 0x12,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x7c, 0x0a, 0x20, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x54,
+	0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x58, 0x0a, 0x12, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x1b, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f,
+	0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x66, 0x52, 0x0b,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x66, 0x73, 0x12, 0x5a, 0x0a, 0x13, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x12, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x76, 0x0a, 0x18, 0x50, 0x72, 0x65, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x5a, 0x0a, 0x13, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x12, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22,
+	0x21, 0x0a, 0x1f, 0x50, 0x72, 0x65, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x50, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x42, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x11, 0x75, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x7c, 0x0a, 0x0a, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x42, 0x11, 0x5a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -302,21 +660,32 @@ func file_proto_extension_context_proto_rawDescGZIP() []byte {
 	return file_proto_extension_context_proto_rawDescData
 }
 
-var file_proto_extension_context_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_extension_context_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_proto_extension_context_proto_goTypes = []interface{}{
 	(*PostRouteExtensionContext)(nil),        // 0: envoygateway.extension.PostRouteExtensionContext
 	(*PostVirtualHostExtensionContext)(nil),  // 1: envoygateway.extension.PostVirtualHostExtensionContext
 	(*PostHTTPListenerExtensionContext)(nil), // 2: envoygateway.extension.PostHTTPListenerExtensionContext
 	(*PostTranslateExtensionContext)(nil),    // 3: envoygateway.extension.PostTranslateExtensionContext
-	(*ExtensionResource)(nil),                // 4: envoygateway.extension.ExtensionResource
+	(*PostClusterExtensionContext)(nil),      // 4: envoygateway.extension.PostClusterExtensionContext
+	(*PreRouteExtensionContext)(nil),         // 5: envoygateway.extension.PreRouteExtensionContext
+	(*PreHTTPListenerExtensionContext)(nil),  // 6: envoygateway.extension.PreHTTPListenerExtensionContext
+	(*PreTranslateExtensionContext)(nil),     // 7: envoygateway.extension.PreTranslateExtensionContext
+	(*ExtensionResource)(nil),                // 8: envoygateway.extension.ExtensionResource
+	(*BackendRef)(nil),                       // 9: envoygateway.extension.BackendRef
 }
 var file_proto_extension_context_proto_depIdxs = []int32{
-	4, // 0: envoygateway.extension.PostRouteExtensionContext.extension_resources:type_name -> envoygateway.extension.ExtensionResource
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8, // 0: envoygateway.extension.PostRouteExtensionContext.extension_resources:type_name -> envoygateway.extension.ExtensionResource
+	8, // 1: envoygateway.extension.PostRouteExtensionContext.extension_policies:type_name -> envoygateway.extension.ExtensionResource
+	8, // 2: envoygateway.extension.PostVirtualHostExtensionContext.extension_policies:type_name -> envoygateway.extension.ExtensionResource
+	8, // 3: envoygateway.extension.PostHTTPListenerExtensionContext.extension_policies:type_name -> envoygateway.extension.ExtensionResource
+	9, // 4: envoygateway.extension.PostClusterExtensionContext.backend_refs:type_name -> envoygateway.extension.BackendRef
+	8, // 5: envoygateway.extension.PostClusterExtensionContext.extension_resources:type_name -> envoygateway.extension.ExtensionResource
+	8, // 6: envoygateway.extension.PreRouteExtensionContext.extension_resources:type_name -> envoygateway.extension.ExtensionResource
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_proto_extension_context_proto_init() }
@@ -374,6 +743,54 @@ func file_proto_extension_context_proto_init() {
 			}
 		}
 		file_proto_extension_context_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostClusterExtensionContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_context_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRouteExtensionContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_context_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreHTTPListenerExtensionContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_context_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreTranslateExtensionContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_context_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExtensionResource); i {
 			case 0:
 				return &v.state
@@ -385,6 +802,18 @@ func file_proto_extension_context_proto_init() {
 				return nil
 			}
 		}
+		file_proto_extension_context_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -392,7 +821,7 @@ func file_proto_extension_context_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_extension_context_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
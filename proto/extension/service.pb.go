@@ -467,6 +467,429 @@ func (x *PostTranslateModifyResponse) GetSecrets() []*v33.Secret {
 	return nil
 }
 
+// PostClusterModifyRequest sends a Cluster that was generated by Envoy Gateway along with context information to an extension so that the Cluster can be modified
+type PostClusterModifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster            *v32.Cluster                 `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	PostClusterContext *PostClusterExtensionContext `protobuf:"bytes,2,opt,name=post_cluster_context,json=postClusterContext,proto3" json:"post_cluster_context,omitempty"`
+}
+
+func (x *PostClusterModifyRequest) Reset() {
+	*x = PostClusterModifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostClusterModifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostClusterModifyRequest) ProtoMessage() {}
+
+func (x *PostClusterModifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostClusterModifyRequest.ProtoReflect.Descriptor instead.
+func (*PostClusterModifyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PostClusterModifyRequest) GetCluster() *v32.Cluster {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (x *PostClusterModifyRequest) GetPostClusterContext() *PostClusterExtensionContext {
+	if x != nil {
+		return x.PostClusterContext
+	}
+	return nil
+}
+
+// PostClusterModifyResponse is the expected response from an extension and contains a modified version of the Cluster that was sent
+// If an extension returns a nil Cluster then it will not be modified
+type PostClusterModifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster *v32.Cluster `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *PostClusterModifyResponse) Reset() {
+	*x = PostClusterModifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostClusterModifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostClusterModifyResponse) ProtoMessage() {}
+
+func (x *PostClusterModifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostClusterModifyResponse.ProtoReflect.Descriptor instead.
+func (*PostClusterModifyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PostClusterModifyResponse) GetCluster() *v32.Cluster {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+// PreTranslateModifyRequest sends the xDS IR of a Gateway, before it is translated into xDS resources, to an extension
+// so that it can be modified. The xDS IR is the JSON encoding of the ir.Xds type of Envoy Gateway.
+type PreTranslateModifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreTranslateContext *PreTranslateExtensionContext `protobuf:"bytes,1,opt,name=pre_translate_context,json=preTranslateContext,proto3" json:"pre_translate_context,omitempty"`
+	XdsIr               []byte                        `protobuf:"bytes,2,opt,name=xds_ir,json=xdsIr,proto3" json:"xds_ir,omitempty"`
+}
+
+func (x *PreTranslateModifyRequest) Reset() {
+	*x = PreTranslateModifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreTranslateModifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreTranslateModifyRequest) ProtoMessage() {}
+
+func (x *PreTranslateModifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreTranslateModifyRequest.ProtoReflect.Descriptor instead.
+func (*PreTranslateModifyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PreTranslateModifyRequest) GetPreTranslateContext() *PreTranslateExtensionContext {
+	if x != nil {
+		return x.PreTranslateContext
+	}
+	return nil
+}
+
+func (x *PreTranslateModifyRequest) GetXdsIr() []byte {
+	if x != nil {
+		return x.XdsIr
+	}
+	return nil
+}
+
+// PreTranslateModifyResponse is the expected response from an extension and contains a modified version of the xDS IR that was sent
+// If an extension returns an empty xDS IR then it will not be modified
+type PreTranslateModifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	XdsIr []byte `protobuf:"bytes,1,opt,name=xds_ir,json=xdsIr,proto3" json:"xds_ir,omitempty"`
+}
+
+func (x *PreTranslateModifyResponse) Reset() {
+	*x = PreTranslateModifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreTranslateModifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreTranslateModifyResponse) ProtoMessage() {}
+
+func (x *PreTranslateModifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreTranslateModifyResponse.ProtoReflect.Descriptor instead.
+func (*PreTranslateModifyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PreTranslateModifyResponse) GetXdsIr() []byte {
+	if x != nil {
+		return x.XdsIr
+	}
+	return nil
+}
+
+// PreHTTPListenerModifyRequest sends the IR of an HTTP listener, before it is translated into xDS resources, to an extension
+// so that it can be modified. The listener is the JSON encoding of the ir.HTTPListener type of Envoy Gateway.
+type PreHTTPListenerModifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listener           []byte                           `protobuf:"bytes,1,opt,name=listener,proto3" json:"listener,omitempty"`
+	PreListenerContext *PreHTTPListenerExtensionContext `protobuf:"bytes,2,opt,name=pre_listener_context,json=preListenerContext,proto3" json:"pre_listener_context,omitempty"`
+}
+
+func (x *PreHTTPListenerModifyRequest) Reset() {
+	*x = PreHTTPListenerModifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreHTTPListenerModifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreHTTPListenerModifyRequest) ProtoMessage() {}
+
+func (x *PreHTTPListenerModifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreHTTPListenerModifyRequest.ProtoReflect.Descriptor instead.
+func (*PreHTTPListenerModifyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PreHTTPListenerModifyRequest) GetListener() []byte {
+	if x != nil {
+		return x.Listener
+	}
+	return nil
+}
+
+func (x *PreHTTPListenerModifyRequest) GetPreListenerContext() *PreHTTPListenerExtensionContext {
+	if x != nil {
+		return x.PreListenerContext
+	}
+	return nil
+}
+
+// PreHTTPListenerModifyResponse is the expected response from an extension and contains a modified version of the listener that was sent
+// If an extension returns an empty listener then it will not be modified
+type PreHTTPListenerModifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listener []byte `protobuf:"bytes,1,opt,name=listener,proto3" json:"listener,omitempty"`
+}
+
+func (x *PreHTTPListenerModifyResponse) Reset() {
+	*x = PreHTTPListenerModifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreHTTPListenerModifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreHTTPListenerModifyResponse) ProtoMessage() {}
+
+func (x *PreHTTPListenerModifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreHTTPListenerModifyResponse.ProtoReflect.Descriptor instead.
+func (*PreHTTPListenerModifyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PreHTTPListenerModifyResponse) GetListener() []byte {
+	if x != nil {
+		return x.Listener
+	}
+	return nil
+}
+
+// PreRouteModifyRequest sends the IR of a route, before it is translated into xDS resources, along with context information
+// to an extension so that it can be modified. The route is the JSON encoding of the ir.HTTPRoute type of Envoy Gateway.
+type PreRouteModifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route           []byte                    `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	PreRouteContext *PreRouteExtensionContext `protobuf:"bytes,2,opt,name=pre_route_context,json=preRouteContext,proto3" json:"pre_route_context,omitempty"`
+}
+
+func (x *PreRouteModifyRequest) Reset() {
+	*x = PreRouteModifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRouteModifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRouteModifyRequest) ProtoMessage() {}
+
+func (x *PreRouteModifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRouteModifyRequest.ProtoReflect.Descriptor instead.
+func (*PreRouteModifyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_extension_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PreRouteModifyRequest) GetRoute() []byte {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *PreRouteModifyRequest) GetPreRouteContext() *PreRouteExtensionContext {
+	if x != nil {
+		return x.PreRouteContext
+	}
+	return nil
+}
+
+// PreRouteModifyResponse is the expected response from an extension and contains a modified version of the route that was sent
+// If an extension returns an empty route then it will not be modified
+type PreRouteModifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route []byte `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+}
+
+func (x *PreRouteModifyResponse) Reset() {
+	*x = PreRouteModifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_extension_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreRouteModifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRouteModifyResponse) ProtoMessage() {}
+
+func (x *PreRouteModifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_extension_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRouteModifyResponse.ProtoReflect.Descriptor instead.
+func (*PreRouteModifyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_extension_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PreRouteModifyResponse) GetRoute() []byte {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
 var File_proto_extension_service_proto protoreflect.FileDescriptor
 
 var file_proto_extension_service_proto_rawDesc = []byte{
@@ -570,7 +993,64 @@ var file_proto_extension_service_proto_rawDesc = []byte{
 	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
 	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2e, 0x74, 0x6c, 0x73,
 	0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x73, 0x32, 0xa5, 0x04, 0x0a, 0x15, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x47, 0x61, 0x74,
+	0x65, 0x74, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x18, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x14,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x12, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x22, 0x57, 0x0a, 0x19, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x9c, 0x01, 0x0a,
+	0x19, 0x50, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x68, 0x0a, 0x15, 0x70, 0x72,
+	0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x13, 0x70, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x78, 0x64, 0x73, 0x5f, 0x69, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x78, 0x64, 0x73, 0x49, 0x72, 0x22, 0x33, 0x0a, 0x1a, 0x50,
+	0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x78, 0x64, 0x73,
+	0x5f, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x78, 0x64, 0x73, 0x49, 0x72,
+	0x22, 0xa5, 0x01, 0x0a, 0x1c, 0x50, 0x72, 0x65, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x69, 0x0a,
+	0x14, 0x70, 0x72, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x52, 0x12, 0x70, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x3b, 0x0a, 0x1d, 0x50, 0x72, 0x65, 0x48,
+	0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x22, 0x2e, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x32, 0x9c, 0x08, 0x0a, 0x15, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x47, 0x61, 0x74,
 	0x65, 0x77, 0x61, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x74, 0x0a,
 	0x0f, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
 	0x12, 0x2e, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
@@ -604,9 +1084,40 @@ var file_proto_extension_service_proto_rawDesc = []byte{
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65,
 	0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6f,
 	0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x11, 0x5a, 0x0f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x11, 0x50,
+	0x6f, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x12, 0x30, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x31, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x48, 0x54,
+	0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x12, 0x34, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x48, 0x54, 0x54,
+	0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x50, 0x72, 0x65, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x71, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x12, 0x2d, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x11, 0x5a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -621,7 +1132,7 @@ func file_proto_extension_service_proto_rawDescGZIP() []byte {
 	return file_proto_extension_service_proto_rawDescData
 }
 
-var file_proto_extension_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_extension_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_proto_extension_service_proto_goTypes = []interface{}{
 	(*PostRouteModifyRequest)(nil),           // 0: envoygateway.extension.PostRouteModifyRequest
 	(*PostRouteModifyResponse)(nil),          // 1: envoygateway.extension.PostRouteModifyResponse
@@ -631,44 +1142,70 @@ var file_proto_extension_service_proto_goTypes = []interface{}{
 	(*PostHTTPListenerModifyResponse)(nil),   // 5: envoygateway.extension.PostHTTPListenerModifyResponse
 	(*PostTranslateModifyRequest)(nil),       // 6: envoygateway.extension.PostTranslateModifyRequest
 	(*PostTranslateModifyResponse)(nil),      // 7: envoygateway.extension.PostTranslateModifyResponse
-	(*v3.Route)(nil),                         // 8: envoy.config.route.v3.Route
-	(*PostRouteExtensionContext)(nil),        // 9: envoygateway.extension.PostRouteExtensionContext
-	(*v3.VirtualHost)(nil),                   // 10: envoy.config.route.v3.VirtualHost
-	(*PostVirtualHostExtensionContext)(nil),  // 11: envoygateway.extension.PostVirtualHostExtensionContext
-	(*v31.Listener)(nil),                     // 12: envoy.config.listener.v3.Listener
-	(*PostHTTPListenerExtensionContext)(nil), // 13: envoygateway.extension.PostHTTPListenerExtensionContext
-	(*PostTranslateExtensionContext)(nil),    // 14: envoygateway.extension.PostTranslateExtensionContext
-	(*v32.Cluster)(nil),                      // 15: envoy.config.cluster.v3.Cluster
-	(*v33.Secret)(nil),                       // 16: envoy.extensions.transport_sockets.tls.v3.Secret
+	(*PostClusterModifyRequest)(nil),         // 8: envoygateway.extension.PostClusterModifyRequest
+	(*PostClusterModifyResponse)(nil),        // 9: envoygateway.extension.PostClusterModifyResponse
+	(*PreTranslateModifyRequest)(nil),        // 10: envoygateway.extension.PreTranslateModifyRequest
+	(*PreTranslateModifyResponse)(nil),       // 11: envoygateway.extension.PreTranslateModifyResponse
+	(*PreHTTPListenerModifyRequest)(nil),     // 12: envoygateway.extension.PreHTTPListenerModifyRequest
+	(*PreHTTPListenerModifyResponse)(nil),    // 13: envoygateway.extension.PreHTTPListenerModifyResponse
+	(*PreRouteModifyRequest)(nil),            // 14: envoygateway.extension.PreRouteModifyRequest
+	(*PreRouteModifyResponse)(nil),           // 15: envoygateway.extension.PreRouteModifyResponse
+	(*v3.Route)(nil),                         // 16: envoy.config.route.v3.Route
+	(*PostRouteExtensionContext)(nil),        // 17: envoygateway.extension.PostRouteExtensionContext
+	(*v3.VirtualHost)(nil),                   // 18: envoy.config.route.v3.VirtualHost
+	(*PostVirtualHostExtensionContext)(nil),  // 19: envoygateway.extension.PostVirtualHostExtensionContext
+	(*v31.Listener)(nil),                     // 20: envoy.config.listener.v3.Listener
+	(*PostHTTPListenerExtensionContext)(nil), // 21: envoygateway.extension.PostHTTPListenerExtensionContext
+	(*PostTranslateExtensionContext)(nil),    // 22: envoygateway.extension.PostTranslateExtensionContext
+	(*v32.Cluster)(nil),                      // 23: envoy.config.cluster.v3.Cluster
+	(*v33.Secret)(nil),                       // 24: envoy.extensions.transport_sockets.tls.v3.Secret
+	(*PostClusterExtensionContext)(nil),      // 25: envoygateway.extension.PostClusterExtensionContext
+	(*PreTranslateExtensionContext)(nil),     // 26: envoygateway.extension.PreTranslateExtensionContext
+	(*PreHTTPListenerExtensionContext)(nil),  // 27: envoygateway.extension.PreHTTPListenerExtensionContext
+	(*PreRouteExtensionContext)(nil),         // 28: envoygateway.extension.PreRouteExtensionContext
 }
 var file_proto_extension_service_proto_depIdxs = []int32{
-	8,  // 0: envoygateway.extension.PostRouteModifyRequest.route:type_name -> envoy.config.route.v3.Route
-	9,  // 1: envoygateway.extension.PostRouteModifyRequest.post_route_context:type_name -> envoygateway.extension.PostRouteExtensionContext
-	8,  // 2: envoygateway.extension.PostRouteModifyResponse.route:type_name -> envoy.config.route.v3.Route
-	10, // 3: envoygateway.extension.PostVirtualHostModifyRequest.virtual_host:type_name -> envoy.config.route.v3.VirtualHost
-	11, // 4: envoygateway.extension.PostVirtualHostModifyRequest.post_virtual_host_context:type_name -> envoygateway.extension.PostVirtualHostExtensionContext
-	10, // 5: envoygateway.extension.PostVirtualHostModifyResponse.virtual_host:type_name -> envoy.config.route.v3.VirtualHost
-	12, // 6: envoygateway.extension.PostHTTPListenerModifyRequest.listener:type_name -> envoy.config.listener.v3.Listener
-	13, // 7: envoygateway.extension.PostHTTPListenerModifyRequest.post_listener_context:type_name -> envoygateway.extension.PostHTTPListenerExtensionContext
-	12, // 8: envoygateway.extension.PostHTTPListenerModifyResponse.listener:type_name -> envoy.config.listener.v3.Listener
-	14, // 9: envoygateway.extension.PostTranslateModifyRequest.post_translate_context:type_name -> envoygateway.extension.PostTranslateExtensionContext
-	15, // 10: envoygateway.extension.PostTranslateModifyRequest.clusters:type_name -> envoy.config.cluster.v3.Cluster
-	16, // 11: envoygateway.extension.PostTranslateModifyRequest.secrets:type_name -> envoy.extensions.transport_sockets.tls.v3.Secret
-	15, // 12: envoygateway.extension.PostTranslateModifyResponse.clusters:type_name -> envoy.config.cluster.v3.Cluster
-	16, // 13: envoygateway.extension.PostTranslateModifyResponse.secrets:type_name -> envoy.extensions.transport_sockets.tls.v3.Secret
-	0,  // 14: envoygateway.extension.EnvoyGatewayExtension.PostRouteModify:input_type -> envoygateway.extension.PostRouteModifyRequest
-	2,  // 15: envoygateway.extension.EnvoyGatewayExtension.PostVirtualHostModify:input_type -> envoygateway.extension.PostVirtualHostModifyRequest
-	4,  // 16: envoygateway.extension.EnvoyGatewayExtension.PostHTTPListenerModify:input_type -> envoygateway.extension.PostHTTPListenerModifyRequest
-	6,  // 17: envoygateway.extension.EnvoyGatewayExtension.PostTranslateModify:input_type -> envoygateway.extension.PostTranslateModifyRequest
-	1,  // 18: envoygateway.extension.EnvoyGatewayExtension.PostRouteModify:output_type -> envoygateway.extension.PostRouteModifyResponse
-	3,  // 19: envoygateway.extension.EnvoyGatewayExtension.PostVirtualHostModify:output_type -> envoygateway.extension.PostVirtualHostModifyResponse
-	5,  // 20: envoygateway.extension.EnvoyGatewayExtension.PostHTTPListenerModify:output_type -> envoygateway.extension.PostHTTPListenerModifyResponse
-	7,  // 21: envoygateway.extension.EnvoyGatewayExtension.PostTranslateModify:output_type -> envoygateway.extension.PostTranslateModifyResponse
-	18, // [18:22] is the sub-list for method output_type
-	14, // [14:18] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	16, // 0: envoygateway.extension.PostRouteModifyRequest.route:type_name -> envoy.config.route.v3.Route
+	17, // 1: envoygateway.extension.PostRouteModifyRequest.post_route_context:type_name -> envoygateway.extension.PostRouteExtensionContext
+	16, // 2: envoygateway.extension.PostRouteModifyResponse.route:type_name -> envoy.config.route.v3.Route
+	18, // 3: envoygateway.extension.PostVirtualHostModifyRequest.virtual_host:type_name -> envoy.config.route.v3.VirtualHost
+	19, // 4: envoygateway.extension.PostVirtualHostModifyRequest.post_virtual_host_context:type_name -> envoygateway.extension.PostVirtualHostExtensionContext
+	18, // 5: envoygateway.extension.PostVirtualHostModifyResponse.virtual_host:type_name -> envoy.config.route.v3.VirtualHost
+	20, // 6: envoygateway.extension.PostHTTPListenerModifyRequest.listener:type_name -> envoy.config.listener.v3.Listener
+	21, // 7: envoygateway.extension.PostHTTPListenerModifyRequest.post_listener_context:type_name -> envoygateway.extension.PostHTTPListenerExtensionContext
+	20, // 8: envoygateway.extension.PostHTTPListenerModifyResponse.listener:type_name -> envoy.config.listener.v3.Listener
+	22, // 9: envoygateway.extension.PostTranslateModifyRequest.post_translate_context:type_name -> envoygateway.extension.PostTranslateExtensionContext
+	23, // 10: envoygateway.extension.PostTranslateModifyRequest.clusters:type_name -> envoy.config.cluster.v3.Cluster
+	24, // 11: envoygateway.extension.PostTranslateModifyRequest.secrets:type_name -> envoy.extensions.transport_sockets.tls.v3.Secret
+	23, // 12: envoygateway.extension.PostTranslateModifyResponse.clusters:type_name -> envoy.config.cluster.v3.Cluster
+	24, // 13: envoygateway.extension.PostTranslateModifyResponse.secrets:type_name -> envoy.extensions.transport_sockets.tls.v3.Secret
+	23, // 14: envoygateway.extension.PostClusterModifyRequest.cluster:type_name -> envoy.config.cluster.v3.Cluster
+	25, // 15: envoygateway.extension.PostClusterModifyRequest.post_cluster_context:type_name -> envoygateway.extension.PostClusterExtensionContext
+	23, // 16: envoygateway.extension.PostClusterModifyResponse.cluster:type_name -> envoy.config.cluster.v3.Cluster
+	26, // 17: envoygateway.extension.PreTranslateModifyRequest.pre_translate_context:type_name -> envoygateway.extension.PreTranslateExtensionContext
+	27, // 18: envoygateway.extension.PreHTTPListenerModifyRequest.pre_listener_context:type_name -> envoygateway.extension.PreHTTPListenerExtensionContext
+	28, // 19: envoygateway.extension.PreRouteModifyRequest.pre_route_context:type_name -> envoygateway.extension.PreRouteExtensionContext
+	0,  // 20: envoygateway.extension.EnvoyGatewayExtension.PostRouteModify:input_type -> envoygateway.extension.PostRouteModifyRequest
+	2,  // 21: envoygateway.extension.EnvoyGatewayExtension.PostVirtualHostModify:input_type -> envoygateway.extension.PostVirtualHostModifyRequest
+	4,  // 22: envoygateway.extension.EnvoyGatewayExtension.PostHTTPListenerModify:input_type -> envoygateway.extension.PostHTTPListenerModifyRequest
+	6,  // 23: envoygateway.extension.EnvoyGatewayExtension.PostTranslateModify:input_type -> envoygateway.extension.PostTranslateModifyRequest
+	8,  // 24: envoygateway.extension.EnvoyGatewayExtension.PostClusterModify:input_type -> envoygateway.extension.PostClusterModifyRequest
+	10, // 25: envoygateway.extension.EnvoyGatewayExtension.PreTranslateModify:input_type -> envoygateway.extension.PreTranslateModifyRequest
+	12, // 26: envoygateway.extension.EnvoyGatewayExtension.PreHTTPListenerModify:input_type -> envoygateway.extension.PreHTTPListenerModifyRequest
+	14, // 27: envoygateway.extension.EnvoyGatewayExtension.PreRouteModify:input_type -> envoygateway.extension.PreRouteModifyRequest
+	1,  // 28: envoygateway.extension.EnvoyGatewayExtension.PostRouteModify:output_type -> envoygateway.extension.PostRouteModifyResponse
+	3,  // 29: envoygateway.extension.EnvoyGatewayExtension.PostVirtualHostModify:output_type -> envoygateway.extension.PostVirtualHostModifyResponse
+	5,  // 30: envoygateway.extension.EnvoyGatewayExtension.PostHTTPListenerModify:output_type -> envoygateway.extension.PostHTTPListenerModifyResponse
+	7,  // 31: envoygateway.extension.EnvoyGatewayExtension.PostTranslateModify:output_type -> envoygateway.extension.PostTranslateModifyResponse
+	9,  // 32: envoygateway.extension.EnvoyGatewayExtension.PostClusterModify:output_type -> envoygateway.extension.PostClusterModifyResponse
+	11, // 33: envoygateway.extension.EnvoyGatewayExtension.PreTranslateModify:output_type -> envoygateway.extension.PreTranslateModifyResponse
+	13, // 34: envoygateway.extension.EnvoyGatewayExtension.PreHTTPListenerModify:output_type -> envoygateway.extension.PreHTTPListenerModifyResponse
+	15, // 35: envoygateway.extension.EnvoyGatewayExtension.PreRouteModify:output_type -> envoygateway.extension.PreRouteModifyResponse
+	28, // [28:36] is the sub-list for method output_type
+	20, // [20:28] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_proto_extension_service_proto_init() }
@@ -774,6 +1311,102 @@ func file_proto_extension_service_proto_init() {
 				return nil
 			}
 		}
+		file_proto_extension_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostClusterModifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostClusterModifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreTranslateModifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreTranslateModifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreHTTPListenerModifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreHTTPListenerModifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRouteModifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_extension_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreRouteModifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -781,7 +1414,7 @@ func file_proto_extension_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_extension_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
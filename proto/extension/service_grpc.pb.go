@@ -29,6 +29,10 @@ const (
 	EnvoyGatewayExtension_PostVirtualHostModify_FullMethodName  = "/envoygateway.extension.EnvoyGatewayExtension/PostVirtualHostModify"
 	EnvoyGatewayExtension_PostHTTPListenerModify_FullMethodName = "/envoygateway.extension.EnvoyGatewayExtension/PostHTTPListenerModify"
 	EnvoyGatewayExtension_PostTranslateModify_FullMethodName    = "/envoygateway.extension.EnvoyGatewayExtension/PostTranslateModify"
+	EnvoyGatewayExtension_PostClusterModify_FullMethodName      = "/envoygateway.extension.EnvoyGatewayExtension/PostClusterModify"
+	EnvoyGatewayExtension_PreTranslateModify_FullMethodName     = "/envoygateway.extension.EnvoyGatewayExtension/PreTranslateModify"
+	EnvoyGatewayExtension_PreHTTPListenerModify_FullMethodName  = "/envoygateway.extension.EnvoyGatewayExtension/PreHTTPListenerModify"
+	EnvoyGatewayExtension_PreRouteModify_FullMethodName         = "/envoygateway.extension.EnvoyGatewayExtension/PreRouteModify"
 )
 
 // EnvoyGatewayExtensionClient is the client API for EnvoyGatewayExtension service.
@@ -60,6 +64,30 @@ type EnvoyGatewayExtensionClient interface {
 	// The list of clusters and secrets returned by the extension are used as the final list of all clusters and secrets
 	// PostTranslateModify is always executed when an extension is loaded
 	PostTranslateModify(ctx context.Context, in *PostTranslateModifyRequest, opts ...grpc.CallOption) (*PostTranslateModifyResponse, error)
+	// PostClusterModify allows an extension to modify a cluster generated by Envoy Gateway for the backends of a route before it is finalized.
+	// Doing so allows extensions to configure per-backend settings, such as transport sockets or load balancing metadata.
+	// PostClusterModify also passes the name of the route, the backendRefs of the cluster and a list of Unstructured data for
+	// the externalRefs owned by the extension on the HTTPRoute that created this cluster.
+	// PostClusterModify will only be executed if an extension is loaded and only on clusters which were generated from an HTTPRoute
+	// that uses extension resources as externalRef filters. An extension may return nil in order to not make any changes to it.
+	PostClusterModify(ctx context.Context, in *PostClusterModifyRequest, opts ...grpc.CallOption) (*PostClusterModifyResponse, error)
+	// PreTranslateModify allows an extension to modify the xDS IR before it is translated into xDS resources by Envoy Gateway.
+	// The xDS IR of a Gateway holds all its listeners, routes, and global settings such as access logging and tracing.
+	// The xDS IR returned by the extension is used as the input of the xDS translation.
+	// PreTranslateModify is always executed when an extension is loaded. An extension may return nil
+	// in order to not make any changes to it.
+	PreTranslateModify(ctx context.Context, in *PreTranslateModifyRequest, opts ...grpc.CallOption) (*PreTranslateModifyResponse, error)
+	// PreHTTPListenerModify allows an extension to modify the IR of an HTTP listener, along with its routes,
+	// before it is translated into xDS resources by Envoy Gateway.
+	// PreHTTPListenerModify is always executed when an extension is loaded. An extension may return nil
+	// in order to not make any changes to it.
+	PreHTTPListenerModify(ctx context.Context, in *PreHTTPListenerModifyRequest, opts ...grpc.CallOption) (*PreHTTPListenerModifyResponse, error)
+	// PreRouteModify allows an extension to modify the IR of a route before it is translated into xDS resources by Envoy Gateway.
+	// PreRouteModify also passes a list of Unstructured data for the externalRefs owned by the extension on the HTTPRoute that
+	// created this route.
+	// PreRouteModify will only be executed if an extension is loaded and only on routes which were generated from an HTTPRoute
+	// that uses extension resources as externalRef filters. An extension may return nil in order to not make any changes to it.
+	PreRouteModify(ctx context.Context, in *PreRouteModifyRequest, opts ...grpc.CallOption) (*PreRouteModifyResponse, error)
 }
 
 type envoyGatewayExtensionClient struct {
@@ -106,6 +134,42 @@ func (c *envoyGatewayExtensionClient) PostTranslateModify(ctx context.Context, i
 	return out, nil
 }
 
+func (c *envoyGatewayExtensionClient) PostClusterModify(ctx context.Context, in *PostClusterModifyRequest, opts ...grpc.CallOption) (*PostClusterModifyResponse, error) {
+	out := new(PostClusterModifyResponse)
+	err := c.cc.Invoke(ctx, EnvoyGatewayExtension_PostClusterModify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyGatewayExtensionClient) PreTranslateModify(ctx context.Context, in *PreTranslateModifyRequest, opts ...grpc.CallOption) (*PreTranslateModifyResponse, error) {
+	out := new(PreTranslateModifyResponse)
+	err := c.cc.Invoke(ctx, EnvoyGatewayExtension_PreTranslateModify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyGatewayExtensionClient) PreHTTPListenerModify(ctx context.Context, in *PreHTTPListenerModifyRequest, opts ...grpc.CallOption) (*PreHTTPListenerModifyResponse, error) {
+	out := new(PreHTTPListenerModifyResponse)
+	err := c.cc.Invoke(ctx, EnvoyGatewayExtension_PreHTTPListenerModify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyGatewayExtensionClient) PreRouteModify(ctx context.Context, in *PreRouteModifyRequest, opts ...grpc.CallOption) (*PreRouteModifyResponse, error) {
+	out := new(PreRouteModifyResponse)
+	err := c.cc.Invoke(ctx, EnvoyGatewayExtension_PreRouteModify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnvoyGatewayExtensionServer is the server API for EnvoyGatewayExtension service.
 // All implementations must embed UnimplementedEnvoyGatewayExtensionServer
 // for forward compatibility
@@ -135,6 +199,30 @@ type EnvoyGatewayExtensionServer interface {
 	// The list of clusters and secrets returned by the extension are used as the final list of all clusters and secrets
 	// PostTranslateModify is always executed when an extension is loaded
 	PostTranslateModify(context.Context, *PostTranslateModifyRequest) (*PostTranslateModifyResponse, error)
+	// PostClusterModify allows an extension to modify a cluster generated by Envoy Gateway for the backends of a route before it is finalized.
+	// Doing so allows extensions to configure per-backend settings, such as transport sockets or load balancing metadata.
+	// PostClusterModify also passes the name of the route, the backendRefs of the cluster and a list of Unstructured data for
+	// the externalRefs owned by the extension on the HTTPRoute that created this cluster.
+	// PostClusterModify will only be executed if an extension is loaded and only on clusters which were generated from an HTTPRoute
+	// that uses extension resources as externalRef filters. An extension may return nil in order to not make any changes to it.
+	PostClusterModify(context.Context, *PostClusterModifyRequest) (*PostClusterModifyResponse, error)
+	// PreTranslateModify allows an extension to modify the xDS IR before it is translated into xDS resources by Envoy Gateway.
+	// The xDS IR of a Gateway holds all its listeners, routes, and global settings such as access logging and tracing.
+	// The xDS IR returned by the extension is used as the input of the xDS translation.
+	// PreTranslateModify is always executed when an extension is loaded. An extension may return nil
+	// in order to not make any changes to it.
+	PreTranslateModify(context.Context, *PreTranslateModifyRequest) (*PreTranslateModifyResponse, error)
+	// PreHTTPListenerModify allows an extension to modify the IR of an HTTP listener, along with its routes,
+	// before it is translated into xDS resources by Envoy Gateway.
+	// PreHTTPListenerModify is always executed when an extension is loaded. An extension may return nil
+	// in order to not make any changes to it.
+	PreHTTPListenerModify(context.Context, *PreHTTPListenerModifyRequest) (*PreHTTPListenerModifyResponse, error)
+	// PreRouteModify allows an extension to modify the IR of a route before it is translated into xDS resources by Envoy Gateway.
+	// PreRouteModify also passes a list of Unstructured data for the externalRefs owned by the extension on the HTTPRoute that
+	// created this route.
+	// PreRouteModify will only be executed if an extension is loaded and only on routes which were generated from an HTTPRoute
+	// that uses extension resources as externalRef filters. An extension may return nil in order to not make any changes to it.
+	PreRouteModify(context.Context, *PreRouteModifyRequest) (*PreRouteModifyResponse, error)
 	mustEmbedUnimplementedEnvoyGatewayExtensionServer()
 }
 
@@ -154,6 +242,18 @@ func (UnimplementedEnvoyGatewayExtensionServer) PostHTTPListenerModify(context.C
 func (UnimplementedEnvoyGatewayExtensionServer) PostTranslateModify(context.Context, *PostTranslateModifyRequest) (*PostTranslateModifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostTranslateModify not implemented")
 }
+func (UnimplementedEnvoyGatewayExtensionServer) PostClusterModify(context.Context, *PostClusterModifyRequest) (*PostClusterModifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostClusterModify not implemented")
+}
+func (UnimplementedEnvoyGatewayExtensionServer) PreTranslateModify(context.Context, *PreTranslateModifyRequest) (*PreTranslateModifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreTranslateModify not implemented")
+}
+func (UnimplementedEnvoyGatewayExtensionServer) PreHTTPListenerModify(context.Context, *PreHTTPListenerModifyRequest) (*PreHTTPListenerModifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreHTTPListenerModify not implemented")
+}
+func (UnimplementedEnvoyGatewayExtensionServer) PreRouteModify(context.Context, *PreRouteModifyRequest) (*PreRouteModifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreRouteModify not implemented")
+}
 func (UnimplementedEnvoyGatewayExtensionServer) mustEmbedUnimplementedEnvoyGatewayExtensionServer() {}
 
 // UnsafeEnvoyGatewayExtensionServer may be embedded to opt out of forward compatibility for this service.
@@ -239,6 +339,78 @@ func _EnvoyGatewayExtension_PostTranslateModify_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnvoyGatewayExtension_PostClusterModify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostClusterModifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyGatewayExtensionServer).PostClusterModify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyGatewayExtension_PostClusterModify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyGatewayExtensionServer).PostClusterModify(ctx, req.(*PostClusterModifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyGatewayExtension_PreTranslateModify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreTranslateModifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyGatewayExtensionServer).PreTranslateModify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyGatewayExtension_PreTranslateModify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyGatewayExtensionServer).PreTranslateModify(ctx, req.(*PreTranslateModifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyGatewayExtension_PreHTTPListenerModify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreHTTPListenerModifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyGatewayExtensionServer).PreHTTPListenerModify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyGatewayExtension_PreHTTPListenerModify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyGatewayExtensionServer).PreHTTPListenerModify(ctx, req.(*PreHTTPListenerModifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyGatewayExtension_PreRouteModify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreRouteModifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyGatewayExtensionServer).PreRouteModify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyGatewayExtension_PreRouteModify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyGatewayExtensionServer).PreRouteModify(ctx, req.(*PreRouteModifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnvoyGatewayExtension_ServiceDesc is the grpc.ServiceDesc for EnvoyGatewayExtension service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -262,6 +434,22 @@ var EnvoyGatewayExtension_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PostTranslateModify",
 			Handler:    _EnvoyGatewayExtension_PostTranslateModify_Handler,
 		},
+		{
+			MethodName: "PostClusterModify",
+			Handler:    _EnvoyGatewayExtension_PostClusterModify_Handler,
+		},
+		{
+			MethodName: "PreTranslateModify",
+			Handler:    _EnvoyGatewayExtension_PreTranslateModify_Handler,
+		},
+		{
+			MethodName: "PreHTTPListenerModify",
+			Handler:    _EnvoyGatewayExtension_PreHTTPListenerModify_Handler,
+		},
+		{
+			MethodName: "PreRouteModify",
+			Handler:    _EnvoyGatewayExtension_PreRouteModify_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/extension/service.proto",
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -220,6 +221,28 @@ func TestEnvoyProxyProvider(t *testing.T) {
 			},
 			wantErrors: []string{"loadBalancerIP can only be set for LoadBalancer type"},
 		},
+		{
+			desc: "EnvoyAdditionalServices-ServiceTypeClusterIP-with-LoadBalancerIP",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyAdditionalServices: []egv1a1.KubernetesAdditionalServiceSpec{
+								{
+									Name: "internal",
+									KubernetesServiceSpec: egv1a1.KubernetesServiceSpec{
+										Type:           ptr.To(egv1a1.ServiceTypeClusterIP),
+										LoadBalancerIP: ptr.To("20.205.243.166"), // github ip for test only
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"loadBalancerIP can only be set for LoadBalancer type"},
+		},
 		{
 			desc: "invalid-ProxyAccessLogFormat",
 			mutate: func(envoy *egv1a1.EnvoyProxy) {
@@ -765,6 +788,99 @@ func TestEnvoyProxyProvider(t *testing.T) {
 			},
 			wantErrors: []string{},
 		},
+		{
+			desc: "ProxyDaemonSet-valid",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet: &egv1a1.KubernetesDaemonSetSpec{},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "ProxyDaemonSet-with-deployment",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDeployment: &egv1a1.KubernetesDeploymentSpec{},
+							EnvoyDaemonSet:  &egv1a1.KubernetesDaemonSetSpec{},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"only one of envoyDeployment or envoyDaemonSet can be specified"},
+		},
+		{
+			desc: "ProxyDaemonSet-with-hpa",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet: &egv1a1.KubernetesDaemonSetSpec{},
+							EnvoyHpa: &egv1a1.KubernetesHorizontalPodAutoscalerSpec{
+								MaxReplicas: ptr.To[int32](2),
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"envoyHpa can only be specified with envoyDeployment"},
+		},
+		{
+			desc: "ProxyPDB-valid",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyPDB: &egv1a1.KubernetesPodDisruptionBudgetSpec{
+								MinAvailable: ptr.To(intstr.FromInt32(1)),
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "ProxyPDB-empty",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyPDB: &egv1a1.KubernetesPodDisruptionBudgetSpec{},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"exactly one of minAvailable or maxUnavailable must be specified"},
+		},
+		{
+			desc: "ProxyPDB-with-daemonset",
+			mutate: func(envoy *egv1a1.EnvoyProxy) {
+				envoy.Spec = egv1a1.EnvoyProxySpec{
+					Provider: &egv1a1.EnvoyProxyProvider{
+						Type: egv1a1.ProviderTypeKubernetes,
+						Kubernetes: &egv1a1.EnvoyProxyKubernetesProvider{
+							EnvoyDaemonSet: &egv1a1.KubernetesDaemonSetSpec{},
+							EnvoyPDB: &egv1a1.KubernetesPodDisruptionBudgetSpec{
+								MaxUnavailable: ptr.To(intstr.FromString("50%")),
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"envoyPDB can only be specified with envoyDeployment"},
+		},
 		{
 			desc: "ProxyHpa-valid",
 			mutate: func(envoy *egv1a1.EnvoyProxy) {
@@ -13,15 +13,18 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1alpha3"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/gateway-api/conformance/tests"
 	"sigs.k8s.io/gateway-api/conformance/utils/flags"
 	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
 )
 
 func TestGatewayAPIConformance(t *testing.T) {
@@ -37,22 +40,24 @@ func TestGatewayAPIConformance(t *testing.T) {
 	require.NoError(t, err)
 
 	require.NoError(t, v1alpha2.AddToScheme(client.Scheme()))
+	require.NoError(t, v1alpha3.AddToScheme(client.Scheme()))
 	require.NoError(t, v1beta1.AddToScheme(client.Scheme()))
 	require.NoError(t, v1.AddToScheme(client.Scheme()))
+	require.NoError(t, apiextensionsv1.AddToScheme(client.Scheme()))
 
-	cSuite := suite.New(suite.Options{
+	cSuite, err := suite.NewConformanceTestSuite(suite.ConformanceOptions{
 		Client:               client,
 		GatewayClassName:     *flags.GatewayClassName,
 		Debug:                *flags.ShowDebug,
 		Clientset:            clientset,
 		CleanupBaseResources: *flags.CleanupBaseResources,
-		SupportedFeatures:    suite.AllFeatures,
+		SupportedFeatures:    features.AllFeatures,
 		SkipTests: []string{
 			tests.GatewayStaticAddresses.ShortName,
 		},
-		ExemptFeatures: suite.MeshCoreFeatures,
+		ExemptFeatures: features.MeshCoreFeatures,
 	})
-	cSuite.Setup(t)
-	cSuite.Run(t, tests.ConformanceTests)
-
+	require.NoError(t, err)
+	cSuite.Setup(t, tests.ConformanceTests)
+	require.NoError(t, cSuite.Run(t, tests.ConformanceTests))
 }
@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -22,18 +23,20 @@ import (
 
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1alpha3"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
-	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+	confv1 "sigs.k8s.io/gateway-api/conformance/apis/v1"
 	"sigs.k8s.io/gateway-api/conformance/tests"
 	"sigs.k8s.io/gateway-api/conformance/utils/flags"
 	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
 )
 
 var (
 	cfg                 *rest.Config
 	k8sClientset        *kubernetes.Clientset
 	mgrClient           client.Client
-	implementation      *confv1a1.Implementation
+	implementation      confv1.Implementation
 	conformanceProfiles sets.Set[suite.ConformanceProfileName]
 )
 
@@ -54,28 +57,29 @@ func TestExperimentalConformance(t *testing.T) {
 
 	err = v1alpha2.AddToScheme(mgrClient.Scheme())
 	require.NoError(t, err)
+	err = v1alpha3.AddToScheme(mgrClient.Scheme())
+	require.NoError(t, err)
 	err = v1beta1.AddToScheme(mgrClient.Scheme())
 	require.NoError(t, err)
 	err = v1.AddToScheme(mgrClient.Scheme())
 	require.NoError(t, err)
+	err = apiextensionsv1.AddToScheme(mgrClient.Scheme())
+	require.NoError(t, err)
 
 	// experimental conformance flags
 	conformanceProfiles = sets.New(
-		suite.HTTPConformanceProfileName,
-		suite.TLSConformanceProfileName,
+		suite.GatewayHTTPConformanceProfileName,
+		suite.GatewayTLSConformanceProfileName,
 	)
 
 	// if some conformance profiles have been set, run the experimental conformance suite...
-	implementation, err = suite.ParseImplementation(
+	implementation = suite.ParseImplementation(
 		*flags.ImplementationOrganization,
 		*flags.ImplementationProject,
 		*flags.ImplementationURL,
 		*flags.ImplementationVersion,
 		*flags.ImplementationContact,
 	)
-	if err != nil {
-		t.Fatalf("Error parsing implementation's details: %v", err)
-	}
 
 	experimentalConformance(t)
 }
@@ -84,31 +88,29 @@ func experimentalConformance(t *testing.T) {
 	t.Logf("Running experimental conformance tests with %s GatewayClass\n cleanup: %t\n debug: %t\n enable all features: %t \n conformance profiles: [%v]",
 		*flags.GatewayClassName, *flags.CleanupBaseResources, *flags.ShowDebug, *flags.EnableAllSupportedFeatures, conformanceProfiles)
 
-	cSuite, err := suite.NewExperimentalConformanceTestSuite(
-		suite.ExperimentalConformanceOptions{
-			Options: suite.Options{
-				Client:     mgrClient,
-				RestConfig: cfg,
-				// This clientset is needed in addition to the client only because
-				// controller-runtime client doesn't support non CRUD sub-resources yet (https://github.com/kubernetes-sigs/controller-runtime/issues/452).
-				Clientset:            k8sClientset,
-				GatewayClassName:     *flags.GatewayClassName,
-				Debug:                *flags.ShowDebug,
-				CleanupBaseResources: *flags.CleanupBaseResources,
-				SupportedFeatures:    suite.AllFeatures,
-				SkipTests: []string{
-					tests.GatewayStaticAddresses.ShortName,
-				},
-				ExemptFeatures: suite.MeshCoreFeatures,
+	cSuite, err := suite.NewConformanceTestSuite(
+		suite.ConformanceOptions{
+			Client:     mgrClient,
+			RestConfig: cfg,
+			// This clientset is needed in addition to the client only because
+			// controller-runtime client doesn't support non CRUD sub-resources yet (https://github.com/kubernetes-sigs/controller-runtime/issues/452).
+			Clientset:            k8sClientset,
+			GatewayClassName:     *flags.GatewayClassName,
+			Debug:                *flags.ShowDebug,
+			CleanupBaseResources: *flags.CleanupBaseResources,
+			SupportedFeatures:    features.AllFeatures,
+			SkipTests: []string{
+				tests.GatewayStaticAddresses.ShortName,
 			},
-			Implementation:      *implementation,
+			ExemptFeatures:      features.MeshCoreFeatures,
+			Implementation:      implementation,
 			ConformanceProfiles: conformanceProfiles,
 		})
 	if err != nil {
 		t.Fatalf("error creating experimental conformance test suite: %v", err)
 	}
 
-	cSuite.Setup(t)
+	cSuite.Setup(t, tests.ConformanceTests)
 	err = cSuite.Run(t, tests.ConformanceTests)
 	if err != nil {
 		t.Fatalf("error running conformance profile report: %v", err)
@@ -122,7 +124,7 @@ func experimentalConformance(t *testing.T) {
 	require.NoError(t, err)
 }
 
-func experimentalConformanceReport(logf func(string, ...any), report confv1a1.ConformanceReport, output string) error {
+func experimentalConformanceReport(logf func(string, ...any), report confv1.ConformanceReport, output string) error {
 	rawReport, err := yaml.Marshal(report)
 	if err != nil {
 		return err
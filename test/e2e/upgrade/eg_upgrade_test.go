@@ -10,18 +10,23 @@ package upgrade
 
 import (
 	"flag"
+	"io/fs"
 
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwapiv1a3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
 	"sigs.k8s.io/gateway-api/conformance/utils/flags"
 	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/features"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/test/e2e"
@@ -36,8 +41,10 @@ func TestEGUpgrade(t *testing.T) {
 	c, err := client.New(cfg, client.Options{})
 	require.NoError(t, err)
 	require.NoError(t, gwapiv1a2.AddToScheme(c.Scheme()))
+	require.NoError(t, gwapiv1a3.AddToScheme(c.Scheme()))
 	require.NoError(t, gwapiv1.AddToScheme(c.Scheme()))
 	require.NoError(t, egv1a1.AddToScheme(c.Scheme()))
+	require.NoError(t, apiextensionsv1.AddToScheme(c.Scheme()))
 
 	if flags.RunTest != nil && *flags.RunTest != "" {
 		t.Logf("Running E2E test %s with %s GatewayClass\n cleanup: %t\n debug: %t",
@@ -47,16 +54,20 @@ func TestEGUpgrade(t *testing.T) {
 			*flags.GatewayClassName, *flags.CleanupBaseResources, *flags.ShowDebug)
 	}
 
-	cSuite := suite.New(suite.Options{
+	cSuite, err := suite.NewConformanceTestSuite(suite.ConformanceOptions{
 		Client:               c,
 		GatewayClassName:     *flags.GatewayClassName,
 		Debug:                *flags.ShowDebug,
 		CleanupBaseResources: *flags.CleanupBaseResources,
-		FS:                   &e2e.Manifests,
+		ManifestFS:           []fs.FS{e2e.Manifests},
 		RunTest:              *flags.RunTest,
+		// The suite requires at least one supported feature. The E2E tests don't
+		// depend on any feature, so only the Gateway core feature is set.
+		SupportedFeatures: sets.New(features.SupportGateway),
 	})
+	require.NoError(t, err)
 
-	cSuite.Setup(t)
+	cSuite.Setup(t, []suite.ConformanceTest{tests.EnvoyShutdownTest, tests.EGUpgradeTest})
 	t.Logf("Upgrade: Running tests")
-	cSuite.Run(t, []suite.ConformanceTest{tests.EnvoyShutdownTest, tests.EGUpgradeTest})
+	require.NoError(t, cSuite.Run(t, []suite.ConformanceTest{tests.EnvoyShutdownTest, tests.EGUpgradeTest}))
 }